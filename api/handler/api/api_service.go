@@ -55,7 +55,17 @@ func Chat(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.AuthInvalid)
 		return
 	}
-	msg, err := application.NewHost(ctx, clientSet).ChatOpenAI(uid, req.ConversationID, req.Message, imageData)
+	msg, err := application.NewHost(ctx, clientSet).ChatOpenAI(uid, req.ConversationID, req.Message, imageData, req.Resources, application.ToolOverride{
+		Enable:  req.EnableTools,
+		Disable: req.DisableTools,
+	}, application.ChatOptions{
+		Model:        req.Model,
+		Temperature:  req.Temperature,
+		TopP:         req.TopP,
+		TopK:         req.TopK,
+		MaxTokens:    req.MaxTokens,
+		SystemPrompt: req.SystemPrompt,
+	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -114,8 +124,20 @@ func ChatSSE(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	if err := application.NewHost(ctx, clientSet).StreamChatOpenAI(ctx, uid, req.ConversationID, req.Message, imageData, emit); err != nil {
-		_ = emit("error", map[string]any{"error": err.Error()})
+	if err := application.NewHost(ctx, clientSet).StreamChatOpenAI(ctx, uid, req.ConversationID, req.Message, imageData, req.Resources, application.ToolOverride{
+		Enable:  req.EnableTools,
+		Disable: req.DisableTools,
+	}, application.ChatOptions{
+		Model:        req.Model,
+		Temperature:  req.Temperature,
+		TopP:         req.TopP,
+		TopK:         req.TopK,
+		MaxTokens:    req.MaxTokens,
+		SystemPrompt: req.SystemPrompt,
+	}, emit); err != nil {
+		// 与 pack.RespError 一致，返回 errno 的错误码与可读信息
+		e := errno.ConvertErr(err)
+		_ = emit("error", map[string]any{"error": e.ErrorMsg, "code": e.ErrorCode})
 		return
 	}
 }
@@ -706,3 +728,145 @@ func DailySchedule(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespData(c, resp)
 }
+
+// CreateUserMCPServer .
+// @router /api/v1/mcp/server/create [POST]
+func CreateUserMCPServer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateUserMCPServerRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	id, err := application.NewHost(ctx, clientSet).CreateUserMCPServerLogic(&req, uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.CreateUserMCPServerResponse{
+		ID: id,
+	}
+	pack.RespData(c, resp)
+}
+
+// ListUserMCPServer .
+// @router /api/v1/mcp/server/list [GET]
+func ListUserMCPServer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListUserMCPServerRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	servers, err := application.NewHost(ctx, clientSet).ListUserMCPServerLogic(uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.ListUserMCPServerResponse{
+		Servers: servers,
+	}
+	pack.RespData(c, resp)
+}
+
+// DeleteUserMCPServer .
+// @router /api/v1/mcp/server/delete [DELETE]
+func DeleteUserMCPServer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DeleteUserMCPServerRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	err = application.NewHost(ctx, clientSet).DeleteUserMCPServerLogic(req.ID, uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.DeleteUserMCPServerResponse{
+		ID: req.ID,
+	}
+	pack.RespData(c, resp)
+}
+
+// ApproveToolCall .
+// @router /api/v1/chat/approve [POST]
+func ApproveToolCall(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ApproveToolCallRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	status, err := application.NewHost(ctx, clientSet).ApproveToolCallLogic(&req, uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.ApproveToolCallResponse{
+		ApprovalID: req.ApprovalID,
+		Status:     status,
+	}
+	pack.RespData(c, resp)
+}
+
+// GetUserUsage .
+// @router /api/v1/user/usage [GET]
+func GetUserUsage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetUserUsageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	resp, err := application.NewHost(ctx, clientSet).GetUserUsageLogic(uid, req.Days)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
@@ -10,6 +10,7 @@ var clientSet *base.ClientSet
 func Init() {
 	clientSet = base.NewClientSet(
 		base.WithMCPClient([]string{constant.ServiceNameMCPLocal, constant.ServiceNameMCPRemote}),
+		base.WithUserMCPPool(),
 		base.WithAiProviderClient(),
 		base.WithDB(),
 		base.WithCache(),
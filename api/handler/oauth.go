@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/jwt"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// OAuthToken 为 MCP 客户端签发访问令牌，仅支持 client_credentials（RFC 6749 4.4）
+func OAuthToken(ctx context.Context, c *app.RequestContext) {
+	c.Header("Cache-Control", "no-store")
+	if grantType := string(c.PostForm("grant_type")); grantType != "client_credentials" {
+		oauthError(c, consts.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+
+	clientID, clientSecret, ok := basicAuth(string(c.GetHeader("Authorization")))
+	if !ok {
+		clientID, clientSecret = string(c.PostForm("client_id")), string(c.PostForm("client_secret"))
+	}
+	allowed, ok := authenticateOAuthClient(clientID, clientSecret)
+	if !ok {
+		c.Header("WWW-Authenticate", `Basic realm="mcp"`)
+		oauthError(c, consts.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+
+	scopes := strings.Fields(string(c.PostForm("scope")))
+	if len(scopes) == 0 {
+		scopes = allowed
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(allowed, scope) {
+			oauthError(c, consts.StatusBadRequest, "invalid_scope", "scope "+scope+" is not allowed for this client")
+			return
+		}
+	}
+
+	token, expiresAt, err := jwt.GenerateMCPAccessToken(clientID, scopes)
+	if err != nil {
+		oauthError(c, consts.StatusInternalServerError, "server_error", "generate token failed")
+		return
+	}
+	c.JSON(consts.StatusOK, utils.H{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int64(time.Until(expiresAt).Seconds()),
+		"scope":        strings.Join(scopes, " "),
+	})
+}
+
+// OAuthServerMetadata 授权服务器元数据（RFC 8414），供 MCP 客户端发现令牌端点
+func OAuthServerMetadata(ctx context.Context, c *app.RequestContext) {
+	issuer := strings.TrimRight(config.MCP.Auth.Issuer, "/")
+	set := map[string]struct{}{}
+	for _, client := range config.MCP.Auth.Clients {
+		for _, scope := range client.Scopes {
+			set[scope] = struct{}{}
+		}
+	}
+	scopes := make([]string, 0, len(set))
+	for scope := range set {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	c.JSON(consts.StatusOK, utils.H{
+		"issuer":                                issuer,
+		"token_endpoint":                        issuer + constant.MCPAuthTokenPath,
+		"grant_types_supported":                 []string{"client_credentials"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"scopes_supported":                      scopes,
+	})
+}
+
+func oauthError(c *app.RequestContext, status int, code, desc string) {
+	c.JSON(status, utils.H{
+		"error":             code,
+		"error_description": desc,
+	})
+}
+
+// basicAuth 解析 client_secret_basic，凭据在 base64 前经过 form-urlencoded 编码（RFC 6749 2.3.1）
+func basicAuth(header string) (string, string, bool) {
+	encoded, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	id, secret, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", "", false
+	}
+	id, err1 := url.QueryUnescape(id)
+	secret, err2 := url.QueryUnescape(secret)
+	if err1 != nil || err2 != nil {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+// authenticateOAuthClient 校验客户端凭据，返回该客户端可申请的 scope
+func authenticateOAuthClient(clientID, clientSecret string) ([]string, bool) {
+	if clientID == "" || clientSecret == "" {
+		return nil, false
+	}
+	for _, client := range config.MCP.Auth.Clients {
+		if client.ClientID == clientID &&
+			subtle.ConstantTimeCompare([]byte(client.ClientSecret), []byte(clientSecret)) == 1 {
+			return client.Scopes, true
+		}
+	}
+	return nil, false
+}
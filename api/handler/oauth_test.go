@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/jwt"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOAuthToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(`
+server:
+  private-key: "user-secret"
+mcp:
+  auth:
+    enable: true
+    signing_key: "mcp-secret"
+    issuer: "http://127.0.0.1:10001"
+    clients:
+      - client_id: "host"
+        client_secret: "s3cret"
+        scopes: ["mcp:tools", "mcp:tools:exec"]
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.Load(path, "host")
+
+	request := func(form string, headers ...ut.Header) (int, map[string]any) {
+		headers = append(headers, ut.Header{Key: "Content-Type", Value: "application/x-www-form-urlencoded"})
+		c := ut.CreateUtRequestContext("POST", "/oauth/token", &ut.Body{Body: strings.NewReader(form), Len: len(form)}, headers...)
+		OAuthToken(context.Background(), c)
+		var body map[string]any
+		So(json.Unmarshal(c.Response.Body(), &body), ShouldBeNil)
+		return c.Response.StatusCode(), body
+	}
+	basic := func(id, secret string) ut.Header {
+		return ut.Header{Key: "Authorization", Value: "Basic " + base64.StdEncoding.EncodeToString([]byte(id+":"+secret))}
+	}
+
+	Convey("OAuthToken", t, func() {
+		Convey("rejects other grant types", func() {
+			status, body := request("grant_type=password", basic("host", "s3cret"))
+			So(status, ShouldEqual, 400)
+			So(body["error"], ShouldEqual, "unsupported_grant_type")
+		})
+
+		Convey("rejects a wrong secret", func() {
+			status, body := request("grant_type=client_credentials", basic("host", "wrong"))
+			So(status, ShouldEqual, 401)
+			So(body["error"], ShouldEqual, "invalid_client")
+
+			status, _ = request("grant_type=client_credentials&client_id=host&client_secret=wrong")
+			So(status, ShouldEqual, 401)
+			status, _ = request("grant_type=client_credentials", basic("ghost", "s3cret"))
+			So(status, ShouldEqual, 401)
+		})
+
+		Convey("rejects scopes the client is not allowed", func() {
+			status, body := request("grant_type=client_credentials&scope=mcp:tools+admin", basic("host", "s3cret"))
+			So(status, ShouldEqual, 400)
+			So(body["error"], ShouldEqual, "invalid_scope")
+		})
+
+		Convey("issues the requested scopes only", func() {
+			status, body := request("grant_type=client_credentials&scope=mcp:tools", basic("host", "s3cret"))
+			So(status, ShouldEqual, 200)
+			So(body["scope"], ShouldEqual, "mcp:tools")
+
+			claims, err := jwt.VerifyMCPAccessToken(body["access_token"].(string))
+			So(err, ShouldBeNil)
+			So(claims.HasScope("mcp:tools"), ShouldBeTrue)
+			So(claims.HasScope("mcp:tools:exec"), ShouldBeFalse)
+			So(claims.ClientID, ShouldEqual, "host")
+		})
+
+		Convey("grants all allowed scopes by default via client_secret_post", func() {
+			status, body := request("grant_type=client_credentials&client_id=host&client_secret=s3cret")
+			So(status, ShouldEqual, 200)
+			So(body["scope"], ShouldEqual, "mcp:tools mcp:tools:exec")
+		})
+
+		Convey("mcp tokens are not user tokens", func() {
+			_, body := request("grant_type=client_credentials", basic("host", "s3cret"))
+			_, err := jwt.VerifyAccessToken(body["access_token"].(string))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -10,9 +10,18 @@ import (
 )
 
 type ChatRequest struct {
-	Message        string `thrift:"message,1" form:"message" json:"message"`
-	Image          []byte `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
-	ConversationID string `thrift:"conversation_id,3" form:"conversation_id" json:"conversation_id"`
+	Message        string   `thrift:"message,1" form:"message" json:"message"`
+	Image          []byte   `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
+	ConversationID string   `thrift:"conversation_id,3" form:"conversation_id" json:"conversation_id"`
+	Resources      []string `thrift:"resources,4,optional,list<string>" form:"resources" json:"resources,omitempty"`
+	EnableTools    []string `thrift:"enable_tools,5,optional,list<string>" form:"enable_tools" json:"enable_tools,omitempty"`
+	DisableTools   []string `thrift:"disable_tools,6,optional,list<string>" form:"disable_tools" json:"disable_tools,omitempty"`
+	Model          *string  `thrift:"model,7,optional" form:"model" json:"model,omitempty"`
+	Temperature    *float64 `thrift:"temperature,8,optional" form:"temperature" json:"temperature,omitempty"`
+	TopP           *float64 `thrift:"top_p,9,optional" form:"top_p" json:"top_p,omitempty"`
+	TopK           *int64   `thrift:"top_k,10,optional" form:"top_k" json:"top_k,omitempty"`
+	MaxTokens      *int64   `thrift:"max_tokens,11,optional" form:"max_tokens" json:"max_tokens,omitempty"`
+	SystemPrompt   *string  `thrift:"system_prompt,12,optional" form:"system_prompt" json:"system_prompt,omitempty"`
 }
 
 func NewChatRequest() *ChatRequest {
@@ -39,16 +48,142 @@ func (p *ChatRequest) GetConversationID() (v string) {
 	return p.ConversationID
 }
 
+var ChatRequest_Resources_DEFAULT []string
+
+func (p *ChatRequest) GetResources() (v []string) {
+	if !p.IsSetResources() {
+		return ChatRequest_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+var ChatRequest_EnableTools_DEFAULT []string
+
+func (p *ChatRequest) GetEnableTools() (v []string) {
+	if !p.IsSetEnableTools() {
+		return ChatRequest_EnableTools_DEFAULT
+	}
+	return p.EnableTools
+}
+
+var ChatRequest_DisableTools_DEFAULT []string
+
+func (p *ChatRequest) GetDisableTools() (v []string) {
+	if !p.IsSetDisableTools() {
+		return ChatRequest_DisableTools_DEFAULT
+	}
+	return p.DisableTools
+}
+
+var ChatRequest_Model_DEFAULT string
+
+func (p *ChatRequest) GetModel() (v string) {
+	if !p.IsSetModel() {
+		return ChatRequest_Model_DEFAULT
+	}
+	return *p.Model
+}
+
+var ChatRequest_Temperature_DEFAULT float64
+
+func (p *ChatRequest) GetTemperature() (v float64) {
+	if !p.IsSetTemperature() {
+		return ChatRequest_Temperature_DEFAULT
+	}
+	return *p.Temperature
+}
+
+var ChatRequest_TopP_DEFAULT float64
+
+func (p *ChatRequest) GetTopP() (v float64) {
+	if !p.IsSetTopP() {
+		return ChatRequest_TopP_DEFAULT
+	}
+	return *p.TopP
+}
+
+var ChatRequest_TopK_DEFAULT int64
+
+func (p *ChatRequest) GetTopK() (v int64) {
+	if !p.IsSetTopK() {
+		return ChatRequest_TopK_DEFAULT
+	}
+	return *p.TopK
+}
+
+var ChatRequest_MaxTokens_DEFAULT int64
+
+func (p *ChatRequest) GetMaxTokens() (v int64) {
+	if !p.IsSetMaxTokens() {
+		return ChatRequest_MaxTokens_DEFAULT
+	}
+	return *p.MaxTokens
+}
+
+var ChatRequest_SystemPrompt_DEFAULT string
+
+func (p *ChatRequest) GetSystemPrompt() (v string) {
+	if !p.IsSetSystemPrompt() {
+		return ChatRequest_SystemPrompt_DEFAULT
+	}
+	return *p.SystemPrompt
+}
+
 var fieldIDToName_ChatRequest = map[int16]string{
-	1: "message",
-	2: "image",
-	3: "conversation_id",
+	1:  "message",
+	2:  "image",
+	3:  "conversation_id",
+	4:  "resources",
+	5:  "enable_tools",
+	6:  "disable_tools",
+	7:  "model",
+	8:  "temperature",
+	9:  "top_p",
+	10: "top_k",
+	11: "max_tokens",
+	12: "system_prompt",
 }
 
 func (p *ChatRequest) IsSetImage() bool {
 	return p.Image != nil
 }
 
+func (p *ChatRequest) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *ChatRequest) IsSetEnableTools() bool {
+	return p.EnableTools != nil
+}
+
+func (p *ChatRequest) IsSetDisableTools() bool {
+	return p.DisableTools != nil
+}
+
+func (p *ChatRequest) IsSetModel() bool {
+	return p.Model != nil
+}
+
+func (p *ChatRequest) IsSetTemperature() bool {
+	return p.Temperature != nil
+}
+
+func (p *ChatRequest) IsSetTopP() bool {
+	return p.TopP != nil
+}
+
+func (p *ChatRequest) IsSetTopK() bool {
+	return p.TopK != nil
+}
+
+func (p *ChatRequest) IsSetMaxTokens() bool {
+	return p.MaxTokens != nil
+}
+
+func (p *ChatRequest) IsSetSystemPrompt() bool {
+	return p.SystemPrompt != nil
+}
+
 func (p *ChatRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -92,6 +227,78 @@ func (p *ChatRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -154,214 +361,131 @@ func (p *ChatRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.ConversationID = _field
 	return nil
 }
-
-func (p *ChatRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatRequest"); err != nil {
-		goto WriteStructBeginError
+func (p *ChatRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
 		}
+
+		_field = append(_field, _elem)
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Resources = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *ChatRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-func (p *ChatRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.EnableTools = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *ChatRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-func (p *ChatRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetImage() {
-		if err = oprot.WriteFieldBegin("image", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBinary([]byte(p.Image)); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+		_field = append(_field, _elem)
 	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DisableTools = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ChatRequest) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *ChatRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Model = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ChatRequest) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *ChatRequest) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return fmt.Sprintf("ChatRequest(%+v)", *p)
-
+	p.Temperature = _field
+	return nil
 }
+func (p *ChatRequest) ReadField9(iprot thrift.TProtocol) error {
 
-type ChatResponse struct {
-	Response       string  `thrift:"response,1" form:"response" json:"response"`
-	ConversationID *string `thrift:"conversation_id,2,optional" form:"conversation_id" json:"conversation_id,omitempty"`
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopP = _field
+	return nil
 }
+func (p *ChatRequest) ReadField10(iprot thrift.TProtocol) error {
 
-func NewChatResponse() *ChatResponse {
-	return &ChatResponse{}
-}
-
-func (p *ChatResponse) InitDefault() {
-}
-
-func (p *ChatResponse) GetResponse() (v string) {
-	return p.Response
-}
-
-var ChatResponse_ConversationID_DEFAULT string
-
-func (p *ChatResponse) GetConversationID() (v string) {
-	if !p.IsSetConversationID() {
-		return ChatResponse_ConversationID_DEFAULT
-	}
-	return *p.ConversationID
-}
-
-var fieldIDToName_ChatResponse = map[int16]string{
-	1: "response",
-	2: "conversation_id",
-}
-
-func (p *ChatResponse) IsSetConversationID() bool {
-	return p.ConversationID != nil
-}
-
-func (p *ChatResponse) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-
+	p.TopK = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
+func (p *ChatRequest) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *ChatResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Response = _field
+	p.MaxTokens = _field
 	return nil
 }
-func (p *ChatResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ChatRequest) ReadField12(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -369,13 +493,13 @@ func (p *ChatResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ConversationID = _field
+	p.SystemPrompt = _field
 	return nil
 }
 
-func (p *ChatResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ChatRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ChatRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -387,6 +511,46 @@ func (p *ChatResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -405,11 +569,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChatResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("response", thrift.STRING, 1); err != nil {
+func (p *ChatRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Response); err != nil {
+	if err := oprot.WriteString(p.Message); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -422,12 +586,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ChatResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConversationID() {
-		if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
+func (p *ChatRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImage() {
+		if err = oprot.WriteFieldBegin("image", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ConversationID); err != nil {
+		if err := oprot.WriteBinary([]byte(p.Image)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -441,219 +605,186 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ChatResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ChatRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("ChatResponse(%+v)", *p)
-
-}
-
-type ChatSSEHandlerRequest struct {
-	Message        string `thrift:"message,1" json:"message" query:"message"`
-	Image          []byte `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
-	ConversationID string `thrift:"conversation_id,3" json:"conversation_id" query:"conversation_id"`
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewChatSSEHandlerRequest() *ChatSSEHandlerRequest {
-	return &ChatSSEHandlerRequest{}
+func (p *ChatRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err = oprot.WriteFieldBegin("resources", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Resources)); err != nil {
+			return err
+		}
+		for _, v := range p.Resources {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) InitDefault() {
+func (p *ChatRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnableTools() {
+		if err = oprot.WriteFieldBegin("enable_tools", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnableTools)); err != nil {
+			return err
+		}
+		for _, v := range p.EnableTools {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) GetMessage() (v string) {
-	return p.Message
+func (p *ChatRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDisableTools() {
+		if err = oprot.WriteFieldBegin("disable_tools", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.DisableTools)); err != nil {
+			return err
+		}
+		for _, v := range p.DisableTools {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-var ChatSSEHandlerRequest_Image_DEFAULT []byte
-
-func (p *ChatSSEHandlerRequest) GetImage() (v []byte) {
-	if !p.IsSetImage() {
-		return ChatSSEHandlerRequest_Image_DEFAULT
-	}
-	return p.Image
-}
-
-func (p *ChatSSEHandlerRequest) GetConversationID() (v string) {
-	return p.ConversationID
-}
-
-var fieldIDToName_ChatSSEHandlerRequest = map[int16]string{
-	1: "message",
-	2: "image",
-	3: "conversation_id",
-}
-
-func (p *ChatSSEHandlerRequest) IsSetImage() bool {
-	return p.Image != nil
-}
-
-func (p *ChatSSEHandlerRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+func (p *ChatRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err := oprot.WriteString(*p.Model); err != nil {
+			return err
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatSSEHandlerRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ChatSSEHandlerRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Message = _field
-	return nil
-}
-func (p *ChatSSEHandlerRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field []byte
-	if v, err := iprot.ReadBinary(); err != nil {
-		return err
-	} else {
-		_field = []byte(v)
-	}
-	p.Image = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *ChatSSEHandlerRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ChatRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTemperature() {
+		if err = oprot.WriteFieldBegin("temperature", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Temperature); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.ConversationID = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSEHandlerRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+func (p *ChatRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopP() {
+		if err = oprot.WriteFieldBegin("top_p", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteDouble(*p.TopP); err != nil {
+			return err
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ChatRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopK() {
+		if err = oprot.WriteFieldBegin("top_k", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TopK); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetImage() {
-		if err = oprot.WriteFieldBegin("image", thrift.STRING, 2); err != nil {
+func (p *ChatRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokens() {
+		if err = oprot.WriteFieldBegin("max_tokens", thrift.I64, 11); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBinary([]byte(p.Image)); err != nil {
+		if err := oprot.WriteI64(*p.MaxTokens); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -662,71 +793,73 @@ func (p *ChatSSEHandlerRequest) writeField2(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ChatRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSystemPrompt() {
+		if err = oprot.WriteFieldBegin("system_prompt", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SystemPrompt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerRequest) String() string {
+func (p *ChatRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChatSSEHandlerRequest(%+v)", *p)
+	return fmt.Sprintf("ChatRequest(%+v)", *p)
 
 }
 
-type ChatSSEHandlerResponse struct {
+type ChatResponse struct {
 	Response       string  `thrift:"response,1" form:"response" json:"response"`
 	ConversationID *string `thrift:"conversation_id,2,optional" form:"conversation_id" json:"conversation_id,omitempty"`
 }
 
-func NewChatSSEHandlerResponse() *ChatSSEHandlerResponse {
-	return &ChatSSEHandlerResponse{}
+func NewChatResponse() *ChatResponse {
+	return &ChatResponse{}
 }
 
-func (p *ChatSSEHandlerResponse) InitDefault() {
+func (p *ChatResponse) InitDefault() {
 }
 
-func (p *ChatSSEHandlerResponse) GetResponse() (v string) {
+func (p *ChatResponse) GetResponse() (v string) {
 	return p.Response
 }
 
-var ChatSSEHandlerResponse_ConversationID_DEFAULT string
+var ChatResponse_ConversationID_DEFAULT string
 
-func (p *ChatSSEHandlerResponse) GetConversationID() (v string) {
+func (p *ChatResponse) GetConversationID() (v string) {
 	if !p.IsSetConversationID() {
-		return ChatSSEHandlerResponse_ConversationID_DEFAULT
+		return ChatResponse_ConversationID_DEFAULT
 	}
 	return *p.ConversationID
 }
 
-var fieldIDToName_ChatSSEHandlerResponse = map[int16]string{
+var fieldIDToName_ChatResponse = map[int16]string{
 	1: "response",
 	2: "conversation_id",
 }
 
-func (p *ChatSSEHandlerResponse) IsSetConversationID() bool {
+func (p *ChatResponse) IsSetConversationID() bool {
 	return p.ConversationID != nil
 }
 
-func (p *ChatSSEHandlerResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ChatResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -780,7 +913,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatSSEHandlerResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -790,7 +923,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ChatResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -801,7 +934,7 @@ func (p *ChatSSEHandlerResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Response = _field
 	return nil
 }
-func (p *ChatSSEHandlerResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ChatResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -813,9 +946,9 @@ func (p *ChatSSEHandlerResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ChatSSEHandlerResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ChatResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSEHandlerResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ChatResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -845,7 +978,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ChatResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("response", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -862,7 +995,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ChatResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetConversationID() {
 		if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
@@ -881,180 +1014,190 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ChatSSEHandlerResponse) String() string {
+func (p *ChatResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChatSSEHandlerResponse(%+v)", *p)
+	return fmt.Sprintf("ChatResponse(%+v)", *p)
 
 }
 
-type GetConversationHistoryRequest struct {
-	ConversationID string `thrift:"conversation_id,1" json:"conversation_id" query:"conversation_id"`
+type ChatSSEHandlerRequest struct {
+	Message        string   `thrift:"message,1" json:"message" query:"message"`
+	Image          []byte   `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
+	ConversationID string   `thrift:"conversation_id,3" json:"conversation_id" query:"conversation_id"`
+	Resources      []string `thrift:"resources,4,optional,list<string>" json:"resources,omitempty" query:"resources"`
+	EnableTools    []string `thrift:"enable_tools,5,optional,list<string>" json:"enable_tools,omitempty" query:"enable_tools"`
+	DisableTools   []string `thrift:"disable_tools,6,optional,list<string>" json:"disable_tools,omitempty" query:"disable_tools"`
+	Model          *string  `thrift:"model,7,optional" json:"model,omitempty" query:"model"`
+	Temperature    *float64 `thrift:"temperature,8,optional" json:"temperature,omitempty" query:"temperature"`
+	TopP           *float64 `thrift:"top_p,9,optional" json:"top_p,omitempty" query:"top_p"`
+	TopK           *int64   `thrift:"top_k,10,optional" json:"top_k,omitempty" query:"top_k"`
+	MaxTokens      *int64   `thrift:"max_tokens,11,optional" json:"max_tokens,omitempty" query:"max_tokens"`
+	SystemPrompt   *string  `thrift:"system_prompt,12,optional" json:"system_prompt,omitempty" query:"system_prompt"`
 }
 
-func NewGetConversationHistoryRequest() *GetConversationHistoryRequest {
-	return &GetConversationHistoryRequest{}
+func NewChatSSEHandlerRequest() *ChatSSEHandlerRequest {
+	return &ChatSSEHandlerRequest{}
 }
 
-func (p *GetConversationHistoryRequest) InitDefault() {
+func (p *ChatSSEHandlerRequest) InitDefault() {
 }
 
-func (p *GetConversationHistoryRequest) GetConversationID() (v string) {
-	return p.ConversationID
+func (p *ChatSSEHandlerRequest) GetMessage() (v string) {
+	return p.Message
 }
 
-var fieldIDToName_GetConversationHistoryRequest = map[int16]string{
-	1: "conversation_id",
+var ChatSSEHandlerRequest_Image_DEFAULT []byte
+
+func (p *ChatSSEHandlerRequest) GetImage() (v []byte) {
+	if !p.IsSetImage() {
+		return ChatSSEHandlerRequest_Image_DEFAULT
+	}
+	return p.Image
 }
 
-func (p *GetConversationHistoryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ChatSSEHandlerRequest) GetConversationID() (v string) {
+	return p.ConversationID
+}
 
-	var fieldTypeId thrift.TType
-	var fieldId int16
+var ChatSSEHandlerRequest_Resources_DEFAULT []string
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *ChatSSEHandlerRequest) GetResources() (v []string) {
+	if !p.IsSetResources() {
+		return ChatSSEHandlerRequest_Resources_DEFAULT
 	}
+	return p.Resources
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+var ChatSSEHandlerRequest_EnableTools_DEFAULT []string
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+func (p *ChatSSEHandlerRequest) GetEnableTools() (v []string) {
+	if !p.IsSetEnableTools() {
+		return ChatSSEHandlerRequest_EnableTools_DEFAULT
 	}
+	return p.EnableTools
+}
 
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetConversationHistoryRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+var ChatSSEHandlerRequest_DisableTools_DEFAULT []string
 
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+func (p *ChatSSEHandlerRequest) GetDisableTools() (v []string) {
+	if !p.IsSetDisableTools() {
+		return ChatSSEHandlerRequest_DisableTools_DEFAULT
+	}
+	return p.DisableTools
 }
 
-func (p *GetConversationHistoryRequest) ReadField1(iprot thrift.TProtocol) error {
+var ChatSSEHandlerRequest_Model_DEFAULT string
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ChatSSEHandlerRequest) GetModel() (v string) {
+	if !p.IsSetModel() {
+		return ChatSSEHandlerRequest_Model_DEFAULT
 	}
-	p.ConversationID = _field
-	return nil
+	return *p.Model
 }
 
-func (p *GetConversationHistoryRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistoryRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+var ChatSSEHandlerRequest_Temperature_DEFAULT float64
+
+func (p *ChatSSEHandlerRequest) GetTemperature() (v float64) {
+	if !p.IsSetTemperature() {
+		return ChatSSEHandlerRequest_Temperature_DEFAULT
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return *p.Temperature
 }
 
-func (p *GetConversationHistoryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
+var ChatSSEHandlerRequest_TopP_DEFAULT float64
+
+func (p *ChatSSEHandlerRequest) GetTopP() (v float64) {
+	if !p.IsSetTopP() {
+		return ChatSSEHandlerRequest_TopP_DEFAULT
 	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
+	return *p.TopP
+}
+
+var ChatSSEHandlerRequest_TopK_DEFAULT int64
+
+func (p *ChatSSEHandlerRequest) GetTopK() (v int64) {
+	if !p.IsSetTopK() {
+		return ChatSSEHandlerRequest_TopK_DEFAULT
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return *p.TopK
+}
+
+var ChatSSEHandlerRequest_MaxTokens_DEFAULT int64
+
+func (p *ChatSSEHandlerRequest) GetMaxTokens() (v int64) {
+	if !p.IsSetMaxTokens() {
+		return ChatSSEHandlerRequest_MaxTokens_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return *p.MaxTokens
 }
 
-func (p *GetConversationHistoryRequest) String() string {
-	if p == nil {
-		return "<nil>"
+var ChatSSEHandlerRequest_SystemPrompt_DEFAULT string
+
+func (p *ChatSSEHandlerRequest) GetSystemPrompt() (v string) {
+	if !p.IsSetSystemPrompt() {
+		return ChatSSEHandlerRequest_SystemPrompt_DEFAULT
 	}
-	return fmt.Sprintf("GetConversationHistoryRequest(%+v)", *p)
+	return *p.SystemPrompt
+}
 
+var fieldIDToName_ChatSSEHandlerRequest = map[int16]string{
+	1:  "message",
+	2:  "image",
+	3:  "conversation_id",
+	4:  "resources",
+	5:  "enable_tools",
+	6:  "disable_tools",
+	7:  "model",
+	8:  "temperature",
+	9:  "top_p",
+	10: "top_k",
+	11: "max_tokens",
+	12: "system_prompt",
 }
 
-type GetConversationHistoryResponse struct {
-	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
-	Messages       string `thrift:"messages,2" form:"messages" json:"messages"`
+func (p *ChatSSEHandlerRequest) IsSetImage() bool {
+	return p.Image != nil
 }
 
-func NewGetConversationHistoryResponse() *GetConversationHistoryResponse {
-	return &GetConversationHistoryResponse{}
+func (p *ChatSSEHandlerRequest) IsSetResources() bool {
+	return p.Resources != nil
 }
 
-func (p *GetConversationHistoryResponse) InitDefault() {
+func (p *ChatSSEHandlerRequest) IsSetEnableTools() bool {
+	return p.EnableTools != nil
 }
 
-func (p *GetConversationHistoryResponse) GetConversationID() (v string) {
-	return p.ConversationID
+func (p *ChatSSEHandlerRequest) IsSetDisableTools() bool {
+	return p.DisableTools != nil
 }
 
-func (p *GetConversationHistoryResponse) GetMessages() (v string) {
-	return p.Messages
+func (p *ChatSSEHandlerRequest) IsSetModel() bool {
+	return p.Model != nil
 }
 
-var fieldIDToName_GetConversationHistoryResponse = map[int16]string{
-	1: "conversation_id",
-	2: "messages",
+func (p *ChatSSEHandlerRequest) IsSetTemperature() bool {
+	return p.Temperature != nil
 }
 
-func (p *GetConversationHistoryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ChatSSEHandlerRequest) IsSetTopP() bool {
+	return p.TopP != nil
+}
+
+func (p *ChatSSEHandlerRequest) IsSetTopK() bool {
+	return p.TopK != nil
+}
+
+func (p *ChatSSEHandlerRequest) IsSetMaxTokens() bool {
+	return p.MaxTokens != nil
+}
+
+func (p *ChatSSEHandlerRequest) IsSetSystemPrompt() bool {
+	return p.SystemPrompt != nil
+}
+
+func (p *ChatSSEHandlerRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1089,6 +1232,86 @@ func (p *GetConversationHistoryResponse) Read(iprot thrift.TProtocol) (err error
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1108,7 +1331,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetConversationHistoryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatSSEHandlerRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1118,7 +1341,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetConversationHistoryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ChatSSEHandlerRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1126,24 +1349,170 @@ func (p *GetConversationHistoryResponse) ReadField1(iprot thrift.TProtocol) erro
 	} else {
 		_field = v
 	}
-	p.ConversationID = _field
+	p.Message = _field
 	return nil
 }
-func (p *GetConversationHistoryResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ChatSSEHandlerRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field []byte
+	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = []byte(v)
 	}
-	p.Messages = _field
+	p.Image = _field
 	return nil
 }
+func (p *ChatSSEHandlerRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *GetConversationHistoryResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConversationID = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Resources = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EnableTools = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DisableTools = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Model = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Temperature = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopP = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopK = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return nil
+}
+func (p *ChatSSEHandlerRequest) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SystemPrompt = _field
+	return nil
+}
+
+func (p *ChatSSEHandlerRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistoryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ChatSSEHandlerRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1155,6 +1524,46 @@ func (p *GetConversationHistoryResponse) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1173,11 +1582,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetConversationHistoryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+func (p *ChatSSEHandlerRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
+	if err := oprot.WriteString(p.Message); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1190,11 +1599,30 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetConversationHistoryResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("messages", thrift.STRING, 2); err != nil {
+func (p *ChatSSEHandlerRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImage() {
+		if err = oprot.WriteFieldBegin("image", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBinary([]byte(p.Image)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ChatSSEHandlerRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Messages); err != nil {
+	if err := oprot.WriteString(p.ConversationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1202,57 +1630,249 @@ func (p *GetConversationHistoryResponse) writeField2(oprot thrift.TProtocol) (er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetConversationHistoryResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ChatSSEHandlerRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err = oprot.WriteFieldBegin("resources", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Resources)); err != nil {
+			return err
+		}
+		for _, v := range p.Resources {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("GetConversationHistoryResponse(%+v)", *p)
-
-}
-
-type ConversationItem struct {
-	ID        string `thrift:"id,1" form:"id" json:"id"`
-	Title     string `thrift:"title,2" form:"title" json:"title"`
-	CreatedAt int64  `thrift:"created_at,3" form:"created_at" json:"created_at"`
-	UpdatedAt int64  `thrift:"updated_at,4" form:"updated_at" json:"updated_at"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func NewConversationItem() *ConversationItem {
-	return &ConversationItem{}
+func (p *ChatSSEHandlerRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnableTools() {
+		if err = oprot.WriteFieldBegin("enable_tools", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnableTools)); err != nil {
+			return err
+		}
+		for _, v := range p.EnableTools {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ConversationItem) InitDefault() {
-}
+func (p *ChatSSEHandlerRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDisableTools() {
+		if err = oprot.WriteFieldBegin("disable_tools", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.DisableTools)); err != nil {
+			return err
+		}
+		for _, v := range p.DisableTools {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *ConversationItem) GetID() (v string) {
-	return p.ID
+func (p *ChatSSEHandlerRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Model); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *ConversationItem) GetTitle() (v string) {
-	return p.Title
+func (p *ChatSSEHandlerRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTemperature() {
+		if err = oprot.WriteFieldBegin("temperature", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Temperature); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *ConversationItem) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *ChatSSEHandlerRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopP() {
+		if err = oprot.WriteFieldBegin("top_p", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TopP); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *ConversationItem) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *ChatSSEHandlerRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopK() {
+		if err = oprot.WriteFieldBegin("top_k", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TopK); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-var fieldIDToName_ConversationItem = map[int16]string{
-	1: "id",
-	2: "title",
-	3: "created_at",
-	4: "updated_at",
+func (p *ChatSSEHandlerRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokens() {
+		if err = oprot.WriteFieldBegin("max_tokens", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *ConversationItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *ChatSSEHandlerRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSystemPrompt() {
+		if err = oprot.WriteFieldBegin("system_prompt", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SystemPrompt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *ChatSSEHandlerRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatSSEHandlerRequest(%+v)", *p)
+
+}
+
+type ChatSSEHandlerResponse struct {
+	Response       string  `thrift:"response,1" form:"response" json:"response"`
+	ConversationID *string `thrift:"conversation_id,2,optional" form:"conversation_id" json:"conversation_id,omitempty"`
+}
+
+func NewChatSSEHandlerResponse() *ChatSSEHandlerResponse {
+	return &ChatSSEHandlerResponse{}
+}
+
+func (p *ChatSSEHandlerResponse) InitDefault() {
+}
+
+func (p *ChatSSEHandlerResponse) GetResponse() (v string) {
+	return p.Response
+}
+
+var ChatSSEHandlerResponse_ConversationID_DEFAULT string
+
+func (p *ChatSSEHandlerResponse) GetConversationID() (v string) {
+	if !p.IsSetConversationID() {
+		return ChatSSEHandlerResponse_ConversationID_DEFAULT
+	}
+	return *p.ConversationID
+}
+
+var fieldIDToName_ChatSSEHandlerResponse = map[int16]string{
+	1: "response",
+	2: "conversation_id",
+}
+
+func (p *ChatSSEHandlerResponse) IsSetConversationID() bool {
+	return p.ConversationID != nil
+}
+
+func (p *ChatSSEHandlerResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1287,22 +1907,6 @@ func (p *ConversationItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1322,7 +1926,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConversationItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatSSEHandlerResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1332,7 +1936,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ConversationItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *ChatSSEHandlerResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1340,46 +1944,24 @@ func (p *ConversationItem) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Response = _field
 	return nil
 }
-func (p *ConversationItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *ChatSSEHandlerResponse) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.Title = _field
-	return nil
-}
-func (p *ConversationItem) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
-	return nil
-}
-func (p *ConversationItem) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
+		_field = &v
 	}
-	p.UpdatedAt = _field
+	p.ConversationID = _field
 	return nil
 }
 
-func (p *ConversationItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *ChatSSEHandlerResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConversationItem"); err != nil {
+	if err = oprot.WriteStructBegin("ChatSSEHandlerResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1391,14 +1973,6 @@ func (p *ConversationItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1417,11 +1991,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ConversationItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ChatSSEHandlerResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("response", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteString(p.Response); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1434,15 +2008,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ConversationItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ChatSSEHandlerResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConversationID() {
+		if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ConversationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1451,151 +2027,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ConversationItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
+func (p *ChatSSEHandlerResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *ConversationItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *ConversationItem) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ConversationItem(%+v)", *p)
-
-}
-
-type ListConversationsRequest struct {
-}
-
-func NewListConversationsRequest() *ListConversationsRequest {
-	return &ListConversationsRequest{}
-}
-
-func (p *ListConversationsRequest) InitDefault() {
-}
-
-var fieldIDToName_ListConversationsRequest = map[int16]string{}
-
-func (p *ListConversationsRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ListConversationsRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ListConversationsRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *ListConversationsRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ListConversationsRequest(%+v)", *p)
+	return fmt.Sprintf("ChatSSEHandlerResponse(%+v)", *p)
 
 }
 
-type ListConversationsResponse struct {
-	Conversations []*ConversationItem `thrift:"conversations,1,default,list<ConversationItem>" form:"conversations" json:"conversations"`
+type GetConversationHistoryRequest struct {
+	ConversationID string `thrift:"conversation_id,1" json:"conversation_id" query:"conversation_id"`
 }
 
-func NewListConversationsResponse() *ListConversationsResponse {
-	return &ListConversationsResponse{}
+func NewGetConversationHistoryRequest() *GetConversationHistoryRequest {
+	return &GetConversationHistoryRequest{}
 }
 
-func (p *ListConversationsResponse) InitDefault() {
+func (p *GetConversationHistoryRequest) InitDefault() {
 }
 
-func (p *ListConversationsResponse) GetConversations() (v []*ConversationItem) {
-	return p.Conversations
+func (p *GetConversationHistoryRequest) GetConversationID() (v string) {
+	return p.ConversationID
 }
 
-var fieldIDToName_ListConversationsResponse = map[int16]string{
-	1: "conversations",
+var fieldIDToName_GetConversationHistoryRequest = map[int16]string{
+	1: "conversation_id",
 }
 
-func (p *ListConversationsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetConversationHistoryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1615,7 +2074,7 @@ func (p *ListConversationsResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1641,7 +2100,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListConversationsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetConversationHistoryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1651,33 +2110,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListConversationsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ConversationItem, 0, size)
-	values := make([]ConversationItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *GetConversationHistoryRequest) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Conversations = _field
+	p.ConversationID = _field
 	return nil
 }
 
-func (p *ListConversationsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetConversationHistoryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListConversationsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetConversationHistoryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1703,19 +2150,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListConversationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversations", thrift.LIST, 1); err != nil {
+func (p *GetConversationHistoryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Conversations)); err != nil {
-		return err
-	}
-	for _, v := range p.Conversations {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ConversationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1728,34 +2167,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListConversationsResponse) String() string {
+func (p *GetConversationHistoryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListConversationsResponse(%+v)", *p)
+	return fmt.Sprintf("GetConversationHistoryRequest(%+v)", *p)
 
 }
 
-type TemplateRequest struct {
-	TemplateId string `thrift:"templateId,1" form:"templateId" json:"templateId"`
+type GetConversationHistoryResponse struct {
+	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
+	Messages       string `thrift:"messages,2" form:"messages" json:"messages"`
 }
 
-func NewTemplateRequest() *TemplateRequest {
-	return &TemplateRequest{}
+func NewGetConversationHistoryResponse() *GetConversationHistoryResponse {
+	return &GetConversationHistoryResponse{}
 }
 
-func (p *TemplateRequest) InitDefault() {
+func (p *GetConversationHistoryResponse) InitDefault() {
 }
 
-func (p *TemplateRequest) GetTemplateId() (v string) {
-	return p.TemplateId
+func (p *GetConversationHistoryResponse) GetConversationID() (v string) {
+	return p.ConversationID
 }
 
-var fieldIDToName_TemplateRequest = map[int16]string{
-	1: "templateId",
+func (p *GetConversationHistoryResponse) GetMessages() (v string) {
+	return p.Messages
 }
 
-func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_GetConversationHistoryResponse = map[int16]string{
+	1: "conversation_id",
+	2: "messages",
+}
+
+func (p *GetConversationHistoryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1782,6 +2227,14 @@ func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1801,7 +2254,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetConversationHistoryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1811,7 +2264,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetConversationHistoryResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1819,13 +2272,24 @@ func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TemplateId = _field
+	p.ConversationID = _field
 	return nil
 }
+func (p *GetConversationHistoryResponse) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Messages = _field
+	return nil
+}
+
+func (p *GetConversationHistoryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TemplateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetConversationHistoryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1833,6 +2297,10 @@ func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1851,11 +2319,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TemplateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("templateId", thrift.STRING, 1); err != nil {
+func (p *GetConversationHistoryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.TemplateId); err != nil {
+	if err := oprot.WriteString(p.ConversationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1868,43 +2336,69 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TemplateRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("TemplateRequest(%+v)", *p)
+func (p *GetConversationHistoryResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("messages", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Messages); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetConversationHistoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetConversationHistoryResponse(%+v)", *p)
 
 }
 
-type TemplateResponse struct {
-	User *model.User `thrift:"user,1" form:"user" json:"user"`
+type ConversationItem struct {
+	ID        string `thrift:"id,1" form:"id" json:"id"`
+	Title     string `thrift:"title,2" form:"title" json:"title"`
+	CreatedAt int64  `thrift:"created_at,3" form:"created_at" json:"created_at"`
+	UpdatedAt int64  `thrift:"updated_at,4" form:"updated_at" json:"updated_at"`
 }
 
-func NewTemplateResponse() *TemplateResponse {
-	return &TemplateResponse{}
+func NewConversationItem() *ConversationItem {
+	return &ConversationItem{}
 }
 
-func (p *TemplateResponse) InitDefault() {
+func (p *ConversationItem) InitDefault() {
 }
 
-var TemplateResponse_User_DEFAULT *model.User
+func (p *ConversationItem) GetID() (v string) {
+	return p.ID
+}
 
-func (p *TemplateResponse) GetUser() (v *model.User) {
-	if !p.IsSetUser() {
-		return TemplateResponse_User_DEFAULT
-	}
-	return p.User
+func (p *ConversationItem) GetTitle() (v string) {
+	return p.Title
 }
 
-var fieldIDToName_TemplateResponse = map[int16]string{
-	1: "user",
+func (p *ConversationItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *TemplateResponse) IsSetUser() bool {
-	return p.User != nil
+func (p *ConversationItem) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
 
-func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ConversationItem = map[int16]string{
+	1: "id",
+	2: "title",
+	3: "created_at",
+	4: "updated_at",
+}
+
+func (p *ConversationItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1924,13 +2418,37 @@ func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1950,7 +2468,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConversationItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1960,18 +2478,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TemplateResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewUser()
-	if err := _field.Read(iprot); err != nil {
+func (p *ConversationItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.User = _field
+	p.ID = _field
 	return nil
 }
+func (p *ConversationItem) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *ConversationItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *ConversationItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *ConversationItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TemplateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ConversationItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1979,6 +2533,18 @@ func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1997,11 +2563,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TemplateResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
+func (p *ConversationItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.User.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2014,34 +2580,78 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TemplateResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ConversationItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TemplateResponse(%+v)", *p)
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
+func (p *ConversationItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-type SummarizeConversationRequest struct {
-	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
+func (p *ConversationItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func NewSummarizeConversationRequest() *SummarizeConversationRequest {
-	return &SummarizeConversationRequest{}
+func (p *ConversationItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConversationItem(%+v)", *p)
+
 }
 
-func (p *SummarizeConversationRequest) InitDefault() {
+type ListConversationsRequest struct {
 }
 
-func (p *SummarizeConversationRequest) GetConversationID() (v string) {
-	return p.ConversationID
+func NewListConversationsRequest() *ListConversationsRequest {
+	return &ListConversationsRequest{}
 }
 
-var fieldIDToName_SummarizeConversationRequest = map[int16]string{
-	1: "conversation_id",
+func (p *ListConversationsRequest) InitDefault() {
 }
 
-func (p *SummarizeConversationRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ListConversationsRequest = map[int16]string{}
+
+func (p *ListConversationsRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2058,20 +2668,8 @@ func (p *SummarizeConversationRequest) Read(iprot thrift.TProtocol) (err error)
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -2086,10 +2684,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -2097,28 +2693,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ConversationID = _field
-	return nil
-}
-
-func (p *SummarizeConversationRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversationRequest"); err != nil {
+func (p *ListConversationsRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListConversationsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2129,83 +2708,40 @@ func (p *SummarizeConversationRequest) Write(oprot thrift.TProtocol) (err error)
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *SummarizeConversationRequest) String() string {
+func (p *ListConversationsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SummarizeConversationRequest(%+v)", *p)
-
-}
-
-type SummarizeConversationResponse struct {
-	SumID         string            `thrift:"sum_id,1" form:"sum_id" json:"sum_id"`
-	Summary       string            `thrift:"summary,2" form:"summary" json:"summary"`
-	Tags          []string          `thrift:"tags,3,default,list<string>" form:"tags" json:"tags"`
-	ToolCallsJSON string            `thrift:"tool_calls_json,4" form:"tool_calls_json" json:"tool_calls_json"`
-	Notes         map[string]string `thrift:"notes,5" form:"notes" json:"notes"`
-}
-
-func NewSummarizeConversationResponse() *SummarizeConversationResponse {
-	return &SummarizeConversationResponse{}
-}
-
-func (p *SummarizeConversationResponse) InitDefault() {
-}
+	return fmt.Sprintf("ListConversationsRequest(%+v)", *p)
 
-func (p *SummarizeConversationResponse) GetSumID() (v string) {
-	return p.SumID
 }
 
-func (p *SummarizeConversationResponse) GetSummary() (v string) {
-	return p.Summary
+type ListConversationsResponse struct {
+	Conversations []*ConversationItem `thrift:"conversations,1,default,list<ConversationItem>" form:"conversations" json:"conversations"`
 }
 
-func (p *SummarizeConversationResponse) GetTags() (v []string) {
-	return p.Tags
+func NewListConversationsResponse() *ListConversationsResponse {
+	return &ListConversationsResponse{}
 }
 
-func (p *SummarizeConversationResponse) GetToolCallsJSON() (v string) {
-	return p.ToolCallsJSON
+func (p *ListConversationsResponse) InitDefault() {
 }
 
-func (p *SummarizeConversationResponse) GetNotes() (v map[string]string) {
-	return p.Notes
+func (p *ListConversationsResponse) GetConversations() (v []*ConversationItem) {
+	return p.Conversations
 }
 
-var fieldIDToName_SummarizeConversationResponse = map[int16]string{
-	1: "sum_id",
-	2: "summary",
-	3: "tags",
-	4: "tool_calls_json",
-	5: "notes",
+var fieldIDToName_ListConversationsResponse = map[int16]string{
+	1: "conversations",
 }
 
-func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListConversationsResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2225,40 +2761,8 @@ func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2283,7 +2787,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListConversationsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2293,41 +2797,19 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SumID = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Summary = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *ListConversationsResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*ConversationItem, 0, size)
+	values := make([]ConversationItem, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -2335,53 +2817,13 @@ func (p *SummarizeConversationResponse) ReadField3(iprot thrift.TProtocol) error
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Tags = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ToolCallsJSON = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Notes = _field
+	p.Conversations = _field
 	return nil
 }
 
-func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListConversationsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListConversationsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2389,22 +2831,6 @@ func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2423,11 +2849,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sum_id", thrift.STRING, 1); err != nil {
+func (p *ListConversationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversations", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SumID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Conversations)); err != nil {
+		return err
+	}
+	for _, v := range p.Conversations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2440,127 +2874,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Summary); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ListConversationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return fmt.Sprintf("ListConversationsResponse(%+v)", *p)
+
 }
 
-func (p *SummarizeConversationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-		return err
-	}
-	for _, v := range p.Tags {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-		return err
-	}
-	for k, v := range p.Notes {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SummarizeConversationResponse(%+v)", *p)
-
-}
-
-type GetLoginDataRequest struct {
-	StuID    string `thrift:"stu_id,1" form:"stu_id" json:"stu_id"`
-	Password string `thrift:"password,2" form:"password" json:"password"`
-}
-
-func NewGetLoginDataRequest() *GetLoginDataRequest {
-	return &GetLoginDataRequest{}
+type TemplateRequest struct {
+	TemplateId string `thrift:"templateId,1" form:"templateId" json:"templateId"`
 }
 
-func (p *GetLoginDataRequest) InitDefault() {
+func NewTemplateRequest() *TemplateRequest {
+	return &TemplateRequest{}
 }
 
-func (p *GetLoginDataRequest) GetStuID() (v string) {
-	return p.StuID
+func (p *TemplateRequest) InitDefault() {
 }
 
-func (p *GetLoginDataRequest) GetPassword() (v string) {
-	return p.Password
+func (p *TemplateRequest) GetTemplateId() (v string) {
+	return p.TemplateId
 }
 
-var fieldIDToName_GetLoginDataRequest = map[int16]string{
-	1: "stu_id",
-	2: "password",
+var fieldIDToName_TemplateRequest = map[int16]string{
+	1: "templateId",
 }
 
-func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2587,14 +2928,6 @@ func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2614,7 +2947,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2624,18 +2957,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StuID = _field
-	return nil
-}
-func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2643,13 +2965,13 @@ func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Password = _field
+	p.TemplateId = _field
 	return nil
 }
 
-func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginDataRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TemplateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2657,10 +2979,6 @@ func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2679,11 +2997,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("stu_id", thrift.STRING, 1); err != nil {
+func (p *TemplateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("templateId", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StuID); err != nil {
+	if err := oprot.WriteString(p.TemplateId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2696,63 +3014,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Password); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *GetLoginDataRequest) String() string {
+func (p *TemplateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLoginDataRequest(%+v)", *p)
+	return fmt.Sprintf("TemplateRequest(%+v)", *p)
 
 }
 
-type GetLoginDataResponse struct {
-	Identifier  string `thrift:"identifier,1" form:"identifier" json:"identifier"`
-	Cookie      string `thrift:"cookie,2" form:"cookie" json:"cookie"`
-	AccessToken string `thrift:"access_token,3" form:"access_token" json:"access_token"`
+type TemplateResponse struct {
+	User *model.User `thrift:"user,1" form:"user" json:"user"`
 }
 
-func NewGetLoginDataResponse() *GetLoginDataResponse {
-	return &GetLoginDataResponse{}
+func NewTemplateResponse() *TemplateResponse {
+	return &TemplateResponse{}
 }
 
-func (p *GetLoginDataResponse) InitDefault() {
+func (p *TemplateResponse) InitDefault() {
 }
 
-func (p *GetLoginDataResponse) GetIdentifier() (v string) {
-	return p.Identifier
-}
+var TemplateResponse_User_DEFAULT *model.User
 
-func (p *GetLoginDataResponse) GetCookie() (v string) {
-	return p.Cookie
+func (p *TemplateResponse) GetUser() (v *model.User) {
+	if !p.IsSetUser() {
+		return TemplateResponse_User_DEFAULT
+	}
+	return p.User
 }
 
-func (p *GetLoginDataResponse) GetAccessToken() (v string) {
-	return p.AccessToken
+var fieldIDToName_TemplateResponse = map[int16]string{
+	1: "user",
 }
 
-var fieldIDToName_GetLoginDataResponse = map[int16]string{
-	1: "identifier",
-	2: "cookie",
-	3: "access_token",
+func (p *TemplateResponse) IsSetUser() bool {
+	return p.User != nil
 }
 
-func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2772,29 +3070,13 @@ func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2814,7 +3096,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2824,43 +3106,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Identifier = _field
-	return nil
-}
-func (p *GetLoginDataResponse) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Cookie = _field
-	return nil
-}
-func (p *GetLoginDataResponse) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TemplateResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewUser()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.AccessToken = _field
+	p.User = _field
 	return nil
 }
 
-func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginDataResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TemplateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2868,14 +3125,6 @@ func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2894,11 +3143,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("identifier", thrift.STRING, 1); err != nil {
+func (p *TemplateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Identifier); err != nil {
+	if err := p.User.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2911,61 +3160,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("cookie", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Cookie); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *GetLoginDataResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *GetLoginDataResponse) String() string {
+func (p *TemplateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLoginDataResponse(%+v)", *p)
+	return fmt.Sprintf("TemplateResponse(%+v)", *p)
 
 }
 
-type GetUserInfoRequest struct {
+type SummarizeConversationRequest struct {
+	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
 }
 
-func NewGetUserInfoRequest() *GetUserInfoRequest {
-	return &GetUserInfoRequest{}
+func NewSummarizeConversationRequest() *SummarizeConversationRequest {
+	return &SummarizeConversationRequest{}
 }
 
-func (p *GetUserInfoRequest) InitDefault() {
+func (p *SummarizeConversationRequest) InitDefault() {
 }
 
-var fieldIDToName_GetUserInfoRequest = map[int16]string{}
+func (p *SummarizeConversationRequest) GetConversationID() (v string) {
+	return p.ConversationID
+}
 
-func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_SummarizeConversationRequest = map[int16]string{
+	1: "conversation_id",
+}
+
+func (p *SummarizeConversationRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2982,8 +3204,20 @@ func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -2998,8 +3232,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -3007,11 +3243,28 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("GetUserInfoRequest"); err != nil {
+func (p *SummarizeConversationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConversationID = _field
+	return nil
+}
+
+func (p *SummarizeConversationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SummarizeConversationRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3022,46 +3275,83 @@ func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserInfoRequest) String() string {
+func (p *SummarizeConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SummarizeConversationRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoRequest(%+v)", *p)
+	return fmt.Sprintf("SummarizeConversationRequest(%+v)", *p)
 
 }
 
-type GetUserInfoResponse struct {
-	UserID   string `thrift:"user_id,1" form:"user_id" json:"user_id"`
-	Username string `thrift:"username,2" form:"username" json:"username"`
+type SummarizeConversationResponse struct {
+	SumID         string            `thrift:"sum_id,1" form:"sum_id" json:"sum_id"`
+	Summary       string            `thrift:"summary,2" form:"summary" json:"summary"`
+	Tags          []string          `thrift:"tags,3,default,list<string>" form:"tags" json:"tags"`
+	ToolCallsJSON string            `thrift:"tool_calls_json,4" form:"tool_calls_json" json:"tool_calls_json"`
+	Notes         map[string]string `thrift:"notes,5" form:"notes" json:"notes"`
 }
 
-func NewGetUserInfoResponse() *GetUserInfoResponse {
-	return &GetUserInfoResponse{}
+func NewSummarizeConversationResponse() *SummarizeConversationResponse {
+	return &SummarizeConversationResponse{}
 }
 
-func (p *GetUserInfoResponse) InitDefault() {
+func (p *SummarizeConversationResponse) InitDefault() {
 }
 
-func (p *GetUserInfoResponse) GetUserID() (v string) {
-	return p.UserID
+func (p *SummarizeConversationResponse) GetSumID() (v string) {
+	return p.SumID
 }
 
-func (p *GetUserInfoResponse) GetUsername() (v string) {
-	return p.Username
+func (p *SummarizeConversationResponse) GetSummary() (v string) {
+	return p.Summary
 }
 
-var fieldIDToName_GetUserInfoResponse = map[int16]string{
-	1: "user_id",
-	2: "username",
+func (p *SummarizeConversationResponse) GetTags() (v []string) {
+	return p.Tags
 }
 
-func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SummarizeConversationResponse) GetToolCallsJSON() (v string) {
+	return p.ToolCallsJSON
+}
+
+func (p *SummarizeConversationResponse) GetNotes() (v map[string]string) {
+	return p.Notes
+}
+
+var fieldIDToName_SummarizeConversationResponse = map[int16]string{
+	1: "sum_id",
+	2: "summary",
+	3: "tags",
+	4: "tool_calls_json",
+	5: "notes",
+}
+
+func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3096,6 +3386,30 @@ func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3115,7 +3429,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3125,7 +3439,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3133,10 +3447,10 @@ func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.SumID = _field
 	return nil
 }
-func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3144,47 +3458,122 @@ func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Username = _field
+	p.Summary = _field
 	return nil
 }
-
-func (p *GetUserInfoResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfoResponse"); err != nil {
-		goto WriteStructBeginError
+func (p *SummarizeConversationResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
 		}
+
+		_field = append(_field, _elem)
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Tags = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *SummarizeConversationResponse) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *GetUserInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ToolCallsJSON = _field
+	return nil
+}
+func (p *SummarizeConversationResponse) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Notes = _field
+	return nil
+}
+
+func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SummarizeConversationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SummarizeConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sum_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserID); err != nil {
+	if err := oprot.WriteString(p.SumID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3197,11 +3586,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
+func (p *SummarizeConversationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Username); err != nil {
+	if err := oprot.WriteString(p.Summary); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3214,103 +3603,110 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *SummarizeConversationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetUserInfoResponse(%+v)", *p)
-
-}
-
-type CreateTodoRequest struct {
-	Title     string  `thrift:"title,1" form:"title" json:"title"`
-	Content   string  `thrift:"content,2" form:"content" json:"content"`
-	StartTime int64   `thrift:"start_time,3" form:"start_time" json:"start_time"`
-	EndTime   int64   `thrift:"end_time,4" form:"end_time" json:"end_time"`
-	IsAllDay  *int16  `thrift:"is_all_day,5,optional" form:"is_all_day" json:"is_all_day,omitempty"`
-	Priority  int16   `thrift:"priority,6" form:"priority" json:"priority"`
-	RemindAt  *int64  `thrift:"remind_at,7,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,8,optional" form:"category" json:"category,omitempty"`
-}
-
-func NewCreateTodoRequest() *CreateTodoRequest {
-	return &CreateTodoRequest{}
-}
-
-func (p *CreateTodoRequest) InitDefault() {
-}
-
-func (p *CreateTodoRequest) GetTitle() (v string) {
-	return p.Title
-}
-
-func (p *CreateTodoRequest) GetContent() (v string) {
-	return p.Content
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) GetStartTime() (v int64) {
-	return p.StartTime
+func (p *SummarizeConversationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) GetEndTime() (v int64) {
-	return p.EndTime
+func (p *SummarizeConversationResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+		return err
+	}
+	for k, v := range p.Notes {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var CreateTodoRequest_IsAllDay_DEFAULT int16
-
-func (p *CreateTodoRequest) GetIsAllDay() (v int16) {
-	if !p.IsSetIsAllDay() {
-		return CreateTodoRequest_IsAllDay_DEFAULT
+func (p *SummarizeConversationResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.IsAllDay
-}
+	return fmt.Sprintf("SummarizeConversationResponse(%+v)", *p)
 
-func (p *CreateTodoRequest) GetPriority() (v int16) {
-	return p.Priority
 }
 
-var CreateTodoRequest_RemindAt_DEFAULT int64
-
-func (p *CreateTodoRequest) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return CreateTodoRequest_RemindAt_DEFAULT
-	}
-	return *p.RemindAt
+type GetLoginDataRequest struct {
+	StuID    string `thrift:"stu_id,1" form:"stu_id" json:"stu_id"`
+	Password string `thrift:"password,2" form:"password" json:"password"`
 }
 
-var CreateTodoRequest_Category_DEFAULT string
-
-func (p *CreateTodoRequest) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return CreateTodoRequest_Category_DEFAULT
-	}
-	return *p.Category
+func NewGetLoginDataRequest() *GetLoginDataRequest {
+	return &GetLoginDataRequest{}
 }
 
-var fieldIDToName_CreateTodoRequest = map[int16]string{
-	1: "title",
-	2: "content",
-	3: "start_time",
-	4: "end_time",
-	5: "is_all_day",
-	6: "priority",
-	7: "remind_at",
-	8: "category",
+func (p *GetLoginDataRequest) InitDefault() {
 }
 
-func (p *CreateTodoRequest) IsSetIsAllDay() bool {
-	return p.IsAllDay != nil
+func (p *GetLoginDataRequest) GetStuID() (v string) {
+	return p.StuID
 }
 
-func (p *CreateTodoRequest) IsSetRemindAt() bool {
-	return p.RemindAt != nil
+func (p *GetLoginDataRequest) GetPassword() (v string) {
+	return p.Password
 }
 
-func (p *CreateTodoRequest) IsSetCategory() bool {
-	return p.Category != nil
+var fieldIDToName_GetLoginDataRequest = map[int16]string{
+	1: "stu_id",
+	2: "password",
 }
 
-func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3345,56 +3741,8 @@ func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -3412,7 +3760,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3422,7 +3770,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetLoginDataRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3430,10 +3778,10 @@ func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.StuID = _field
 	return nil
 }
-func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3441,79 +3789,13 @@ func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Content = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StartTime = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.EndTime = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsAllDay = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Priority = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.RemindAt = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Category = _field
+	p.Password = _field
 	return nil
 }
 
-func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginDataRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3525,30 +3807,6 @@ func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3567,11 +3825,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 1); err != nil {
+func (p *GetLoginDataRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stu_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteString(p.StuID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3584,11 +3842,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 2); err != nil {
+func (p *GetLoginDataRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteString(p.Password); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3601,142 +3859,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StartTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetLoginDataRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("GetLoginDataRequest(%+v)", *p)
+
 }
 
-func (p *CreateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EndTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+type GetLoginDataResponse struct {
+	Identifier  string `thrift:"identifier,1" form:"identifier" json:"identifier"`
+	Cookie      string `thrift:"cookie,2" form:"cookie" json:"cookie"`
+	AccessToken string `thrift:"access_token,3" form:"access_token" json:"access_token"`
 }
 
-func (p *CreateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsAllDay() {
-		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func NewGetLoginDataResponse() *GetLoginDataResponse {
+	return &GetLoginDataResponse{}
 }
 
-func (p *CreateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("priority", thrift.I16, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Priority); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *GetLoginDataResponse) InitDefault() {
 }
 
-func (p *CreateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.RemindAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+func (p *GetLoginDataResponse) GetIdentifier() (v string) {
+	return p.Identifier
 }
 
-func (p *CreateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *CreateTodoRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("CreateTodoRequest(%+v)", *p)
-
-}
-
-type CreateTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
-}
-
-func NewCreateTodoResponse() *CreateTodoResponse {
-	return &CreateTodoResponse{}
-}
-
-func (p *CreateTodoResponse) InitDefault() {
+func (p *GetLoginDataResponse) GetCookie() (v string) {
+	return p.Cookie
 }
 
-func (p *CreateTodoResponse) GetID() (v string) {
-	return p.ID
+func (p *GetLoginDataResponse) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-var fieldIDToName_CreateTodoResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_GetLoginDataResponse = map[int16]string{
+	1: "identifier",
+	2: "cookie",
+	3: "access_token",
 }
 
-func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3763,6 +3925,22 @@ func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3782,7 +3960,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3792,7 +3970,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetLoginDataResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3800,13 +3978,35 @@ func (p *CreateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Identifier = _field
 	return nil
 }
+func (p *GetLoginDataResponse) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *CreateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Cookie = _field
+	return nil
+}
+func (p *GetLoginDataResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginDataResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3814,6 +4014,14 @@ func (p *CreateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3832,11 +4040,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *GetLoginDataResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("identifier", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteString(p.Identifier); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3849,34 +4057,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetLoginDataResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cookie", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("CreateTodoResponse(%+v)", *p)
-
+	if err := oprot.WriteString(p.Cookie); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type GetTodoRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+func (p *GetLoginDataResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewGetTodoRequest() *GetTodoRequest {
-	return &GetTodoRequest{}
+func (p *GetLoginDataResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLoginDataResponse(%+v)", *p)
+
 }
 
-func (p *GetTodoRequest) InitDefault() {
+type GetUserInfoRequest struct {
 }
 
-func (p *GetTodoRequest) GetID() (v string) {
-	return p.ID
+func NewGetUserInfoRequest() *GetUserInfoRequest {
+	return &GetUserInfoRequest{}
 }
 
-var fieldIDToName_GetTodoRequest = map[int16]string{
-	1: "id",
+func (p *GetUserInfoRequest) InitDefault() {
 }
 
-func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_GetUserInfoRequest = map[int16]string{}
+
+func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3893,20 +4128,8 @@ func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -3921,10 +4144,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -3932,28 +4153,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTodoRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-
-func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodoRequest"); err != nil {
+func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetUserInfoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3964,143 +4168,46 @@ func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *GetTodoRequest) String() string {
+func (p *GetUserInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetTodoRequest(%+v)", *p)
+	return fmt.Sprintf("GetUserInfoRequest(%+v)", *p)
 
 }
 
-type TodoItem struct {
-	ID        string  `thrift:"id,1" form:"id" json:"id"`
-	Title     string  `thrift:"title,2" form:"title" json:"title"`
-	Content   string  `thrift:"content,3" form:"content" json:"content"`
-	StartTime int64   `thrift:"start_time,4" form:"start_time" json:"start_time"`
-	EndTime   int64   `thrift:"end_time,5" form:"end_time" json:"end_time"`
-	IsAllDay  int16   `thrift:"is_all_day,6" form:"is_all_day" json:"is_all_day"`
-	Status    int16   `thrift:"status,7" form:"status" json:"status"`
-	Priority  int16   `thrift:"priority,8" form:"priority" json:"priority"`
-	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
-	CreatedAt int64   `thrift:"created_at,11" form:"created_at" json:"created_at"`
-	UpdatedAt int64   `thrift:"updated_at,12" form:"updated_at" json:"updated_at"`
+type GetUserInfoResponse struct {
+	UserID   string `thrift:"user_id,1" form:"user_id" json:"user_id"`
+	Username string `thrift:"username,2" form:"username" json:"username"`
 }
 
-func NewTodoItem() *TodoItem {
-	return &TodoItem{}
+func NewGetUserInfoResponse() *GetUserInfoResponse {
+	return &GetUserInfoResponse{}
 }
 
-func (p *TodoItem) InitDefault() {
+func (p *GetUserInfoResponse) InitDefault() {
 }
 
-func (p *TodoItem) GetID() (v string) {
-	return p.ID
-}
-
-func (p *TodoItem) GetTitle() (v string) {
-	return p.Title
-}
-
-func (p *TodoItem) GetContent() (v string) {
-	return p.Content
-}
-
-func (p *TodoItem) GetStartTime() (v int64) {
-	return p.StartTime
-}
-
-func (p *TodoItem) GetEndTime() (v int64) {
-	return p.EndTime
-}
-
-func (p *TodoItem) GetIsAllDay() (v int16) {
-	return p.IsAllDay
-}
-
-func (p *TodoItem) GetStatus() (v int16) {
-	return p.Status
-}
-
-func (p *TodoItem) GetPriority() (v int16) {
-	return p.Priority
-}
-
-var TodoItem_RemindAt_DEFAULT int64
-
-func (p *TodoItem) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return TodoItem_RemindAt_DEFAULT
-	}
-	return *p.RemindAt
-}
-
-var TodoItem_Category_DEFAULT string
-
-func (p *TodoItem) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return TodoItem_Category_DEFAULT
-	}
-	return *p.Category
-}
-
-func (p *TodoItem) GetCreatedAt() (v int64) {
-	return p.CreatedAt
-}
-
-func (p *TodoItem) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
-}
-
-var fieldIDToName_TodoItem = map[int16]string{
-	1:  "id",
-	2:  "title",
-	3:  "content",
-	4:  "start_time",
-	5:  "end_time",
-	6:  "is_all_day",
-	7:  "status",
-	8:  "priority",
-	9:  "remind_at",
-	10: "category",
-	11: "created_at",
-	12: "updated_at",
+func (p *GetUserInfoResponse) GetUserID() (v string) {
+	return p.UserID
 }
 
-func (p *TodoItem) IsSetRemindAt() bool {
-	return p.RemindAt != nil
+func (p *GetUserInfoResponse) GetUsername() (v string) {
+	return p.Username
 }
 
-func (p *TodoItem) IsSetCategory() bool {
-	return p.Category != nil
+var fieldIDToName_GetUserInfoResponse = map[int16]string{
+	1: "user_id",
+	2: "username",
 }
 
-func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4135,86 +4242,6 @@ func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4234,7 +4261,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TodoItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4244,7 +4271,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TodoItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4252,10 +4279,10 @@ func (p *TodoItem) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.UserID = _field
 	return nil
 }
-func (p *TodoItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4263,123 +4290,376 @@ func (p *TodoItem) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.Username = _field
 	return nil
 }
-func (p *TodoItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *GetUserInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserInfoResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Content = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *TodoItem) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *GetUserInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserID); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.StartTime = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TodoItem) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *GetUserInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Username); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.EndTime = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *TodoItem) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *GetUserInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.IsAllDay = _field
-	return nil
+	return fmt.Sprintf("GetUserInfoResponse(%+v)", *p)
+
 }
-func (p *TodoItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
+type CreateTodoRequest struct {
+	Title     string  `thrift:"title,1" form:"title" json:"title"`
+	Content   string  `thrift:"content,2" form:"content" json:"content"`
+	StartTime int64   `thrift:"start_time,3" form:"start_time" json:"start_time"`
+	EndTime   int64   `thrift:"end_time,4" form:"end_time" json:"end_time"`
+	IsAllDay  *int16  `thrift:"is_all_day,5,optional" form:"is_all_day" json:"is_all_day,omitempty"`
+	Priority  int16   `thrift:"priority,6" form:"priority" json:"priority"`
+	RemindAt  *int64  `thrift:"remind_at,7,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,8,optional" form:"category" json:"category,omitempty"`
+}
+
+func NewCreateTodoRequest() *CreateTodoRequest {
+	return &CreateTodoRequest{}
+}
+
+func (p *CreateTodoRequest) InitDefault() {
+}
+
+func (p *CreateTodoRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *CreateTodoRequest) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *CreateTodoRequest) GetStartTime() (v int64) {
+	return p.StartTime
+}
+
+func (p *CreateTodoRequest) GetEndTime() (v int64) {
+	return p.EndTime
+}
+
+var CreateTodoRequest_IsAllDay_DEFAULT int16
+
+func (p *CreateTodoRequest) GetIsAllDay() (v int16) {
+	if !p.IsSetIsAllDay() {
+		return CreateTodoRequest_IsAllDay_DEFAULT
+	}
+	return *p.IsAllDay
+}
+
+func (p *CreateTodoRequest) GetPriority() (v int16) {
+	return p.Priority
+}
+
+var CreateTodoRequest_RemindAt_DEFAULT int64
+
+func (p *CreateTodoRequest) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return CreateTodoRequest_RemindAt_DEFAULT
+	}
+	return *p.RemindAt
+}
+
+var CreateTodoRequest_Category_DEFAULT string
+
+func (p *CreateTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return CreateTodoRequest_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var fieldIDToName_CreateTodoRequest = map[int16]string{
+	1: "title",
+	2: "content",
+	3: "start_time",
+	4: "end_time",
+	5: "is_all_day",
+	6: "priority",
+	7: "remind_at",
+	8: "category",
+}
+
+func (p *CreateTodoRequest) IsSetIsAllDay() bool {
+	return p.IsAllDay != nil
+}
+
+func (p *CreateTodoRequest) IsSetRemindAt() bool {
+	return p.RemindAt != nil
+}
+
+func (p *CreateTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Status = _field
+	p.Title = _field
 	return nil
 }
-func (p *TodoItem) ReadField8(iprot thrift.TProtocol) error {
+func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Priority = _field
+	p.Content = _field
 	return nil
 }
-func (p *TodoItem) ReadField9(iprot thrift.TProtocol) error {
+func (p *CreateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.RemindAt = _field
+	p.StartTime = _field
 	return nil
 }
-func (p *TodoItem) ReadField10(iprot thrift.TProtocol) error {
+func (p *CreateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Category = _field
+	p.EndTime = _field
 	return nil
 }
-func (p *TodoItem) ReadField11(iprot thrift.TProtocol) error {
+func (p *CreateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.CreatedAt = _field
+	p.IsAllDay = _field
 	return nil
 }
-func (p *TodoItem) ReadField12(iprot thrift.TProtocol) error {
+func (p *CreateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.Priority = _field
 	return nil
 }
+func (p *CreateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TodoItem"); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RemindAt = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+
+func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4415,22 +4695,6 @@ func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4449,11 +4713,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TodoItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *CreateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4466,11 +4730,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *CreateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteString(p.Content); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4483,11 +4747,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+func (p *CreateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteI64(p.StartTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4500,11 +4764,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
+func (p *CreateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.StartTime); err != nil {
+	if err := oprot.WriteI64(p.EndTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4517,15 +4781,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EndTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *CreateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsAllDay() {
+		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4534,11 +4800,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
+func (p *CreateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I16, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI16(p.IsAllDay); err != nil {
+	if err := oprot.WriteI16(p.Priority); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4551,43 +4817,9 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *TodoItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Priority); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *TodoItem) writeField9(oprot thrift.TProtocol) (err error) {
+func (p *CreateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
 	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 7); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteI64(*p.RemindAt); err != nil {
@@ -4599,14 +4831,14 @@ func (p *TodoItem) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *CreateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
 	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 8); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteString(*p.Category); err != nil {
@@ -4618,82 +4850,39 @@ func (p *TodoItem) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
-
-func (p *TodoItem) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
-
-func (p *TodoItem) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *TodoItem) String() string {
+func (p *CreateTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TodoItem(%+v)", *p)
-
-}
+	return fmt.Sprintf("CreateTodoRequest(%+v)", *p)
 
-type GetTodoResponse struct {
-	Todo *TodoItem `thrift:"todo,1" form:"todo" json:"todo"`
 }
 
-func NewGetTodoResponse() *GetTodoResponse {
-	return &GetTodoResponse{}
+type CreateTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-func (p *GetTodoResponse) InitDefault() {
+func NewCreateTodoResponse() *CreateTodoResponse {
+	return &CreateTodoResponse{}
 }
 
-var GetTodoResponse_Todo_DEFAULT *TodoItem
-
-func (p *GetTodoResponse) GetTodo() (v *TodoItem) {
-	if !p.IsSetTodo() {
-		return GetTodoResponse_Todo_DEFAULT
-	}
-	return p.Todo
+func (p *CreateTodoResponse) InitDefault() {
 }
 
-var fieldIDToName_GetTodoResponse = map[int16]string{
-	1: "todo",
+func (p *CreateTodoResponse) GetID() (v string) {
+	return p.ID
 }
 
-func (p *GetTodoResponse) IsSetTodo() bool {
-	return p.Todo != nil
+var fieldIDToName_CreateTodoResponse = map[int16]string{
+	1: "id",
 }
 
-func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4713,7 +4902,7 @@ func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4739,7 +4928,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4749,18 +4938,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTodoItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *CreateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Todo = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *GetTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4786,11 +4978,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todo", thrift.STRUCT, 1); err != nil {
+func (p *CreateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Todo.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4803,117 +4995,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetTodoResponse) String() string {
+func (p *CreateTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetTodoResponse(%+v)", *p)
+	return fmt.Sprintf("CreateTodoResponse(%+v)", *p)
 
 }
 
-type ListTodoRequest struct {
+type GetTodoRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewListTodoRequest() *ListTodoRequest {
-	return &ListTodoRequest{}
+func NewGetTodoRequest() *GetTodoRequest {
+	return &GetTodoRequest{}
 }
 
-func (p *ListTodoRequest) InitDefault() {
+func (p *GetTodoRequest) InitDefault() {
 }
 
-var fieldIDToName_ListTodoRequest = map[int16]string{}
+func (p *GetTodoRequest) GetID() (v string) {
+	return p.ID
+}
 
-func (p *ListTodoRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ListTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ListTodoRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *ListTodoRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ListTodoRequest(%+v)", *p)
-
-}
-
-type ListTodoResponse struct {
-	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
-}
-
-func NewListTodoResponse() *ListTodoResponse {
-	return &ListTodoResponse{}
-}
-
-func (p *ListTodoResponse) InitDefault() {
-}
-
-func (p *ListTodoResponse) GetTodos() (v []*TodoItem) {
-	return p.Todos
-}
-
-var fieldIDToName_ListTodoResponse = map[int16]string{
-	1: "todos",
-}
-
-func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_GetTodoRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4933,7 +5042,7 @@ func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4959,7 +5068,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4969,33 +5078,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*TodoItem, 0, size)
-	values := make([]TodoItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *GetTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Todos = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *ListTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5021,19 +5118,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+func (p *GetTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
-		return err
-	}
-	for _, v := range p.Todos {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5046,73 +5135,118 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListTodoResponse) String() string {
+func (p *GetTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListTodoResponse(%+v)", *p)
+	return fmt.Sprintf("GetTodoRequest(%+v)", *p)
 
 }
 
-type SearchTodoRequest struct {
-	Status   *int16  `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
-	Priority *int16  `thrift:"priority,2,optional" json:"priority,omitempty" query:"priority"`
-	Category *string `thrift:"category,3,optional" json:"category,omitempty" query:"category"`
+type TodoItem struct {
+	ID        string  `thrift:"id,1" form:"id" json:"id"`
+	Title     string  `thrift:"title,2" form:"title" json:"title"`
+	Content   string  `thrift:"content,3" form:"content" json:"content"`
+	StartTime int64   `thrift:"start_time,4" form:"start_time" json:"start_time"`
+	EndTime   int64   `thrift:"end_time,5" form:"end_time" json:"end_time"`
+	IsAllDay  int16   `thrift:"is_all_day,6" form:"is_all_day" json:"is_all_day"`
+	Status    int16   `thrift:"status,7" form:"status" json:"status"`
+	Priority  int16   `thrift:"priority,8" form:"priority" json:"priority"`
+	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
+	CreatedAt int64   `thrift:"created_at,11" form:"created_at" json:"created_at"`
+	UpdatedAt int64   `thrift:"updated_at,12" form:"updated_at" json:"updated_at"`
 }
 
-func NewSearchTodoRequest() *SearchTodoRequest {
-	return &SearchTodoRequest{}
+func NewTodoItem() *TodoItem {
+	return &TodoItem{}
 }
 
-func (p *SearchTodoRequest) InitDefault() {
+func (p *TodoItem) InitDefault() {
 }
 
-var SearchTodoRequest_Status_DEFAULT int16
+func (p *TodoItem) GetID() (v string) {
+	return p.ID
+}
 
-func (p *SearchTodoRequest) GetStatus() (v int16) {
-	if !p.IsSetStatus() {
-		return SearchTodoRequest_Status_DEFAULT
-	}
-	return *p.Status
+func (p *TodoItem) GetTitle() (v string) {
+	return p.Title
 }
 
-var SearchTodoRequest_Priority_DEFAULT int16
+func (p *TodoItem) GetContent() (v string) {
+	return p.Content
+}
 
-func (p *SearchTodoRequest) GetPriority() (v int16) {
-	if !p.IsSetPriority() {
-		return SearchTodoRequest_Priority_DEFAULT
+func (p *TodoItem) GetStartTime() (v int64) {
+	return p.StartTime
+}
+
+func (p *TodoItem) GetEndTime() (v int64) {
+	return p.EndTime
+}
+
+func (p *TodoItem) GetIsAllDay() (v int16) {
+	return p.IsAllDay
+}
+
+func (p *TodoItem) GetStatus() (v int16) {
+	return p.Status
+}
+
+func (p *TodoItem) GetPriority() (v int16) {
+	return p.Priority
+}
+
+var TodoItem_RemindAt_DEFAULT int64
+
+func (p *TodoItem) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return TodoItem_RemindAt_DEFAULT
 	}
-	return *p.Priority
+	return *p.RemindAt
 }
 
-var SearchTodoRequest_Category_DEFAULT string
+var TodoItem_Category_DEFAULT string
 
-func (p *SearchTodoRequest) GetCategory() (v string) {
+func (p *TodoItem) GetCategory() (v string) {
 	if !p.IsSetCategory() {
-		return SearchTodoRequest_Category_DEFAULT
+		return TodoItem_Category_DEFAULT
 	}
 	return *p.Category
 }
 
-var fieldIDToName_SearchTodoRequest = map[int16]string{
-	1: "status",
-	2: "priority",
-	3: "category",
+func (p *TodoItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *SearchTodoRequest) IsSetStatus() bool {
-	return p.Status != nil
+func (p *TodoItem) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
 
-func (p *SearchTodoRequest) IsSetPriority() bool {
-	return p.Priority != nil
+var fieldIDToName_TodoItem = map[int16]string{
+	1:  "id",
+	2:  "title",
+	3:  "content",
+	4:  "start_time",
+	5:  "end_time",
+	6:  "is_all_day",
+	7:  "status",
+	8:  "priority",
+	9:  "remind_at",
+	10: "category",
+	11: "created_at",
+	12: "updated_at",
 }
 
-func (p *SearchTodoRequest) IsSetCategory() bool {
+func (p *TodoItem) IsSetRemindAt() bool {
+	return p.RemindAt != nil
+}
+
+func (p *TodoItem) IsSetCategory() bool {
 	return p.Category != nil
 }
 
-func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5132,7 +5266,7 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I16 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5140,7 +5274,7 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I16 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5155,12 +5289,84 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
 	}
@@ -5174,7 +5380,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TodoItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5184,29 +5390,106 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int16
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *TodoItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *TodoItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *TodoItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *TodoItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *TodoItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int16
 	if v, err := iprot.ReadI16(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
+	}
+	p.IsAllDay = _field
+	return nil
+}
+func (p *TodoItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
 	p.Status = _field
 	return nil
 }
-func (p *SearchTodoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int16
+	var _field int16
 	if v, err := iprot.ReadI16(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
 	p.Priority = _field
 	return nil
 }
-func (p *SearchTodoRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RemindAt = _field
+	return nil
+}
+func (p *TodoItem) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5217,10 +5500,32 @@ func (p *SearchTodoRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.Category = _field
 	return nil
 }
+func (p *TodoItem) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *TodoItem) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TodoItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5236,6 +5541,42 @@ func (p *SearchTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5254,17 +5595,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I16, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *TodoItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5273,12 +5612,131 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPriority() {
-		if err = oprot.WriteFieldBegin("priority", thrift.I16, 2); err != nil {
+func (p *TodoItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI16(p.IsAllDay); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI16(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI16(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemindAt() {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI16(*p.Priority); err != nil {
+		if err := oprot.WriteI64(*p.RemindAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5287,14 +5745,14 @@ func (p *SearchTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *TodoItem) writeField10(oprot thrift.TProtocol) (err error) {
 	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 3); err != nil {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteString(*p.Category); err != nil {
@@ -5306,39 +5764,82 @@ func (p *SearchTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) String() string {
+func (p *TodoItem) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *TodoItem) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *TodoItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchTodoRequest(%+v)", *p)
+	return fmt.Sprintf("TodoItem(%+v)", *p)
 
 }
 
-type SearchTodoResponse struct {
-	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
+type GetTodoResponse struct {
+	Todo *TodoItem `thrift:"todo,1" form:"todo" json:"todo"`
 }
 
-func NewSearchTodoResponse() *SearchTodoResponse {
-	return &SearchTodoResponse{}
+func NewGetTodoResponse() *GetTodoResponse {
+	return &GetTodoResponse{}
 }
 
-func (p *SearchTodoResponse) InitDefault() {
+func (p *GetTodoResponse) InitDefault() {
 }
 
-func (p *SearchTodoResponse) GetTodos() (v []*TodoItem) {
-	return p.Todos
+var GetTodoResponse_Todo_DEFAULT *TodoItem
+
+func (p *GetTodoResponse) GetTodo() (v *TodoItem) {
+	if !p.IsSetTodo() {
+		return GetTodoResponse_Todo_DEFAULT
+	}
+	return p.Todo
 }
 
-var fieldIDToName_SearchTodoResponse = map[int16]string{
-	1: "todos",
+var fieldIDToName_GetTodoResponse = map[int16]string{
+	1: "todo",
 }
 
-func (p *SearchTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetTodoResponse) IsSetTodo() bool {
+	return p.Todo != nil
+}
+
+func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5358,7 +5859,7 @@ func (p *SearchTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5384,7 +5885,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5394,33 +5895,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SearchTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*TodoItem, 0, size)
-	values := make([]TodoItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *GetTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTodoItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Todos = _field
+	p.Todo = _field
 	return nil
 }
 
-func (p *SearchTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5446,19 +5932,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SearchTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+func (p *GetTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todo", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
-		return err
-	}
-	for _, v := range p.Todos {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := p.Todo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5471,169 +5949,117 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SearchTodoResponse) String() string {
+func (p *GetTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchTodoResponse(%+v)", *p)
+	return fmt.Sprintf("GetTodoResponse(%+v)", *p)
 
 }
 
-type UpdateTodoRequest struct {
-	ID        string  `thrift:"id,1" form:"id" json:"id"`
-	Title     *string `thrift:"title,2,optional" form:"title" json:"title,omitempty"`
-	Content   *string `thrift:"content,3,optional" form:"content" json:"content,omitempty"`
-	StartTime *int64  `thrift:"start_time,4,optional" form:"start_time" json:"start_time,omitempty"`
-	EndTime   *int64  `thrift:"end_time,5,optional" form:"end_time" json:"end_time,omitempty"`
-	IsAllDay  *int16  `thrift:"is_all_day,6,optional" form:"is_all_day" json:"is_all_day,omitempty"`
-	Status    *int16  `thrift:"status,7,optional" form:"status" json:"status,omitempty"`
-	Priority  *int16  `thrift:"priority,8,optional" form:"priority" json:"priority,omitempty"`
-	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
-}
-
-func NewUpdateTodoRequest() *UpdateTodoRequest {
-	return &UpdateTodoRequest{}
+type ListTodoRequest struct {
 }
 
-func (p *UpdateTodoRequest) InitDefault() {
+func NewListTodoRequest() *ListTodoRequest {
+	return &ListTodoRequest{}
 }
 
-func (p *UpdateTodoRequest) GetID() (v string) {
-	return p.ID
+func (p *ListTodoRequest) InitDefault() {
 }
 
-var UpdateTodoRequest_Title_DEFAULT string
+var fieldIDToName_ListTodoRequest = map[int16]string{}
 
-func (p *UpdateTodoRequest) GetTitle() (v string) {
-	if !p.IsSetTitle() {
-		return UpdateTodoRequest_Title_DEFAULT
-	}
-	return *p.Title
-}
+func (p *ListTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
-var UpdateTodoRequest_Content_DEFAULT string
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *UpdateTodoRequest) GetContent() (v string) {
-	if !p.IsSetContent() {
-		return UpdateTodoRequest_Content_DEFAULT
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.Content
-}
-
-var UpdateTodoRequest_StartTime_DEFAULT int64
 
-func (p *UpdateTodoRequest) GetStartTime() (v int64) {
-	if !p.IsSetStartTime() {
-		return UpdateTodoRequest_StartTime_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return *p.StartTime
-}
-
-var UpdateTodoRequest_EndTime_DEFAULT int64
-
-func (p *UpdateTodoRequest) GetEndTime() (v int64) {
-	if !p.IsSetEndTime() {
-		return UpdateTodoRequest_EndTime_DEFAULT
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return *p.EndTime
-}
 
-var UpdateTodoRequest_IsAllDay_DEFAULT int16
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
-func (p *UpdateTodoRequest) GetIsAllDay() (v int16) {
-	if !p.IsSetIsAllDay() {
-		return UpdateTodoRequest_IsAllDay_DEFAULT
-	}
-	return *p.IsAllDay
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var UpdateTodoRequest_Status_DEFAULT int16
-
-func (p *UpdateTodoRequest) GetStatus() (v int16) {
-	if !p.IsSetStatus() {
-		return UpdateTodoRequest_Status_DEFAULT
+func (p *ListTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListTodoRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return *p.Status
-}
-
-var UpdateTodoRequest_Priority_DEFAULT int16
-
-func (p *UpdateTodoRequest) GetPriority() (v int16) {
-	if !p.IsSetPriority() {
-		return UpdateTodoRequest_Priority_DEFAULT
+	if p != nil {
 	}
-	return *p.Priority
-}
-
-var UpdateTodoRequest_RemindAt_DEFAULT int64
-
-func (p *UpdateTodoRequest) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return UpdateTodoRequest_RemindAt_DEFAULT
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return *p.RemindAt
-}
-
-var UpdateTodoRequest_Category_DEFAULT string
-
-func (p *UpdateTodoRequest) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return UpdateTodoRequest_Category_DEFAULT
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return *p.Category
-}
-
-var fieldIDToName_UpdateTodoRequest = map[int16]string{
-	1:  "id",
-	2:  "title",
-	3:  "content",
-	4:  "start_time",
-	5:  "end_time",
-	6:  "is_all_day",
-	7:  "status",
-	8:  "priority",
-	9:  "remind_at",
-	10: "category",
-}
-
-func (p *UpdateTodoRequest) IsSetTitle() bool {
-	return p.Title != nil
-}
-
-func (p *UpdateTodoRequest) IsSetContent() bool {
-	return p.Content != nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) IsSetStartTime() bool {
-	return p.StartTime != nil
-}
+func (p *ListTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTodoRequest(%+v)", *p)
 
-func (p *UpdateTodoRequest) IsSetEndTime() bool {
-	return p.EndTime != nil
 }
 
-func (p *UpdateTodoRequest) IsSetIsAllDay() bool {
-	return p.IsAllDay != nil
+type ListTodoResponse struct {
+	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
 }
 
-func (p *UpdateTodoRequest) IsSetStatus() bool {
-	return p.Status != nil
+func NewListTodoResponse() *ListTodoResponse {
+	return &ListTodoResponse{}
 }
 
-func (p *UpdateTodoRequest) IsSetPriority() bool {
-	return p.Priority != nil
+func (p *ListTodoResponse) InitDefault() {
 }
 
-func (p *UpdateTodoRequest) IsSetRemindAt() bool {
-	return p.RemindAt != nil
+func (p *ListTodoResponse) GetTodos() (v []*TodoItem) {
+	return p.Todos
 }
 
-func (p *UpdateTodoRequest) IsSetCategory() bool {
-	return p.Category != nil
+var fieldIDToName_ListTodoResponse = map[int16]string{
+	1: "todos",
 }
 
-func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5653,85 +6079,13 @@ func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5751,7 +6105,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5761,73 +6115,222 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TodoItem, 0, size)
+	values := make([]TodoItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Todos = _field
 	return nil
 }
-func (p *UpdateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ListTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListTodoResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Title = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *UpdateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ListTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Content = _field
+	for _, v := range p.Todos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ListTodoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.StartTime = _field
-	return nil
+	return fmt.Sprintf("ListTodoResponse(%+v)", *p)
+
 }
-func (p *UpdateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+type SearchTodoRequest struct {
+	Status   *int16  `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
+	Priority *int16  `thrift:"priority,2,optional" json:"priority,omitempty" query:"priority"`
+	Category *string `thrift:"category,3,optional" json:"category,omitempty" query:"category"`
+}
+
+func NewSearchTodoRequest() *SearchTodoRequest {
+	return &SearchTodoRequest{}
+}
+
+func (p *SearchTodoRequest) InitDefault() {
+}
+
+var SearchTodoRequest_Status_DEFAULT int16
+
+func (p *SearchTodoRequest) GetStatus() (v int16) {
+	if !p.IsSetStatus() {
+		return SearchTodoRequest_Status_DEFAULT
 	}
-	p.EndTime = _field
-	return nil
+	return *p.Status
 }
-func (p *UpdateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
+var SearchTodoRequest_Priority_DEFAULT int16
+
+func (p *SearchTodoRequest) GetPriority() (v int16) {
+	if !p.IsSetPriority() {
+		return SearchTodoRequest_Priority_DEFAULT
 	}
-	p.IsAllDay = _field
+	return *p.Priority
+}
+
+var SearchTodoRequest_Category_DEFAULT string
+
+func (p *SearchTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return SearchTodoRequest_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var fieldIDToName_SearchTodoRequest = map[int16]string{
+	1: "status",
+	2: "priority",
+	3: "category",
+}
+
+func (p *SearchTodoRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *SearchTodoRequest) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *SearchTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *UpdateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
+
+func (p *SearchTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int16
 	if v, err := iprot.ReadI16(); err != nil {
@@ -5838,7 +6341,7 @@ func (p *UpdateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
 	p.Status = _field
 	return nil
 }
-func (p *UpdateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
+func (p *SearchTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int16
 	if v, err := iprot.ReadI16(); err != nil {
@@ -5849,18 +6352,7 @@ func (p *UpdateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
 	p.Priority = _field
 	return nil
 }
-func (p *UpdateTodoRequest) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.RemindAt = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField10(iprot thrift.TProtocol) error {
+func (p *SearchTodoRequest) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5872,9 +6364,9 @@ func (p *UpdateTodoRequest) ReadField10(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5890,34 +6382,6 @@ func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5936,121 +6400,9 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTitle() {
-		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Title); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContent() {
-		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Content); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartTime() {
-		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.StartTime); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEndTime() {
-		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EndTime); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsAllDay() {
-		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
+		if err = oprot.WriteFieldBegin("status", thrift.I16, 1); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteI16(*p.Status); err != nil {
@@ -6062,14 +6414,14 @@ func (p *UpdateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetPriority() {
-		if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
+		if err = oprot.WriteFieldBegin("priority", thrift.I16, 2); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteI16(*p.Priority); err != nil {
@@ -6081,33 +6433,14 @@ func (p *UpdateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *UpdateTodoRequest) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.RemindAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteString(*p.Category); err != nil {
@@ -6119,39 +6452,39 @@ func (p *UpdateTodoRequest) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) String() string {
+func (p *SearchTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateTodoRequest(%+v)", *p)
+	return fmt.Sprintf("SearchTodoRequest(%+v)", *p)
 
 }
 
-type UpdateTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type SearchTodoResponse struct {
+	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
 }
 
-func NewUpdateTodoResponse() *UpdateTodoResponse {
-	return &UpdateTodoResponse{}
+func NewSearchTodoResponse() *SearchTodoResponse {
+	return &SearchTodoResponse{}
 }
 
-func (p *UpdateTodoResponse) InitDefault() {
+func (p *SearchTodoResponse) InitDefault() {
 }
 
-func (p *UpdateTodoResponse) GetID() (v string) {
-	return p.ID
+func (p *SearchTodoResponse) GetTodos() (v []*TodoItem) {
+	return p.Todos
 }
 
-var fieldIDToName_UpdateTodoResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_SearchTodoResponse = map[int16]string{
+	1: "todos",
 }
 
-func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6171,7 +6504,7 @@ func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6197,7 +6530,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6207,21 +6540,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TodoItem, 0, size)
+	values := make([]TodoItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Todos = _field
 	return nil
 }
 
-func (p *UpdateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6247,11 +6592,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *SearchTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
+		return err
+	}
+	for _, v := range p.Todos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6264,34 +6617,169 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) String() string {
+func (p *SearchTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateTodoResponse(%+v)", *p)
+	return fmt.Sprintf("SearchTodoResponse(%+v)", *p)
 
 }
 
-type DeleteTodoRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+type UpdateTodoRequest struct {
+	ID        string  `thrift:"id,1" form:"id" json:"id"`
+	Title     *string `thrift:"title,2,optional" form:"title" json:"title,omitempty"`
+	Content   *string `thrift:"content,3,optional" form:"content" json:"content,omitempty"`
+	StartTime *int64  `thrift:"start_time,4,optional" form:"start_time" json:"start_time,omitempty"`
+	EndTime   *int64  `thrift:"end_time,5,optional" form:"end_time" json:"end_time,omitempty"`
+	IsAllDay  *int16  `thrift:"is_all_day,6,optional" form:"is_all_day" json:"is_all_day,omitempty"`
+	Status    *int16  `thrift:"status,7,optional" form:"status" json:"status,omitempty"`
+	Priority  *int16  `thrift:"priority,8,optional" form:"priority" json:"priority,omitempty"`
+	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
 }
 
-func NewDeleteTodoRequest() *DeleteTodoRequest {
-	return &DeleteTodoRequest{}
+func NewUpdateTodoRequest() *UpdateTodoRequest {
+	return &UpdateTodoRequest{}
 }
 
-func (p *DeleteTodoRequest) InitDefault() {
+func (p *UpdateTodoRequest) InitDefault() {
 }
 
-func (p *DeleteTodoRequest) GetID() (v string) {
+func (p *UpdateTodoRequest) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_DeleteTodoRequest = map[int16]string{
-	1: "id",
+var UpdateTodoRequest_Title_DEFAULT string
+
+func (p *UpdateTodoRequest) GetTitle() (v string) {
+	if !p.IsSetTitle() {
+		return UpdateTodoRequest_Title_DEFAULT
+	}
+	return *p.Title
 }
 
-func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+var UpdateTodoRequest_Content_DEFAULT string
+
+func (p *UpdateTodoRequest) GetContent() (v string) {
+	if !p.IsSetContent() {
+		return UpdateTodoRequest_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+var UpdateTodoRequest_StartTime_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return UpdateTodoRequest_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var UpdateTodoRequest_EndTime_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return UpdateTodoRequest_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var UpdateTodoRequest_IsAllDay_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetIsAllDay() (v int16) {
+	if !p.IsSetIsAllDay() {
+		return UpdateTodoRequest_IsAllDay_DEFAULT
+	}
+	return *p.IsAllDay
+}
+
+var UpdateTodoRequest_Status_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetStatus() (v int16) {
+	if !p.IsSetStatus() {
+		return UpdateTodoRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateTodoRequest_Priority_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetPriority() (v int16) {
+	if !p.IsSetPriority() {
+		return UpdateTodoRequest_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var UpdateTodoRequest_RemindAt_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return UpdateTodoRequest_RemindAt_DEFAULT
+	}
+	return *p.RemindAt
+}
+
+var UpdateTodoRequest_Category_DEFAULT string
+
+func (p *UpdateTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return UpdateTodoRequest_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var fieldIDToName_UpdateTodoRequest = map[int16]string{
+	1:  "id",
+	2:  "title",
+	3:  "content",
+	4:  "start_time",
+	5:  "end_time",
+	6:  "is_all_day",
+	7:  "status",
+	8:  "priority",
+	9:  "remind_at",
+	10: "category",
+}
+
+func (p *UpdateTodoRequest) IsSetTitle() bool {
+	return p.Title != nil
+}
+
+func (p *UpdateTodoRequest) IsSetContent() bool {
+	return p.Content != nil
+}
+
+func (p *UpdateTodoRequest) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *UpdateTodoRequest) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *UpdateTodoRequest) IsSetIsAllDay() bool {
+	return p.IsAllDay != nil
+}
+
+func (p *UpdateTodoRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateTodoRequest) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *UpdateTodoRequest) IsSetRemindAt() bool {
+	return p.RemindAt != nil
+}
+
+func (p *UpdateTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6318,6 +6806,78 @@ func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6337,7 +6897,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6347,7 +6907,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6358,150 +6918,109 @@ func (p *DeleteTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
+func (p *UpdateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodoRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Title = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *UpdateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Content = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *UpdateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoRequest) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return fmt.Sprintf("DeleteTodoRequest(%+v)", *p)
-
-}
-
-type DeleteTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
-}
-
-func NewDeleteTodoResponse() *DeleteTodoResponse {
-	return &DeleteTodoResponse{}
+	p.StartTime = _field
+	return nil
 }
+func (p *UpdateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoResponse) InitDefault() {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
 }
+func (p *UpdateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoResponse) GetID() (v string) {
-	return p.ID
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsAllDay = _field
+	return nil
 }
+func (p *UpdateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
 
-var fieldIDToName_DeleteTodoResponse = map[int16]string{
-	1: "id",
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
 }
+func (p *UpdateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoResponse) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Priority = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField9(iprot thrift.TProtocol) error {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-
+	p.RemindAt = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
+func (p *UpdateTodoRequest) ReadField10(iprot thrift.TProtocol) error {
 
-func (p *DeleteTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ID = _field
+	p.Category = _field
 	return nil
 }
 
-func (p *DeleteTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6509,6 +7028,42 @@ func (p *DeleteTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6527,7 +7082,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6544,35 +7099,205 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteTodoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTitle() {
+		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Title); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("DeleteTodoResponse(%+v)", *p)
-
-}
-
-// ==================== 知识库(Summarize)相关接口 ====================
-type GetSummaryRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func NewGetSummaryRequest() *GetSummaryRequest {
-	return &GetSummaryRequest{}
+func (p *UpdateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContent() {
+		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Content); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) InitDefault() {
+func (p *UpdateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) GetID() (v string) {
+func (p *UpdateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsAllDay() {
+		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Priority); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemindAt() {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RemindAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *UpdateTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTodoRequest(%+v)", *p)
+
+}
+
+type UpdateTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewUpdateTodoResponse() *UpdateTodoResponse {
+	return &UpdateTodoResponse{}
+}
+
+func (p *UpdateTodoResponse) InitDefault() {
+}
+
+func (p *UpdateTodoResponse) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_GetSummaryRequest = map[int16]string{
+var fieldIDToName_UpdateTodoResponse = map[int16]string{
 	1: "id",
 }
 
-func (p *GetSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6618,7 +7343,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6628,7 +7353,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6640,9 +7365,9 @@ func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummaryRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6668,7 +7393,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6685,76 +7410,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) String() string {
+func (p *UpdateTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetSummaryRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateTodoResponse(%+v)", *p)
 
 }
 
-type SummaryItem struct {
-	ID             string            `thrift:"id,1" form:"id" json:"id"`
-	ConversationID string            `thrift:"conversation_id,2" form:"conversation_id" json:"conversation_id"`
-	SummaryText    string            `thrift:"summary_text,3" form:"summary_text" json:"summary_text"`
-	Tags           []string          `thrift:"tags,4,default,list<string>" form:"tags" json:"tags"`
-	ToolCallsJSON  string            `thrift:"tool_calls_json,5" form:"tool_calls_json" json:"tool_calls_json"`
-	Notes          map[string]string `thrift:"notes,6" form:"notes" json:"notes"`
-	CreatedAt      int64             `thrift:"created_at,7" form:"created_at" json:"created_at"`
-	UpdatedAt      int64             `thrift:"updated_at,8" form:"updated_at" json:"updated_at"`
+type DeleteTodoRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewSummaryItem() *SummaryItem {
-	return &SummaryItem{}
+func NewDeleteTodoRequest() *DeleteTodoRequest {
+	return &DeleteTodoRequest{}
 }
 
-func (p *SummaryItem) InitDefault() {
+func (p *DeleteTodoRequest) InitDefault() {
 }
 
-func (p *SummaryItem) GetID() (v string) {
+func (p *DeleteTodoRequest) GetID() (v string) {
 	return p.ID
 }
 
-func (p *SummaryItem) GetConversationID() (v string) {
-	return p.ConversationID
-}
-
-func (p *SummaryItem) GetSummaryText() (v string) {
-	return p.SummaryText
-}
-
-func (p *SummaryItem) GetTags() (v []string) {
-	return p.Tags
-}
-
-func (p *SummaryItem) GetToolCallsJSON() (v string) {
-	return p.ToolCallsJSON
-}
-
-func (p *SummaryItem) GetNotes() (v map[string]string) {
-	return p.Notes
-}
-
-func (p *SummaryItem) GetCreatedAt() (v int64) {
-	return p.CreatedAt
-}
-
-func (p *SummaryItem) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
-}
-
-var fieldIDToName_SummaryItem = map[int16]string{
+var fieldIDToName_DeleteTodoRequest = map[int16]string{
 	1: "id",
-	2: "conversation_id",
-	3: "summary_text",
-	4: "tags",
-	5: "tool_calls_json",
-	6: "notes",
-	7: "created_at",
-	8: "updated_at",
 }
 
-func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6781,64 +7464,8 @@ func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -6856,7 +7483,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummaryItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6866,7 +7493,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6877,117 +7504,150 @@ func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *SummaryItem) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *DeleteTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteTodoRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.ConversationID = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *SummaryItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *DeleteTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.SummaryText = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *SummaryItem) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+
+func (p *DeleteTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
+	return fmt.Sprintf("DeleteTodoRequest(%+v)", *p)
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
+type DeleteTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
 }
-func (p *SummaryItem) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ToolCallsJSON = _field
-	return nil
+func NewDeleteTodoResponse() *DeleteTodoResponse {
+	return &DeleteTodoResponse{}
 }
-func (p *SummaryItem) ReadField6(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
+
+func (p *DeleteTodoResponse) InitDefault() {
+}
+
+func (p *DeleteTodoResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteTodoResponse = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
 
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
-		_field[_key] = _val
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	p.Notes = _field
-	return nil
-}
-func (p *SummaryItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *SummaryItem) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *DeleteTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummaryItem"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6995,34 +7655,6 @@ func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7041,7 +7673,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DeleteTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7058,181 +7690,35 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *DeleteTodoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.SummaryText); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-		return err
-	}
-	for _, v := range p.Tags {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-		return err
-	}
-	for k, v := range p.Notes {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *SummaryItem) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SummaryItem(%+v)", *p)
-
-}
+	return fmt.Sprintf("DeleteTodoResponse(%+v)", *p)
 
-type GetSummaryResponse struct {
-	Summary *SummaryItem `thrift:"summary,1" form:"summary" json:"summary"`
 }
 
-func NewGetSummaryResponse() *GetSummaryResponse {
-	return &GetSummaryResponse{}
+// ==================== 知识库(Summarize)相关接口 ====================
+type GetSummaryRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func (p *GetSummaryResponse) InitDefault() {
+func NewGetSummaryRequest() *GetSummaryRequest {
+	return &GetSummaryRequest{}
 }
 
-var GetSummaryResponse_Summary_DEFAULT *SummaryItem
-
-func (p *GetSummaryResponse) GetSummary() (v *SummaryItem) {
-	if !p.IsSetSummary() {
-		return GetSummaryResponse_Summary_DEFAULT
-	}
-	return p.Summary
+func (p *GetSummaryRequest) InitDefault() {
 }
 
-var fieldIDToName_GetSummaryResponse = map[int16]string{
-	1: "summary",
+func (p *GetSummaryRequest) GetID() (v string) {
+	return p.ID
 }
 
-func (p *GetSummaryResponse) IsSetSummary() bool {
-	return p.Summary != nil
+var fieldIDToName_GetSummaryRequest = map[int16]string{
+	1: "id",
 }
 
-func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7252,7 +7738,7 @@ func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7278,7 +7764,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7288,18 +7774,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSummaryItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Summary = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *GetSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummaryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7325,11 +7814,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary", thrift.STRUCT, 1); err != nil {
+func (p *GetSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Summary.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7342,117 +7831,76 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) String() string {
+func (p *GetSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetSummaryResponse(%+v)", *p)
+	return fmt.Sprintf("GetSummaryRequest(%+v)", *p)
 
 }
 
-type ListSummaryRequest struct {
+type SummaryItem struct {
+	ID             string            `thrift:"id,1" form:"id" json:"id"`
+	ConversationID string            `thrift:"conversation_id,2" form:"conversation_id" json:"conversation_id"`
+	SummaryText    string            `thrift:"summary_text,3" form:"summary_text" json:"summary_text"`
+	Tags           []string          `thrift:"tags,4,default,list<string>" form:"tags" json:"tags"`
+	ToolCallsJSON  string            `thrift:"tool_calls_json,5" form:"tool_calls_json" json:"tool_calls_json"`
+	Notes          map[string]string `thrift:"notes,6" form:"notes" json:"notes"`
+	CreatedAt      int64             `thrift:"created_at,7" form:"created_at" json:"created_at"`
+	UpdatedAt      int64             `thrift:"updated_at,8" form:"updated_at" json:"updated_at"`
 }
 
-func NewListSummaryRequest() *ListSummaryRequest {
-	return &ListSummaryRequest{}
+func NewSummaryItem() *SummaryItem {
+	return &SummaryItem{}
 }
 
-func (p *ListSummaryRequest) InitDefault() {
+func (p *SummaryItem) InitDefault() {
 }
 
-var fieldIDToName_ListSummaryRequest = map[int16]string{}
+func (p *SummaryItem) GetID() (v string) {
+	return p.ID
+}
 
-func (p *ListSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ListSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ListSummaryRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *ListSummaryRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ListSummaryRequest(%+v)", *p)
-
-}
-
-type ListSummaryResponse struct {
-	Summaries []*SummaryItem `thrift:"summaries,1,default,list<SummaryItem>" form:"summaries" json:"summaries"`
-}
-
-func NewListSummaryResponse() *ListSummaryResponse {
-	return &ListSummaryResponse{}
-}
-
-func (p *ListSummaryResponse) InitDefault() {
-}
-
-func (p *ListSummaryResponse) GetSummaries() (v []*SummaryItem) {
-	return p.Summaries
-}
-
-var fieldIDToName_ListSummaryResponse = map[int16]string{
-	1: "summaries",
-}
-
-func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SummaryItem) GetConversationID() (v string) {
+	return p.ConversationID
+}
+
+func (p *SummaryItem) GetSummaryText() (v string) {
+	return p.SummaryText
+}
+
+func (p *SummaryItem) GetTags() (v []string) {
+	return p.Tags
+}
+
+func (p *SummaryItem) GetToolCallsJSON() (v string) {
+	return p.ToolCallsJSON
+}
+
+func (p *SummaryItem) GetNotes() (v map[string]string) {
+	return p.Notes
+}
+
+func (p *SummaryItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *SummaryItem) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_SummaryItem = map[int16]string{
+	1: "id",
+	2: "conversation_id",
+	3: "summary_text",
+	4: "tags",
+	5: "tool_calls_json",
+	6: "notes",
+	7: "created_at",
+	8: "updated_at",
+}
+
+func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7472,13 +7920,69 @@ func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7498,7 +8002,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummaryItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7508,19 +8012,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *SummaryItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConversationID = _field
+	return nil
+}
+func (p *SummaryItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SummaryText = _field
+	return nil
+}
+func (p *SummaryItem) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*SummaryItem, 0, size)
-	values := make([]SummaryItem, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -7528,151 +8065,320 @@ func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Summaries = _field
+	p.Tags = _field
 	return nil
 }
+func (p *SummaryItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *ListSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSummaryResponse"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.ToolCallsJSON = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-
-func (p *ListSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summaries", thrift.LIST, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Summaries)); err != nil {
+func (p *SummaryItem) ReadField6(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
 		return err
 	}
-	for _, v := range p.Summaries {
-		if err := v.Write(oprot); err != nil {
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_val = v
 		}
+
+		_field[_key] = _val
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Notes = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *SummaryItem) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *ListSummaryResponse) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return fmt.Sprintf("ListSummaryResponse(%+v)", *p)
-
+	p.CreatedAt = _field
+	return nil
 }
+func (p *SummaryItem) ReadField8(iprot thrift.TProtocol) error {
 
-type UpdateSummaryRequest struct {
-	ID            string            `thrift:"id,1" form:"id" json:"id"`
-	SummaryText   *string           `thrift:"summary_text,2,optional" form:"summary_text" json:"summary_text,omitempty"`
-	Tags          []string          `thrift:"tags,3,optional,list<string>" form:"tags" json:"tags,omitempty"`
-	ToolCallsJSON *string           `thrift:"tool_calls_json,4,optional" form:"tool_calls_json" json:"tool_calls_json,omitempty"`
-	Notes         map[string]string `thrift:"notes,5,optional" form:"notes" json:"notes,omitempty"`
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
 }
 
-func NewUpdateSummaryRequest() *UpdateSummaryRequest {
-	return &UpdateSummaryRequest{}
+func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SummaryItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) InitDefault() {
+func (p *SummaryItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) GetID() (v string) {
-	return p.ID
+func (p *SummaryItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_SummaryText_DEFAULT string
-
-func (p *UpdateSummaryRequest) GetSummaryText() (v string) {
-	if !p.IsSetSummaryText() {
-		return UpdateSummaryRequest_SummaryText_DEFAULT
+func (p *SummaryItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.SummaryText
+	if err := oprot.WriteString(p.SummaryText); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_Tags_DEFAULT []string
-
-func (p *UpdateSummaryRequest) GetTags() (v []string) {
-	if !p.IsSetTags() {
-		return UpdateSummaryRequest_Tags_DEFAULT
+func (p *SummaryItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.Tags
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_ToolCallsJSON_DEFAULT string
-
-func (p *UpdateSummaryRequest) GetToolCallsJSON() (v string) {
-	if !p.IsSetToolCallsJSON() {
-		return UpdateSummaryRequest_ToolCallsJSON_DEFAULT
+func (p *SummaryItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.ToolCallsJSON
+	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_Notes_DEFAULT map[string]string
-
-func (p *UpdateSummaryRequest) GetNotes() (v map[string]string) {
-	if !p.IsSetNotes() {
-		return UpdateSummaryRequest_Notes_DEFAULT
+func (p *SummaryItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.Notes
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+		return err
+	}
+	for k, v := range p.Notes {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-var fieldIDToName_UpdateSummaryRequest = map[int16]string{
-	1: "id",
-	2: "summary_text",
-	3: "tags",
-	4: "tool_calls_json",
-	5: "notes",
+func (p *SummaryItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) IsSetSummaryText() bool {
-	return p.SummaryText != nil
+func (p *SummaryItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) IsSetTags() bool {
-	return p.Tags != nil
+func (p *SummaryItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SummaryItem(%+v)", *p)
+
 }
 
-func (p *UpdateSummaryRequest) IsSetToolCallsJSON() bool {
-	return p.ToolCallsJSON != nil
+type GetSummaryResponse struct {
+	Summary *SummaryItem `thrift:"summary,1" form:"summary" json:"summary"`
 }
 
-func (p *UpdateSummaryRequest) IsSetNotes() bool {
-	return p.Notes != nil
+func NewGetSummaryResponse() *GetSummaryResponse {
+	return &GetSummaryResponse{}
 }
 
-func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetSummaryResponse) InitDefault() {
+}
+
+var GetSummaryResponse_Summary_DEFAULT *SummaryItem
+
+func (p *GetSummaryResponse) GetSummary() (v *SummaryItem) {
+	if !p.IsSetSummary() {
+		return GetSummaryResponse_Summary_DEFAULT
+	}
+	return p.Summary
+}
+
+var fieldIDToName_GetSummaryResponse = map[int16]string{
+	1: "summary",
+}
+
+func (p *GetSummaryResponse) IsSetSummary() bool {
+	return p.Summary != nil
+}
+
+func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7692,45 +8398,13 @@ func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7750,7 +8424,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7760,95 +8434,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SummaryText = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ToolCallsJSON = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+func (p *GetSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSummaryItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Notes = _field
+	p.Summary = _field
 	return nil
 }
 
-func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummaryRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7856,22 +8453,6 @@ func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7890,11 +8471,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *GetSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := p.Summary.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7907,129 +8488,117 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSummaryText() {
-		if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SummaryText); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetSummaryResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return fmt.Sprintf("GetSummaryResponse(%+v)", *p)
+
 }
 
-func (p *UpdateSummaryRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-			return err
+type ListSummaryRequest struct {
+}
+
+func NewListSummaryRequest() *ListSummaryRequest {
+	return &ListSummaryRequest{}
+}
+
+func (p *ListSummaryRequest) InitDefault() {
+}
+
+var fieldIDToName_ListSummaryRequest = map[int16]string{}
+
+func (p *ListSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		for _, v := range p.Tags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
-func (p *UpdateSummaryRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolCallsJSON() {
-		if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ToolCallsJSON); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNotes() {
-		if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-			return err
-		}
-		for k, v := range p.Notes {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListSummaryRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) String() string {
+func (p *ListSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateSummaryRequest(%+v)", *p)
+	return fmt.Sprintf("ListSummaryRequest(%+v)", *p)
 
 }
 
-type UpdateSummaryResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type ListSummaryResponse struct {
+	Summaries []*SummaryItem `thrift:"summaries,1,default,list<SummaryItem>" form:"summaries" json:"summaries"`
 }
 
-func NewUpdateSummaryResponse() *UpdateSummaryResponse {
-	return &UpdateSummaryResponse{}
+func NewListSummaryResponse() *ListSummaryResponse {
+	return &ListSummaryResponse{}
 }
 
-func (p *UpdateSummaryResponse) InitDefault() {
+func (p *ListSummaryResponse) InitDefault() {
 }
 
-func (p *UpdateSummaryResponse) GetID() (v string) {
-	return p.ID
+func (p *ListSummaryResponse) GetSummaries() (v []*SummaryItem) {
+	return p.Summaries
 }
 
-var fieldIDToName_UpdateSummaryResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_ListSummaryResponse = map[int16]string{
+	1: "summaries",
 }
 
-func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8049,7 +8618,7 @@ func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8075,7 +8644,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8085,21 +8654,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SummaryItem, 0, size)
+	values := make([]SummaryItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Summaries = _field
 	return nil
 }
 
-func (p *UpdateSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummaryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8125,11 +8706,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ListSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summaries", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Summaries)); err != nil {
+		return err
+	}
+	for _, v := range p.Summaries {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8142,34 +8731,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateSummaryResponse) String() string {
+func (p *ListSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateSummaryResponse(%+v)", *p)
+	return fmt.Sprintf("ListSummaryResponse(%+v)", *p)
 
 }
 
-type DeleteSummaryRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+type UpdateSummaryRequest struct {
+	ID            string            `thrift:"id,1" form:"id" json:"id"`
+	SummaryText   *string           `thrift:"summary_text,2,optional" form:"summary_text" json:"summary_text,omitempty"`
+	Tags          []string          `thrift:"tags,3,optional,list<string>" form:"tags" json:"tags,omitempty"`
+	ToolCallsJSON *string           `thrift:"tool_calls_json,4,optional" form:"tool_calls_json" json:"tool_calls_json,omitempty"`
+	Notes         map[string]string `thrift:"notes,5,optional" form:"notes" json:"notes,omitempty"`
 }
 
-func NewDeleteSummaryRequest() *DeleteSummaryRequest {
-	return &DeleteSummaryRequest{}
+func NewUpdateSummaryRequest() *UpdateSummaryRequest {
+	return &UpdateSummaryRequest{}
 }
 
-func (p *DeleteSummaryRequest) InitDefault() {
+func (p *UpdateSummaryRequest) InitDefault() {
 }
 
-func (p *DeleteSummaryRequest) GetID() (v string) {
+func (p *UpdateSummaryRequest) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_DeleteSummaryRequest = map[int16]string{
+var UpdateSummaryRequest_SummaryText_DEFAULT string
+
+func (p *UpdateSummaryRequest) GetSummaryText() (v string) {
+	if !p.IsSetSummaryText() {
+		return UpdateSummaryRequest_SummaryText_DEFAULT
+	}
+	return *p.SummaryText
+}
+
+var UpdateSummaryRequest_Tags_DEFAULT []string
+
+func (p *UpdateSummaryRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return UpdateSummaryRequest_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var UpdateSummaryRequest_ToolCallsJSON_DEFAULT string
+
+func (p *UpdateSummaryRequest) GetToolCallsJSON() (v string) {
+	if !p.IsSetToolCallsJSON() {
+		return UpdateSummaryRequest_ToolCallsJSON_DEFAULT
+	}
+	return *p.ToolCallsJSON
+}
+
+var UpdateSummaryRequest_Notes_DEFAULT map[string]string
+
+func (p *UpdateSummaryRequest) GetNotes() (v map[string]string) {
+	if !p.IsSetNotes() {
+		return UpdateSummaryRequest_Notes_DEFAULT
+	}
+	return p.Notes
+}
+
+var fieldIDToName_UpdateSummaryRequest = map[int16]string{
 	1: "id",
+	2: "summary_text",
+	3: "tags",
+	4: "tool_calls_json",
+	5: "notes",
 }
 
-func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryRequest) IsSetSummaryText() bool {
+	return p.SummaryText != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetToolCallsJSON() bool {
+	return p.ToolCallsJSON != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetNotes() bool {
+	return p.Notes != nil
+}
+
+func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8196,6 +8845,38 @@ func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8215,7 +8896,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8225,7 +8906,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8236,10 +8917,84 @@ func (p *DeleteSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
+func (p *UpdateSummaryRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SummaryText = _field
+	return nil
+}
+func (p *UpdateSummaryRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *UpdateSummaryRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToolCallsJSON = _field
+	return nil
+}
+func (p *UpdateSummaryRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Notes = _field
+	return nil
+}
+
+func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummaryRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8247,6 +9002,22 @@ func (p *DeleteSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8265,7 +9036,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8282,34 +9053,129 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) String() string {
+func (p *UpdateSummaryRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSummaryText() {
+		if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SummaryText); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateSummaryRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateSummaryRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCallsJSON() {
+		if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToolCallsJSON); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateSummaryRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotes() {
+		if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+			return err
+		}
+		for k, v := range p.Notes {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UpdateSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteSummaryRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateSummaryRequest(%+v)", *p)
 
 }
 
-type DeleteSummaryResponse struct {
+type UpdateSummaryResponse struct {
 	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-func NewDeleteSummaryResponse() *DeleteSummaryResponse {
-	return &DeleteSummaryResponse{}
+func NewUpdateSummaryResponse() *UpdateSummaryResponse {
+	return &UpdateSummaryResponse{}
 }
 
-func (p *DeleteSummaryResponse) InitDefault() {
+func (p *UpdateSummaryResponse) InitDefault() {
 }
 
-func (p *DeleteSummaryResponse) GetID() (v string) {
+func (p *UpdateSummaryResponse) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_DeleteSummaryResponse = map[int16]string{
+var fieldIDToName_UpdateSummaryResponse = map[int16]string{
 	1: "id",
 }
 
-func (p *DeleteSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8355,7 +9221,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8365,7 +9231,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8377,9 +9243,9 @@ func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummaryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8405,7 +9271,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8422,53 +9288,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteSummaryResponse) String() string {
+func (p *UpdateSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteSummaryResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateSummaryResponse(%+v)", *p)
 
 }
 
-type CourseListRequest struct {
-	Term      string `thrift:"term,1,required" json:"term,required" query:"term,required"`
-	IsRefresh *bool  `thrift:"is_refresh,2,optional" json:"is_refresh,omitempty" query:"is_refresh"`
+type DeleteSummaryRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewCourseListRequest() *CourseListRequest {
-	return &CourseListRequest{}
+func NewDeleteSummaryRequest() *DeleteSummaryRequest {
+	return &DeleteSummaryRequest{}
 }
 
-func (p *CourseListRequest) InitDefault() {
+func (p *DeleteSummaryRequest) InitDefault() {
 }
 
-func (p *CourseListRequest) GetTerm() (v string) {
-	return p.Term
+func (p *DeleteSummaryRequest) GetID() (v string) {
+	return p.ID
 }
 
-var CourseListRequest_IsRefresh_DEFAULT bool
-
-func (p *CourseListRequest) GetIsRefresh() (v bool) {
-	if !p.IsSetIsRefresh() {
-		return CourseListRequest_IsRefresh_DEFAULT
-	}
-	return *p.IsRefresh
+var fieldIDToName_DeleteSummaryRequest = map[int16]string{
+	1: "id",
 }
 
-var fieldIDToName_CourseListRequest = map[int16]string{
-	1: "term",
-	2: "is_refresh",
-}
-
-func (p *CourseListRequest) IsSetIsRefresh() bool {
-	return p.IsRefresh != nil
-}
-
-func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetTerm bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8489,15 +9339,6 @@ func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTerm = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -8514,17 +9355,13 @@ func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetTerm {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8532,11 +9369,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListRequest[fieldId]))
 }
 
-func (p *CourseListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8544,24 +9379,13 @@ func (p *CourseListRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Term = _field
-	return nil
-}
-func (p *CourseListRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsRefresh = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8569,10 +9393,6 @@ func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8591,11 +9411,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
+func (p *DeleteSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Term); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8608,73 +9428,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsRefresh() {
-		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CourseListRequest) String() string {
+func (p *DeleteSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseListRequest(%+v)", *p)
-
-}
-
-type CourseListResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required"`
-}
+	return fmt.Sprintf("DeleteSummaryRequest(%+v)", *p)
 
-func NewCourseListResponse() *CourseListResponse {
-	return &CourseListResponse{}
 }
 
-func (p *CourseListResponse) InitDefault() {
+type DeleteSummaryResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-var CourseListResponse_Base_DEFAULT *model.BaseResp
-
-func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return CourseListResponse_Base_DEFAULT
-	}
-	return p.Base
+func NewDeleteSummaryResponse() *DeleteSummaryResponse {
+	return &DeleteSummaryResponse{}
 }
 
-func (p *CourseListResponse) GetData() (v []*model.Course) {
-	return p.Data
+func (p *DeleteSummaryResponse) InitDefault() {
 }
 
-var fieldIDToName_CourseListResponse = map[int16]string{
-	1: "base",
-	2: "data",
+func (p *DeleteSummaryResponse) GetID() (v string) {
+	return p.ID
 }
 
-func (p *CourseListResponse) IsSetBase() bool {
-	return p.Base != nil
+var fieldIDToName_DeleteSummaryResponse = map[int16]string{
+	1: "id",
 }
 
-func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBase bool = false
-	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8691,20 +9475,10 @@ func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBase = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -8721,22 +9495,13 @@ func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBase {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetData {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8744,45 +9509,23 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListResponse[fieldId]))
-}
-
-func (p *CourseListResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Base = _field
-	return nil
 }
-func (p *CourseListResponse) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*model.Course, 0, size)
-	values := make([]model.Course, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Data = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8790,10 +9533,6 @@ func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8812,11 +9551,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseListResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
+func (p *DeleteSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Base.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8829,55 +9568,53 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
-		return err
-	}
-	for _, v := range p.Data {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CourseListResponse) String() string {
+func (p *DeleteSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseListResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteSummaryResponse(%+v)", *p)
 
 }
 
-type CourseTermListRequest struct {
+type CourseListRequest struct {
+	Term      string `thrift:"term,1,required" json:"term,required" query:"term,required"`
+	IsRefresh *bool  `thrift:"is_refresh,2,optional" json:"is_refresh,omitempty" query:"is_refresh"`
 }
 
-func NewCourseTermListRequest() *CourseTermListRequest {
-	return &CourseTermListRequest{}
+func NewCourseListRequest() *CourseListRequest {
+	return &CourseListRequest{}
 }
 
-func (p *CourseTermListRequest) InitDefault() {
+func (p *CourseListRequest) InitDefault() {
 }
 
-var fieldIDToName_CourseTermListRequest = map[int16]string{}
+func (p *CourseListRequest) GetTerm() (v string) {
+	return p.Term
+}
 
-func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
+var CourseListRequest_IsRefresh_DEFAULT bool
+
+func (p *CourseListRequest) GetIsRefresh() (v bool) {
+	if !p.IsSetIsRefresh() {
+		return CourseListRequest_IsRefresh_DEFAULT
+	}
+	return *p.IsRefresh
+}
+
+var fieldIDToName_CourseListRequest = map[int16]string{
+	1: "term",
+	2: "is_refresh",
+}
+
+func (p *CourseListRequest) IsSetIsRefresh() bool {
+	return p.IsRefresh != nil
+}
+
+func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetTerm bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8891,36 +9628,97 @@ func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTerm = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
+	if !issetTerm {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListRequest[fieldId]))
 }
 
-func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("CourseTermListRequest"); err != nil {
+func (p *CourseListRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Term = _field
+	return nil
+}
+func (p *CourseListRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsRefresh = _field
+	return nil
+}
+
+func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CourseListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8931,55 +9729,93 @@ func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseTermListRequest) String() string {
+func (p *CourseListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Term); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CourseListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsRefresh() {
+		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CourseListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
+	return fmt.Sprintf("CourseListRequest(%+v)", *p)
 
 }
 
-type CourseTermListResponse struct {
+type CourseListResponse struct {
 	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	Data []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required"`
+	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required"`
 }
 
-func NewCourseTermListResponse() *CourseTermListResponse {
-	return &CourseTermListResponse{}
+func NewCourseListResponse() *CourseListResponse {
+	return &CourseListResponse{}
 }
 
-func (p *CourseTermListResponse) InitDefault() {
+func (p *CourseListResponse) InitDefault() {
 }
 
-var CourseTermListResponse_Base_DEFAULT *model.BaseResp
+var CourseListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
+func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return CourseTermListResponse_Base_DEFAULT
+		return CourseListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *CourseTermListResponse) GetData() (v []string) {
+func (p *CourseListResponse) GetData() (v []*model.Course) {
 	return p.Data
 }
 
-var fieldIDToName_CourseTermListResponse = map[int16]string{
+var fieldIDToName_CourseListResponse = map[int16]string{
 	1: "base",
 	2: "data",
 }
 
-func (p *CourseTermListResponse) IsSetBase() bool {
+func (p *CourseListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9046,7 +9882,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseTermListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9055,10 +9891,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseTermListResponse[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListResponse[fieldId]))
 }
 
-func (p *CourseTermListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CourseListResponse) ReadField1(iprot thrift.TProtocol) error {
 	_field := model.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -9066,19 +9902,19 @@ func (p *CourseTermListResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Base = _field
 	return nil
 }
-func (p *CourseTermListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *CourseListResponse) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*model.Course, 0, size)
+	values := make([]model.Course, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -9090,9 +9926,9 @@ func (p *CourseTermListResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CourseTermListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseTermListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CourseListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9122,7 +9958,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseTermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9139,15 +9975,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseTermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Data)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
 		return err
 	}
 	for _, v := range p.Data {
-		if err := oprot.WriteString(v); err != nil {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
 	}
@@ -9164,34 +10000,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CourseTermListResponse) String() string {
+func (p *CourseListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
-
-}
+	return fmt.Sprintf("CourseListResponse(%+v)", *p)
 
-type UpdateUserSettingRequest struct {
-	SettingJSON string `thrift:"setting_json,1" form:"setting_json" json:"setting_json"`
 }
 
-func NewUpdateUserSettingRequest() *UpdateUserSettingRequest {
-	return &UpdateUserSettingRequest{}
+type CourseTermListRequest struct {
 }
 
-func (p *UpdateUserSettingRequest) InitDefault() {
+func NewCourseTermListRequest() *CourseTermListRequest {
+	return &CourseTermListRequest{}
 }
 
-func (p *UpdateUserSettingRequest) GetSettingJSON() (v string) {
-	return p.SettingJSON
+func (p *CourseTermListRequest) InitDefault() {
 }
 
-var fieldIDToName_UpdateUserSettingRequest = map[int16]string{
-	1: "setting_json",
-}
+var fieldIDToName_CourseTermListRequest = map[int16]string{}
 
-func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9208,20 +10037,8 @@ func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -9236,10 +10053,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -9247,28 +10062,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateUserSettingRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SettingJSON = _field
-	return nil
-}
-
-func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSettingRequest"); err != nil {
+func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("CourseTermListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9279,62 +10077,60 @@ func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserSettingRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("setting_json", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.SettingJSON); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *CourseTermListRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
+
 }
 
-func (p *UpdateUserSettingRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("UpdateUserSettingRequest(%+v)", *p)
+type CourseTermListResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	Data []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required"`
+}
 
+func NewCourseTermListResponse() *CourseTermListResponse {
+	return &CourseTermListResponse{}
 }
 
-type UpdateUserSettingResponse struct {
-	UserID string `thrift:"user_id,1" form:"user_id" json:"user_id"`
+func (p *CourseTermListResponse) InitDefault() {
 }
 
-func NewUpdateUserSettingResponse() *UpdateUserSettingResponse {
-	return &UpdateUserSettingResponse{}
+var CourseTermListResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CourseTermListResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *UpdateUserSettingResponse) InitDefault() {
+func (p *CourseTermListResponse) GetData() (v []string) {
+	return p.Data
 }
 
-func (p *UpdateUserSettingResponse) GetUserID() (v string) {
-	return p.UserID
+var fieldIDToName_CourseTermListResponse = map[int16]string{
+	1: "base",
+	2: "data",
 }
 
-var fieldIDToName_UpdateUserSettingResponse = map[int16]string{
-	1: "user_id",
+func (p *CourseTermListResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
+	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9351,10 +10147,20 @@ func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9371,13 +10177,22 @@ func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetData {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseTermListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9385,23 +10200,45 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseTermListResponse[fieldId]))
 }
 
-func (p *UpdateUserSettingResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CourseTermListResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *CourseTermListResponse) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserID = _field
+	p.Data = _field
 	return nil
 }
 
-func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseTermListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSettingResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CourseTermListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9409,6 +10246,10 @@ func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9427,11 +10268,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserSettingResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+func (p *CourseTermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserID); err != nil {
+	if err := p.Base.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9444,27 +10285,59 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateUserSettingResponse) String() string {
+func (p *CourseTermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CourseTermListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateUserSettingResponse(%+v)", *p)
+	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
 
 }
 
-type TermListRequest struct {
+type UpdateUserSettingRequest struct {
+	SettingJSON string `thrift:"setting_json,1" form:"setting_json" json:"setting_json"`
 }
 
-func NewTermListRequest() *TermListRequest {
-	return &TermListRequest{}
+func NewUpdateUserSettingRequest() *UpdateUserSettingRequest {
+	return &UpdateUserSettingRequest{}
 }
 
-func (p *TermListRequest) InitDefault() {
+func (p *UpdateUserSettingRequest) InitDefault() {
 }
 
-var fieldIDToName_TermListRequest = map[int16]string{}
+func (p *UpdateUserSettingRequest) GetSettingJSON() (v string) {
+	return p.SettingJSON
+}
 
-func (p *TermListRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_UpdateUserSettingRequest = map[int16]string{
+	1: "setting_json",
+}
+
+func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9481,8 +10354,20 @@ func (p *TermListRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -9497,8 +10382,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -9506,11 +10393,28 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TermListRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("TermListRequest"); err != nil {
+func (p *UpdateUserSettingRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SettingJSON = _field
+	return nil
+}
+
+func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateUserSettingRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9521,46 +10425,288 @@ func (p *TermListRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TermListRequest) String() string {
+func (p *UpdateUserSettingRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("setting_json", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SettingJSON); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateUserSettingRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermListRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateUserSettingRequest(%+v)", *p)
 
 }
 
-type TermListResponse struct {
-	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required"`
+type UpdateUserSettingResponse struct {
+	UserID string `thrift:"user_id,1" form:"user_id" json:"user_id"`
 }
 
-func NewTermListResponse() *TermListResponse {
-	return &TermListResponse{}
+func NewUpdateUserSettingResponse() *UpdateUserSettingResponse {
+	return &UpdateUserSettingResponse{}
 }
 
-func (p *TermListResponse) InitDefault() {
+func (p *UpdateUserSettingResponse) InitDefault() {
 }
 
-var TermListResponse_Base_DEFAULT *model.BaseResp
+func (p *UpdateUserSettingResponse) GetUserID() (v string) {
+	return p.UserID
+}
 
-func (p *TermListResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return TermListResponse_Base_DEFAULT
-	}
-	return p.Base
+var fieldIDToName_UpdateUserSettingResponse = map[int16]string{
+	1: "user_id",
 }
 
-var TermListResponse_TermLists_DEFAULT *model.TermList
+func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *TermListResponse) GetTermLists() (v *model.TermList) {
-	if !p.IsSetTermLists() {
-		return TermListResponse_TermLists_DEFAULT
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateUserSettingResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateUserSettingResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateUserSettingResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateUserSettingResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateUserSettingResponse(%+v)", *p)
+
+}
+
+type TermListRequest struct {
+}
+
+func NewTermListRequest() *TermListRequest {
+	return &TermListRequest{}
+}
+
+func (p *TermListRequest) InitDefault() {
+}
+
+var fieldIDToName_TermListRequest = map[int16]string{}
+
+func (p *TermListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TermListRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("TermListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TermListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TermListRequest(%+v)", *p)
+
+}
+
+type TermListResponse struct {
+	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required"`
+}
+
+func NewTermListResponse() *TermListResponse {
+	return &TermListResponse{}
+}
+
+func (p *TermListResponse) InitDefault() {
+}
+
+var TermListResponse_Base_DEFAULT *model.BaseResp
+
+func (p *TermListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return TermListResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var TermListResponse_TermLists_DEFAULT *model.TermList
+
+func (p *TermListResponse) GetTermLists() (v *model.TermList) {
+	if !p.IsSetTermLists() {
+		return TermListResponse_TermLists_DEFAULT
 	}
 	return p.TermLists
 }
@@ -10613,1608 +11759,6275 @@ func (p *DailyScheduleResponse) String() string {
 
 }
 
-type ApiService interface {
-	// 非流式对话
-	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
-	// 流式对话
-	ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error)
-	// 示例接口 idl写好后运行make hertz-gen-api生成脚手架
-	Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error)
-	// 获取会话历史
-	GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error)
-	// 删除会话
-	DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error)
-	// 获取对话列表
-	ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error)
-	// 会话总结
-	SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error)
-	// 获取jwch登录数据
-	GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error)
-	// 获取用户信息
-	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error)
-	// 更新用户设置
-	UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error)
-	// 待办事项管理
-	// 创建待办事项
-	CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error)
-	// 获取待办事项详情
-	GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error)
-	// 获取所有待办事项列表
-	ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error)
-	// 搜索待办事项
-	SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error)
-	// 更新待办事项
-	UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error)
-	// 删除待办事项
-	DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error)
-	// 知识库(摘要)管理
-	// 获取摘要详情
-	GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error)
-	// 获取所有摘要列表
-	ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error)
-	// 更新摘要
-	UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error)
-	// 删除摘要
-	DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error)
-	// 课程相关接口
-	// 获取课表
-	GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error)
-	// 获取学期
-	GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error)
-	// 校历信息：学期列表
-	GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error)
-	// 校历信息：学期详情
-	GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error)
-	// 每日日程
-	DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error)
+// ==================== 用户自定义 MCP 服务 ====================
+type CreateUserMCPServerRequest struct {
+	Name    string            `thrift:"name,1,required" form:"name,required" json:"name,required"`
+	URL     string            `thrift:"url,2,required" form:"url,required" json:"url,required"`
+	Token   *string           `thrift:"token,3,optional" form:"token" json:"token,omitempty"`
+	Headers map[string]string `thrift:"headers,4,optional" form:"headers" json:"headers,omitempty"`
 }
 
-type ApiServiceClient struct {
-	c thrift.TClient
+func NewCreateUserMCPServerRequest() *CreateUserMCPServerRequest {
+	return &CreateUserMCPServerRequest{}
 }
 
-func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *CreateUserMCPServerRequest) InitDefault() {
 }
 
-func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *CreateUserMCPServerRequest) GetName() (v string) {
+	return p.Name
 }
 
-func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: c,
-	}
+func (p *CreateUserMCPServerRequest) GetURL() (v string) {
+	return p.URL
 }
 
-func (p *ApiServiceClient) Client_() thrift.TClient {
-	return p.c
-}
+var CreateUserMCPServerRequest_Token_DEFAULT string
 
-func (p *ApiServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
-	var _args ApiServiceChatArgs
-	_args.Req = req
-	var _result ApiServiceChatResult
-	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
-		return
+func (p *CreateUserMCPServerRequest) GetToken() (v string) {
+	if !p.IsSetToken() {
+		return CreateUserMCPServerRequest_Token_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.Token
 }
-func (p *ApiServiceClient) ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error) {
-	var _args ApiServiceChatSSEArgs
-	_args.Req = req
-	var _result ApiServiceChatSSEResult
-	if err = p.Client_().Call(ctx, "ChatSSE", &_args, &_result); err != nil {
-		return
+
+var CreateUserMCPServerRequest_Headers_DEFAULT map[string]string
+
+func (p *CreateUserMCPServerRequest) GetHeaders() (v map[string]string) {
+	if !p.IsSetHeaders() {
+		return CreateUserMCPServerRequest_Headers_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Headers
 }
-func (p *ApiServiceClient) Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error) {
-	var _args ApiServiceTemplateArgs
-	_args.Req = req
-	var _result ApiServiceTemplateResult
-	if err = p.Client_().Call(ctx, "Template", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+var fieldIDToName_CreateUserMCPServerRequest = map[int16]string{
+	1: "name",
+	2: "url",
+	3: "token",
+	4: "headers",
 }
-func (p *ApiServiceClient) GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error) {
-	var _args ApiServiceGetConversationHistoryArgs
-	_args.Req = req
-	var _result ApiServiceGetConversationHistoryResult
-	if err = p.Client_().Call(ctx, "GetConversationHistory", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *CreateUserMCPServerRequest) IsSetToken() bool {
+	return p.Token != nil
 }
-func (p *ApiServiceClient) DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error) {
-	var _args ApiServiceDeleteConversationArgs
-	_args.Req = req
-	var _result ApiServiceDeleteConversationResult
-	if err = p.Client_().Call(ctx, "DeleteConversation", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *CreateUserMCPServerRequest) IsSetHeaders() bool {
+	return p.Headers != nil
 }
-func (p *ApiServiceClient) ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error) {
-	var _args ApiServiceListConversationsArgs
-	_args.Req = req
-	var _result ApiServiceListConversationsResult
-	if err = p.Client_().Call(ctx, "ListConversations", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetURL bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error) {
-	var _args ApiServiceSummarizeConversationArgs
-	_args.Req = req
-	var _result ApiServiceSummarizeConversationResult
-	if err = p.Client_().Call(ctx, "SummarizeConversation", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetURL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error) {
-	var _args ApiServiceGetLoginDataArgs
-	_args.Req = req
-	var _result ApiServiceGetLoginDataResult
-	if err = p.Client_().Call(ctx, "GetLoginData", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error) {
-	var _args ApiServiceGetUserInfoArgs
-	_args.Req = req
-	var _result ApiServiceGetUserInfoResult
-	if err = p.Client_().Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
-		return
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error) {
-	var _args ApiServiceUpdateUserSettingArgs
-	_args.Req = req
-	var _result ApiServiceUpdateUserSettingResult
-	if err = p.Client_().Call(ctx, "UpdateUserSetting", &_args, &_result); err != nil {
-		return
+
+	if !issetURL {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateUserMCPServerRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateUserMCPServerRequest[fieldId]))
 }
-func (p *ApiServiceClient) CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error) {
-	var _args ApiServiceCreateTodoArgs
-	_args.Req = req
-	var _result ApiServiceCreateTodoResult
-	if err = p.Client_().Call(ctx, "CreateTodo", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Name = _field
+	return nil
 }
-func (p *ApiServiceClient) GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error) {
-	var _args ApiServiceGetTodoArgs
-	_args.Req = req
-	var _result ApiServiceGetTodoResult
-	if err = p.Client_().Call(ctx, "GetTodo", &_args, &_result); err != nil {
-		return
+func (p *CreateUserMCPServerRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.URL = _field
+	return nil
 }
-func (p *ApiServiceClient) ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error) {
-	var _args ApiServiceListTodoArgs
-	_args.Req = req
-	var _result ApiServiceListTodoResult
-	if err = p.Client_().Call(ctx, "ListTodo", &_args, &_result); err != nil {
-		return
+func (p *CreateUserMCPServerRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return _result.GetSuccess(), nil
+	p.Token = _field
+	return nil
 }
-func (p *ApiServiceClient) SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error) {
-	var _args ApiServiceSearchTodoArgs
-	_args.Req = req
-	var _result ApiServiceSearchTodoResult
-	if err = p.Client_().Call(ctx, "SearchTodo", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error) {
-	var _args ApiServiceUpdateTodoArgs
-	_args.Req = req
-	var _result ApiServiceUpdateTodoResult
-	if err = p.Client_().Call(ctx, "UpdateTodo", &_args, &_result); err != nil {
-		return
+func (p *CreateUserMCPServerRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error) {
-	var _args ApiServiceDeleteTodoArgs
-	_args.Req = req
-	var _result ApiServiceDeleteTodoResult
-	if err = p.Client_().Call(ctx, "DeleteTodo", &_args, &_result); err != nil {
-		return
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error) {
-	var _args ApiServiceGetSummaryArgs
-	_args.Req = req
-	var _result ApiServiceGetSummaryResult
-	if err = p.Client_().Call(ctx, "GetSummary", &_args, &_result); err != nil {
-		return
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Headers = _field
+	return nil
 }
-func (p *ApiServiceClient) ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error) {
-	var _args ApiServiceListSummaryArgs
-	_args.Req = req
-	var _result ApiServiceListSummaryResult
-	if err = p.Client_().Call(ctx, "ListSummary", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateUserMCPServerRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error) {
-	var _args ApiServiceUpdateSummaryArgs
-	_args.Req = req
-	var _result ApiServiceUpdateSummaryResult
-	if err = p.Client_().Call(ctx, "UpdateSummary", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error) {
-	var _args ApiServiceDeleteSummaryArgs
-	_args.Req = req
-	var _result ApiServiceDeleteSummaryResult
-	if err = p.Client_().Call(ctx, "DeleteSummary", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *ApiServiceClient) GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error) {
-	var _args ApiServiceGetCourseListArgs
-	_args.Req = req
-	var _result ApiServiceGetCourseListResult
-	if err = p.Client_().Call(ctx, "GetCourseList", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ApiServiceClient) GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error) {
-	var _args ApiServiceGetTermListArgs
-	_args.Req = req
-	var _result ApiServiceGetTermListResult
-	if err = p.Client_().Call(ctx, "GetTermList", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ApiServiceClient) GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error) {
-	var _args ApiServiceGetTermsListArgs
-	_args.Req = req
-	var _result ApiServiceGetTermsListResult
-	if err = p.Client_().Call(ctx, "GetTermsList", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToken() {
+		if err = oprot.WriteFieldBegin("token", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Token); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ApiServiceClient) GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error) {
-	var _args ApiServiceGetTermArgs
-	_args.Req = req
-	var _result ApiServiceGetTermResult
-	if err = p.Client_().Call(ctx, "GetTerm", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ApiServiceClient) DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error) {
-	var _args ApiServiceDailyScheduleArgs
-	_args.Req = req
-	var _result ApiServiceDailyScheduleResult
-	if err = p.Client_().Call(ctx, "DailySchedule", &_args, &_result); err != nil {
-		return
+
+func (p *CreateUserMCPServerRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("CreateUserMCPServerRequest(%+v)", *p)
+
 }
 
-type ApiServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ApiService
+type CreateUserMCPServerResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewCreateUserMCPServerResponse() *CreateUserMCPServerResponse {
+	return &CreateUserMCPServerResponse{}
 }
 
-func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *CreateUserMCPServerResponse) InitDefault() {
 }
 
-func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *CreateUserMCPServerResponse) GetID() (v string) {
+	return p.ID
 }
 
-func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
-	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Chat", &apiServiceProcessorChat{handler: handler})
-	self.AddToProcessorMap("ChatSSE", &apiServiceProcessorChatSSE{handler: handler})
-	self.AddToProcessorMap("Template", &apiServiceProcessorTemplate{handler: handler})
-	self.AddToProcessorMap("GetConversationHistory", &apiServiceProcessorGetConversationHistory{handler: handler})
-	self.AddToProcessorMap("DeleteConversation", &apiServiceProcessorDeleteConversation{handler: handler})
-	self.AddToProcessorMap("ListConversations", &apiServiceProcessorListConversations{handler: handler})
-	self.AddToProcessorMap("SummarizeConversation", &apiServiceProcessorSummarizeConversation{handler: handler})
-	self.AddToProcessorMap("GetLoginData", &apiServiceProcessorGetLoginData{handler: handler})
-	self.AddToProcessorMap("GetUserInfo", &apiServiceProcessorGetUserInfo{handler: handler})
-	self.AddToProcessorMap("UpdateUserSetting", &apiServiceProcessorUpdateUserSetting{handler: handler})
-	self.AddToProcessorMap("CreateTodo", &apiServiceProcessorCreateTodo{handler: handler})
-	self.AddToProcessorMap("GetTodo", &apiServiceProcessorGetTodo{handler: handler})
-	self.AddToProcessorMap("ListTodo", &apiServiceProcessorListTodo{handler: handler})
-	self.AddToProcessorMap("SearchTodo", &apiServiceProcessorSearchTodo{handler: handler})
-	self.AddToProcessorMap("UpdateTodo", &apiServiceProcessorUpdateTodo{handler: handler})
-	self.AddToProcessorMap("DeleteTodo", &apiServiceProcessorDeleteTodo{handler: handler})
-	self.AddToProcessorMap("GetSummary", &apiServiceProcessorGetSummary{handler: handler})
-	self.AddToProcessorMap("ListSummary", &apiServiceProcessorListSummary{handler: handler})
-	self.AddToProcessorMap("UpdateSummary", &apiServiceProcessorUpdateSummary{handler: handler})
-	self.AddToProcessorMap("DeleteSummary", &apiServiceProcessorDeleteSummary{handler: handler})
-	self.AddToProcessorMap("GetCourseList", &apiServiceProcessorGetCourseList{handler: handler})
-	self.AddToProcessorMap("GetTermList", &apiServiceProcessorGetTermList{handler: handler})
-	self.AddToProcessorMap("GetTermsList", &apiServiceProcessorGetTermsList{handler: handler})
-	self.AddToProcessorMap("GetTerm", &apiServiceProcessorGetTerm{handler: handler})
-	self.AddToProcessorMap("DailySchedule", &apiServiceProcessorDailySchedule{handler: handler})
-	return self
+var fieldIDToName_CreateUserMCPServerResponse = map[int16]string{
+	1: "id",
 }
-func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *CreateUserMCPServerResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type apiServiceProcessorChat struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateUserMCPServerResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceChatArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *CreateUserMCPServerResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceChatResult{}
-	var retval *ChatResponse
-	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+		_field = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.ID = _field
+	return nil
+}
+
+func (p *CreateUserMCPServerResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateUserMCPServerResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type apiServiceProcessorChatSSE struct {
-	handler ApiService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceChatSSEArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceChatSSEResult{}
-	var retval *ChatSSEHandlerResponse
-	if retval, err2 = p.handler.ChatSSE(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChatSSE: "+err2.Error())
-		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ChatSSE", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *CreateUserMCPServerResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateUserMCPServerResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("CreateUserMCPServerResponse(%+v)", *p)
+
 }
 
-type apiServiceProcessorTemplate struct {
-	handler ApiService
+type UserMCPServerItem struct {
+	ID         string   `thrift:"id,1" form:"id" json:"id"`
+	Name       string   `thrift:"name,2" form:"name" json:"name"`
+	URL        string   `thrift:"url,3" form:"url" json:"url"`
+	HasToken   bool     `thrift:"has_token,4" form:"has_token" json:"has_token"`
+	HeaderKeys []string `thrift:"header_keys,5,default,list<string>" form:"header_keys" json:"header_keys"`
+	Enabled    bool     `thrift:"enabled,6" form:"enabled" json:"enabled"`
+	CreatedAt  int64    `thrift:"created_at,7" form:"created_at" json:"created_at"`
 }
 
-func (p *apiServiceProcessorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTemplateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserMCPServerItem() *UserMCPServerItem {
+	return &UserMCPServerItem{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTemplateResult{}
-	var retval *TemplateResponse
-	if retval, err2 = p.handler.Template(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Template: "+err2.Error())
-		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Template", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *UserMCPServerItem) InitDefault() {
 }
 
-type apiServiceProcessorGetConversationHistory struct {
-	handler ApiService
+func (p *UserMCPServerItem) GetID() (v string) {
+	return p.ID
 }
 
-func (p *apiServiceProcessorGetConversationHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetConversationHistoryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserMCPServerItem) GetName() (v string) {
+	return p.Name
+}
+
+func (p *UserMCPServerItem) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *UserMCPServerItem) GetHasToken() (v bool) {
+	return p.HasToken
+}
+
+func (p *UserMCPServerItem) GetHeaderKeys() (v []string) {
+	return p.HeaderKeys
+}
+
+func (p *UserMCPServerItem) GetEnabled() (v bool) {
+	return p.Enabled
+}
+
+func (p *UserMCPServerItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_UserMCPServerItem = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "url",
+	4: "has_token",
+	5: "header_keys",
+	6: "enabled",
+	7: "created_at",
+}
+
+func (p *UserMCPServerItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetConversationHistoryResult{}
-	var retval *GetConversationHistoryResponse
-	if retval, err2 = p.handler.GetConversationHistory(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetConversationHistory: "+err2.Error())
-		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("GetConversationHistory", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserMCPServerItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.ID = _field
+	return nil
+}
+func (p *UserMCPServerItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.Name = _field
+	return nil
+}
+func (p *UserMCPServerItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *UserMCPServerItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.HasToken = _field
+	return nil
+}
+func (p *UserMCPServerItem) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
-		return
+		return err
 	}
-	return true, err
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.HeaderKeys = _field
+	return nil
 }
+func (p *UserMCPServerItem) ReadField6(iprot thrift.TProtocol) error {
 
-type apiServiceProcessorDeleteConversation struct {
-	handler ApiService
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *UserMCPServerItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *UserMCPServerItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserMCPServerItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_token", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("header_keys", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.HeaderKeys)); err != nil {
+		return err
+	}
+	for _, v := range p.HeaderKeys {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Enabled); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *UserMCPServerItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserMCPServerItem(%+v)", *p)
+
+}
+
+type ListUserMCPServerRequest struct {
+}
+
+func NewListUserMCPServerRequest() *ListUserMCPServerRequest {
+	return &ListUserMCPServerRequest{}
+}
+
+func (p *ListUserMCPServerRequest) InitDefault() {
+}
+
+var fieldIDToName_ListUserMCPServerRequest = map[int16]string{}
+
+func (p *ListUserMCPServerRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListUserMCPServerRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListUserMCPServerRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListUserMCPServerRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListUserMCPServerRequest(%+v)", *p)
+
+}
+
+type ListUserMCPServerResponse struct {
+	Servers []*UserMCPServerItem `thrift:"servers,1,default,list<UserMCPServerItem>" form:"servers" json:"servers"`
+}
+
+func NewListUserMCPServerResponse() *ListUserMCPServerResponse {
+	return &ListUserMCPServerResponse{}
+}
+
+func (p *ListUserMCPServerResponse) InitDefault() {
+}
+
+func (p *ListUserMCPServerResponse) GetServers() (v []*UserMCPServerItem) {
+	return p.Servers
+}
+
+var fieldIDToName_ListUserMCPServerResponse = map[int16]string{
+	1: "servers",
+}
+
+func (p *ListUserMCPServerResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListUserMCPServerResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListUserMCPServerResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UserMCPServerItem, 0, size)
+	values := make([]UserMCPServerItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Servers = _field
+	return nil
+}
+
+func (p *ListUserMCPServerResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserMCPServerResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListUserMCPServerResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("servers", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Servers)); err != nil {
+		return err
+	}
+	for _, v := range p.Servers {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListUserMCPServerResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListUserMCPServerResponse(%+v)", *p)
+
+}
+
+type DeleteUserMCPServerRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
+}
+
+func NewDeleteUserMCPServerRequest() *DeleteUserMCPServerRequest {
+	return &DeleteUserMCPServerRequest{}
+}
+
+func (p *DeleteUserMCPServerRequest) InitDefault() {
+}
+
+func (p *DeleteUserMCPServerRequest) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteUserMCPServerRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteUserMCPServerRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserMCPServerRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *DeleteUserMCPServerRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteUserMCPServerRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteUserMCPServerRequest(%+v)", *p)
+
+}
+
+type ApproveToolCallRequest struct {
+	ApprovalID string  `thrift:"approval_id,1,required" form:"approval_id,required" json:"approval_id,required"`
+	Decision   string  `thrift:"decision,2,required" form:"decision,required" json:"decision,required"`
+	Args       *string `thrift:"args,3,optional" form:"args" json:"args,omitempty"`
+	Reason     *string `thrift:"reason,4,optional" form:"reason" json:"reason,omitempty"`
+}
+
+func NewApproveToolCallRequest() *ApproveToolCallRequest {
+	return &ApproveToolCallRequest{}
+}
+
+func (p *ApproveToolCallRequest) InitDefault() {
+}
+
+func (p *ApproveToolCallRequest) GetApprovalID() (v string) {
+	return p.ApprovalID
+}
+
+func (p *ApproveToolCallRequest) GetDecision() (v string) {
+	return p.Decision
+}
+
+var ApproveToolCallRequest_Args_DEFAULT string
+
+func (p *ApproveToolCallRequest) GetArgs() (v string) {
+	if !p.IsSetArgs() {
+		return ApproveToolCallRequest_Args_DEFAULT
+	}
+	return *p.Args
+}
+
+var ApproveToolCallRequest_Reason_DEFAULT string
+
+func (p *ApproveToolCallRequest) GetReason() (v string) {
+	if !p.IsSetReason() {
+		return ApproveToolCallRequest_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var fieldIDToName_ApproveToolCallRequest = map[int16]string{
+	1: "approval_id",
+	2: "decision",
+	3: "args",
+	4: "reason",
+}
+
+func (p *ApproveToolCallRequest) IsSetArgs() bool {
+	return p.Args != nil
+}
+
+func (p *ApproveToolCallRequest) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *ApproveToolCallRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetApprovalID bool = false
+	var issetDecision bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetApprovalID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDecision = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetApprovalID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDecision {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApproveToolCallRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ApproveToolCallRequest[fieldId]))
+}
+
+func (p *ApproveToolCallRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ApprovalID = _field
+	return nil
+}
+func (p *ApproveToolCallRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Decision = _field
+	return nil
+}
+func (p *ApproveToolCallRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Args = _field
+	return nil
+}
+func (p *ApproveToolCallRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ApproveToolCallRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApproveToolCallRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApproveToolCallRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("approval_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ApprovalID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApproveToolCallRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decision", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Decision); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ApproveToolCallRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetArgs() {
+		if err = oprot.WriteFieldBegin("args", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Args); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ApproveToolCallRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReason() {
+		if err = oprot.WriteFieldBegin("reason", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ApproveToolCallRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApproveToolCallRequest(%+v)", *p)
+
+}
+
+type ApproveToolCallResponse struct {
+	ApprovalID string `thrift:"approval_id,1" form:"approval_id" json:"approval_id"`
+	Status     string `thrift:"status,2" form:"status" json:"status"`
+}
+
+func NewApproveToolCallResponse() *ApproveToolCallResponse {
+	return &ApproveToolCallResponse{}
+}
+
+func (p *ApproveToolCallResponse) InitDefault() {
+}
+
+func (p *ApproveToolCallResponse) GetApprovalID() (v string) {
+	return p.ApprovalID
+}
+
+func (p *ApproveToolCallResponse) GetStatus() (v string) {
+	return p.Status
+}
+
+var fieldIDToName_ApproveToolCallResponse = map[int16]string{
+	1: "approval_id",
+	2: "status",
+}
+
+func (p *ApproveToolCallResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApproveToolCallResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApproveToolCallResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ApprovalID = _field
+	return nil
+}
+func (p *ApproveToolCallResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+
+func (p *ApproveToolCallResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApproveToolCallResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApproveToolCallResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("approval_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ApprovalID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApproveToolCallResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ApproveToolCallResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApproveToolCallResponse(%+v)", *p)
+
+}
+
+type DeleteUserMCPServerResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewDeleteUserMCPServerResponse() *DeleteUserMCPServerResponse {
+	return &DeleteUserMCPServerResponse{}
+}
+
+func (p *DeleteUserMCPServerResponse) InitDefault() {
+}
+
+func (p *DeleteUserMCPServerResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteUserMCPServerResponse = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteUserMCPServerResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserMCPServerResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *DeleteUserMCPServerResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteUserMCPServerResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteUserMCPServerResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteUserMCPServerResponse(%+v)", *p)
+
+}
+
+type GetUserUsageRequest struct {
+	Days *int64 `thrift:"days,1,optional" json:"days,omitempty" query:"days"`
+}
+
+func NewGetUserUsageRequest() *GetUserUsageRequest {
+	return &GetUserUsageRequest{}
+}
+
+func (p *GetUserUsageRequest) InitDefault() {
+}
+
+var GetUserUsageRequest_Days_DEFAULT int64
+
+func (p *GetUserUsageRequest) GetDays() (v int64) {
+	if !p.IsSetDays() {
+		return GetUserUsageRequest_Days_DEFAULT
+	}
+	return *p.Days
+}
+
+var fieldIDToName_GetUserUsageRequest = map[int16]string{
+	1: "days",
+}
+
+func (p *GetUserUsageRequest) IsSetDays() bool {
+	return p.Days != nil
+}
+
+func (p *GetUserUsageRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserUsageRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserUsageRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Days = _field
+	return nil
+}
+
+func (p *GetUserUsageRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserUsageRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserUsageRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDays() {
+		if err = oprot.WriteFieldBegin("days", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Days); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUserUsageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserUsageRequest(%+v)", *p)
+
+}
+
+type UsageItem struct {
+	Key              string `thrift:"key,1" form:"key" json:"key"`
+	PromptTokens     int64  `thrift:"prompt_tokens,2" form:"prompt_tokens" json:"prompt_tokens"`
+	CompletionTokens int64  `thrift:"completion_tokens,3" form:"completion_tokens" json:"completion_tokens"`
+	TotalTokens      int64  `thrift:"total_tokens,4" form:"total_tokens" json:"total_tokens"`
+}
+
+func NewUsageItem() *UsageItem {
+	return &UsageItem{}
+}
+
+func (p *UsageItem) InitDefault() {
+}
+
+func (p *UsageItem) GetKey() (v string) {
+	return p.Key
+}
+
+func (p *UsageItem) GetPromptTokens() (v int64) {
+	return p.PromptTokens
+}
+
+func (p *UsageItem) GetCompletionTokens() (v int64) {
+	return p.CompletionTokens
+}
+
+func (p *UsageItem) GetTotalTokens() (v int64) {
+	return p.TotalTokens
+}
+
+var fieldIDToName_UsageItem = map[int16]string{
+	1: "key",
+	2: "prompt_tokens",
+	3: "completion_tokens",
+	4: "total_tokens",
+}
+
+func (p *UsageItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UsageItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UsageItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *UsageItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PromptTokens = _field
+	return nil
+}
+func (p *UsageItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CompletionTokens = _field
+	return nil
+}
+func (p *UsageItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalTokens = _field
+	return nil
+}
+
+func (p *UsageItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UsageItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UsageItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Key); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prompt_tokens", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PromptTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("completion_tokens", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CompletionTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_tokens", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UsageItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsageItem(%+v)", *p)
+
+}
+
+type GetUserUsageResponse struct {
+	Role            string       `thrift:"role,1" form:"role" json:"role"`
+	DailyQuota      int64        `thrift:"daily_quota,2" form:"daily_quota" json:"daily_quota"`
+	TodayTokens     int64        `thrift:"today_tokens,3" form:"today_tokens" json:"today_tokens"`
+	RemainingTokens int64        `thrift:"remaining_tokens,4" form:"remaining_tokens" json:"remaining_tokens"`
+	TotalTokens     int64        `thrift:"total_tokens,5" form:"total_tokens" json:"total_tokens"`
+	Daily           []*UsageItem `thrift:"daily,6,default,list<UsageItem>" form:"daily" json:"daily"`
+	Models          []*UsageItem `thrift:"models,7,default,list<UsageItem>" form:"models" json:"models"`
+	Conversations   []*UsageItem `thrift:"conversations,8,default,list<UsageItem>" form:"conversations" json:"conversations"`
+}
+
+func NewGetUserUsageResponse() *GetUserUsageResponse {
+	return &GetUserUsageResponse{}
+}
+
+func (p *GetUserUsageResponse) InitDefault() {
+}
+
+func (p *GetUserUsageResponse) GetRole() (v string) {
+	return p.Role
+}
+
+func (p *GetUserUsageResponse) GetDailyQuota() (v int64) {
+	return p.DailyQuota
+}
+
+func (p *GetUserUsageResponse) GetTodayTokens() (v int64) {
+	return p.TodayTokens
+}
+
+func (p *GetUserUsageResponse) GetRemainingTokens() (v int64) {
+	return p.RemainingTokens
+}
+
+func (p *GetUserUsageResponse) GetTotalTokens() (v int64) {
+	return p.TotalTokens
+}
+
+func (p *GetUserUsageResponse) GetDaily() (v []*UsageItem) {
+	return p.Daily
+}
+
+func (p *GetUserUsageResponse) GetModels() (v []*UsageItem) {
+	return p.Models
+}
+
+func (p *GetUserUsageResponse) GetConversations() (v []*UsageItem) {
+	return p.Conversations
+}
+
+var fieldIDToName_GetUserUsageResponse = map[int16]string{
+	1: "role",
+	2: "daily_quota",
+	3: "today_tokens",
+	4: "remaining_tokens",
+	5: "total_tokens",
+	6: "daily",
+	7: "models",
+	8: "conversations",
+}
+
+func (p *GetUserUsageResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserUsageResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Role = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DailyQuota = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TodayTokens = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RemainingTokens = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalTokens = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UsageItem, 0, size)
+	values := make([]UsageItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Daily = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UsageItem, 0, size)
+	values := make([]UsageItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Models = _field
+	return nil
+}
+func (p *GetUserUsageResponse) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UsageItem, 0, size)
+	values := make([]UsageItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Conversations = _field
+	return nil
+}
+
+func (p *GetUserUsageResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserUsageResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("daily_quota", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DailyQuota); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("today_tokens", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TodayTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("remaining_tokens", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RemainingTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_tokens", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("daily", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Daily)); err != nil {
+		return err
+	}
+	for _, v := range p.Daily {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("models", thrift.LIST, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Models)); err != nil {
+		return err
+	}
+	for _, v := range p.Models {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversations", thrift.LIST, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Conversations)); err != nil {
+		return err
+	}
+	for _, v := range p.Conversations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *GetUserUsageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserUsageResponse(%+v)", *p)
+
+}
+
+type ApiService interface {
+	// 非流式对话
+	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
+	// 流式对话
+	ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error)
+	// 审批流式对话中等待确认的高风险工具调用
+	ApproveToolCall(ctx context.Context, req *ApproveToolCallRequest) (r *ApproveToolCallResponse, err error)
+	// 示例接口 idl写好后运行make hertz-gen-api生成脚手架
+	Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error)
+	// 获取会话历史
+	GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error)
+	// 删除会话
+	DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error)
+	// 获取对话列表
+	ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error)
+	// 会话总结
+	SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error)
+	// 获取jwch登录数据
+	GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error)
+	// 获取用户信息
+	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error)
+	// 更新用户设置
+	UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error)
+	// 获取用户 token 用量与每日配额
+	GetUserUsage(ctx context.Context, req *GetUserUsageRequest) (r *GetUserUsageResponse, err error)
+	// 待办事项管理
+	// 创建待办事项
+	CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error)
+	// 获取待办事项详情
+	GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error)
+	// 获取所有待办事项列表
+	ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error)
+	// 搜索待办事项
+	SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error)
+	// 更新待办事项
+	UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error)
+	// 删除待办事项
+	DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error)
+	// 知识库(摘要)管理
+	// 获取摘要详情
+	GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error)
+	// 获取所有摘要列表
+	ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error)
+	// 更新摘要
+	UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error)
+	// 删除摘要
+	DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error)
+	// 课程相关接口
+	// 获取课表
+	GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error)
+	// 获取学期
+	GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error)
+	// 校历信息：学期列表
+	GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error)
+	// 校历信息：学期详情
+	GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error)
+	// 每日日程
+	DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error)
+	// 用户自定义 MCP 服务
+	// 添加MCP服务
+	CreateUserMCPServer(ctx context.Context, req *CreateUserMCPServerRequest) (r *CreateUserMCPServerResponse, err error)
+	// 获取MCP服务列表
+	ListUserMCPServer(ctx context.Context, req *ListUserMCPServerRequest) (r *ListUserMCPServerResponse, err error)
+	// 删除MCP服务
+	DeleteUserMCPServer(ctx context.Context, req *DeleteUserMCPServerRequest) (r *DeleteUserMCPServerResponse, err error)
+}
+
+type ApiServiceClient struct {
+	c thrift.TClient
+}
+
+func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: c,
+	}
+}
+
+func (p *ApiServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ApiServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
+	var _args ApiServiceChatArgs
+	_args.Req = req
+	var _result ApiServiceChatResult
+	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error) {
+	var _args ApiServiceChatSSEArgs
+	_args.Req = req
+	var _result ApiServiceChatSSEResult
+	if err = p.Client_().Call(ctx, "ChatSSE", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ApproveToolCall(ctx context.Context, req *ApproveToolCallRequest) (r *ApproveToolCallResponse, err error) {
+	var _args ApiServiceApproveToolCallArgs
+	_args.Req = req
+	var _result ApiServiceApproveToolCallResult
+	if err = p.Client_().Call(ctx, "ApproveToolCall", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error) {
+	var _args ApiServiceTemplateArgs
+	_args.Req = req
+	var _result ApiServiceTemplateResult
+	if err = p.Client_().Call(ctx, "Template", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error) {
+	var _args ApiServiceGetConversationHistoryArgs
+	_args.Req = req
+	var _result ApiServiceGetConversationHistoryResult
+	if err = p.Client_().Call(ctx, "GetConversationHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error) {
+	var _args ApiServiceDeleteConversationArgs
+	_args.Req = req
+	var _result ApiServiceDeleteConversationResult
+	if err = p.Client_().Call(ctx, "DeleteConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error) {
+	var _args ApiServiceListConversationsArgs
+	_args.Req = req
+	var _result ApiServiceListConversationsResult
+	if err = p.Client_().Call(ctx, "ListConversations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error) {
+	var _args ApiServiceSummarizeConversationArgs
+	_args.Req = req
+	var _result ApiServiceSummarizeConversationResult
+	if err = p.Client_().Call(ctx, "SummarizeConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error) {
+	var _args ApiServiceGetLoginDataArgs
+	_args.Req = req
+	var _result ApiServiceGetLoginDataResult
+	if err = p.Client_().Call(ctx, "GetLoginData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error) {
+	var _args ApiServiceGetUserInfoArgs
+	_args.Req = req
+	var _result ApiServiceGetUserInfoResult
+	if err = p.Client_().Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error) {
+	var _args ApiServiceUpdateUserSettingArgs
+	_args.Req = req
+	var _result ApiServiceUpdateUserSettingResult
+	if err = p.Client_().Call(ctx, "UpdateUserSetting", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetUserUsage(ctx context.Context, req *GetUserUsageRequest) (r *GetUserUsageResponse, err error) {
+	var _args ApiServiceGetUserUsageArgs
+	_args.Req = req
+	var _result ApiServiceGetUserUsageResult
+	if err = p.Client_().Call(ctx, "GetUserUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error) {
+	var _args ApiServiceCreateTodoArgs
+	_args.Req = req
+	var _result ApiServiceCreateTodoResult
+	if err = p.Client_().Call(ctx, "CreateTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error) {
+	var _args ApiServiceGetTodoArgs
+	_args.Req = req
+	var _result ApiServiceGetTodoResult
+	if err = p.Client_().Call(ctx, "GetTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error) {
+	var _args ApiServiceListTodoArgs
+	_args.Req = req
+	var _result ApiServiceListTodoResult
+	if err = p.Client_().Call(ctx, "ListTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error) {
+	var _args ApiServiceSearchTodoArgs
+	_args.Req = req
+	var _result ApiServiceSearchTodoResult
+	if err = p.Client_().Call(ctx, "SearchTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error) {
+	var _args ApiServiceUpdateTodoArgs
+	_args.Req = req
+	var _result ApiServiceUpdateTodoResult
+	if err = p.Client_().Call(ctx, "UpdateTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error) {
+	var _args ApiServiceDeleteTodoArgs
+	_args.Req = req
+	var _result ApiServiceDeleteTodoResult
+	if err = p.Client_().Call(ctx, "DeleteTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error) {
+	var _args ApiServiceGetSummaryArgs
+	_args.Req = req
+	var _result ApiServiceGetSummaryResult
+	if err = p.Client_().Call(ctx, "GetSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error) {
+	var _args ApiServiceListSummaryArgs
+	_args.Req = req
+	var _result ApiServiceListSummaryResult
+	if err = p.Client_().Call(ctx, "ListSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error) {
+	var _args ApiServiceUpdateSummaryArgs
+	_args.Req = req
+	var _result ApiServiceUpdateSummaryResult
+	if err = p.Client_().Call(ctx, "UpdateSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error) {
+	var _args ApiServiceDeleteSummaryArgs
+	_args.Req = req
+	var _result ApiServiceDeleteSummaryResult
+	if err = p.Client_().Call(ctx, "DeleteSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error) {
+	var _args ApiServiceGetCourseListArgs
+	_args.Req = req
+	var _result ApiServiceGetCourseListResult
+	if err = p.Client_().Call(ctx, "GetCourseList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error) {
+	var _args ApiServiceGetTermListArgs
+	_args.Req = req
+	var _result ApiServiceGetTermListResult
+	if err = p.Client_().Call(ctx, "GetTermList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error) {
+	var _args ApiServiceGetTermsListArgs
+	_args.Req = req
+	var _result ApiServiceGetTermsListResult
+	if err = p.Client_().Call(ctx, "GetTermsList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error) {
+	var _args ApiServiceGetTermArgs
+	_args.Req = req
+	var _result ApiServiceGetTermResult
+	if err = p.Client_().Call(ctx, "GetTerm", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error) {
+	var _args ApiServiceDailyScheduleArgs
+	_args.Req = req
+	var _result ApiServiceDailyScheduleResult
+	if err = p.Client_().Call(ctx, "DailySchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) CreateUserMCPServer(ctx context.Context, req *CreateUserMCPServerRequest) (r *CreateUserMCPServerResponse, err error) {
+	var _args ApiServiceCreateUserMCPServerArgs
+	_args.Req = req
+	var _result ApiServiceCreateUserMCPServerResult
+	if err = p.Client_().Call(ctx, "CreateUserMCPServer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListUserMCPServer(ctx context.Context, req *ListUserMCPServerRequest) (r *ListUserMCPServerResponse, err error) {
+	var _args ApiServiceListUserMCPServerArgs
+	_args.Req = req
+	var _result ApiServiceListUserMCPServerResult
+	if err = p.Client_().Call(ctx, "ListUserMCPServer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteUserMCPServer(ctx context.Context, req *DeleteUserMCPServerRequest) (r *DeleteUserMCPServerResponse, err error) {
+	var _args ApiServiceDeleteUserMCPServerArgs
+	_args.Req = req
+	var _result ApiServiceDeleteUserMCPServerResult
+	if err = p.Client_().Call(ctx, "DeleteUserMCPServer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ApiServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ApiService
+}
+
+func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
+	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Chat", &apiServiceProcessorChat{handler: handler})
+	self.AddToProcessorMap("ChatSSE", &apiServiceProcessorChatSSE{handler: handler})
+	self.AddToProcessorMap("ApproveToolCall", &apiServiceProcessorApproveToolCall{handler: handler})
+	self.AddToProcessorMap("Template", &apiServiceProcessorTemplate{handler: handler})
+	self.AddToProcessorMap("GetConversationHistory", &apiServiceProcessorGetConversationHistory{handler: handler})
+	self.AddToProcessorMap("DeleteConversation", &apiServiceProcessorDeleteConversation{handler: handler})
+	self.AddToProcessorMap("ListConversations", &apiServiceProcessorListConversations{handler: handler})
+	self.AddToProcessorMap("SummarizeConversation", &apiServiceProcessorSummarizeConversation{handler: handler})
+	self.AddToProcessorMap("GetLoginData", &apiServiceProcessorGetLoginData{handler: handler})
+	self.AddToProcessorMap("GetUserInfo", &apiServiceProcessorGetUserInfo{handler: handler})
+	self.AddToProcessorMap("UpdateUserSetting", &apiServiceProcessorUpdateUserSetting{handler: handler})
+	self.AddToProcessorMap("GetUserUsage", &apiServiceProcessorGetUserUsage{handler: handler})
+	self.AddToProcessorMap("CreateTodo", &apiServiceProcessorCreateTodo{handler: handler})
+	self.AddToProcessorMap("GetTodo", &apiServiceProcessorGetTodo{handler: handler})
+	self.AddToProcessorMap("ListTodo", &apiServiceProcessorListTodo{handler: handler})
+	self.AddToProcessorMap("SearchTodo", &apiServiceProcessorSearchTodo{handler: handler})
+	self.AddToProcessorMap("UpdateTodo", &apiServiceProcessorUpdateTodo{handler: handler})
+	self.AddToProcessorMap("DeleteTodo", &apiServiceProcessorDeleteTodo{handler: handler})
+	self.AddToProcessorMap("GetSummary", &apiServiceProcessorGetSummary{handler: handler})
+	self.AddToProcessorMap("ListSummary", &apiServiceProcessorListSummary{handler: handler})
+	self.AddToProcessorMap("UpdateSummary", &apiServiceProcessorUpdateSummary{handler: handler})
+	self.AddToProcessorMap("DeleteSummary", &apiServiceProcessorDeleteSummary{handler: handler})
+	self.AddToProcessorMap("GetCourseList", &apiServiceProcessorGetCourseList{handler: handler})
+	self.AddToProcessorMap("GetTermList", &apiServiceProcessorGetTermList{handler: handler})
+	self.AddToProcessorMap("GetTermsList", &apiServiceProcessorGetTermsList{handler: handler})
+	self.AddToProcessorMap("GetTerm", &apiServiceProcessorGetTerm{handler: handler})
+	self.AddToProcessorMap("DailySchedule", &apiServiceProcessorDailySchedule{handler: handler})
+	self.AddToProcessorMap("CreateUserMCPServer", &apiServiceProcessorCreateUserMCPServer{handler: handler})
+	self.AddToProcessorMap("ListUserMCPServer", &apiServiceProcessorListUserMCPServer{handler: handler})
+	self.AddToProcessorMap("DeleteUserMCPServer", &apiServiceProcessorDeleteUserMCPServer{handler: handler})
+	return self
+}
+func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type apiServiceProcessorChat struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceChatArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceChatResult{}
+	var retval *ChatResponse
+	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorChatSSE struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceChatSSEArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceChatSSEResult{}
+	var retval *ChatSSEHandlerResponse
+	if retval, err2 = p.handler.ChatSSE(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChatSSE: "+err2.Error())
+		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ChatSSE", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorApproveToolCall struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorApproveToolCall) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceApproveToolCallArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ApproveToolCall", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceApproveToolCallResult{}
+	var retval *ApproveToolCallResponse
+	if retval, err2 = p.handler.ApproveToolCall(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ApproveToolCall: "+err2.Error())
+		oprot.WriteMessageBegin("ApproveToolCall", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ApproveToolCall", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTemplate struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTemplateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTemplateResult{}
+	var retval *TemplateResponse
+	if retval, err2 = p.handler.Template(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Template: "+err2.Error())
+		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Template", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetConversationHistory struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetConversationHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetConversationHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetConversationHistoryResult{}
+	var retval *GetConversationHistoryResponse
+	if retval, err2 = p.handler.GetConversationHistory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetConversationHistory: "+err2.Error())
+		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetConversationHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteConversation struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteConversationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteConversationResult{}
+	var retval *DeleteConversationResponse
+	if retval, err2 = p.handler.DeleteConversation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteConversation: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteConversation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListConversations struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListConversations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListConversationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListConversationsResult{}
+	var retval *ListConversationsResponse
+	if retval, err2 = p.handler.ListConversations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListConversations: "+err2.Error())
+		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListConversations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorSummarizeConversation struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorSummarizeConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceSummarizeConversationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceSummarizeConversationResult{}
+	var retval *SummarizeConversationResponse
+	if retval, err2 = p.handler.SummarizeConversation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SummarizeConversation: "+err2.Error())
+		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SummarizeConversation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetLoginData struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetLoginData) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetLoginDataArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetLoginDataResult{}
+	var retval *GetLoginDataResponse
+	if retval, err2 = p.handler.GetLoginData(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginData: "+err2.Error())
+		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetLoginData", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetUserInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetUserInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetUserInfoResult{}
+	var retval *GetUserInfoResponse
+	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInfo: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUpdateUserSetting struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateUserSetting) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateUserSettingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateUserSettingResult{}
+	var retval *UpdateUserSettingResponse
+	if retval, err2 = p.handler.UpdateUserSetting(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserSetting: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateUserSetting", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetUserUsage struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetUserUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetUserUsageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetUserUsageResult{}
+	var retval *GetUserUsageResponse
+	if retval, err2 = p.handler.GetUserUsage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserUsage: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserUsage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorCreateTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorCreateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceCreateTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceCreateTodoResult{}
+	var retval *CreateTodoResponse
+	if retval, err2 = p.handler.CreateTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTodo: "+err2.Error())
+		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTodoResult{}
+	var retval *GetTodoResponse
+	if retval, err2 = p.handler.GetTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTodo: "+err2.Error())
+		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListTodoResult{}
+	var retval *ListTodoResponse
+	if retval, err2 = p.handler.ListTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTodo: "+err2.Error())
+		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorSearchTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorSearchTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceSearchTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceSearchTodoResult{}
+	var retval *SearchTodoResponse
+	if retval, err2 = p.handler.SearchTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTodo: "+err2.Error())
+		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUpdateTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateTodoResult{}
+	var retval *UpdateTodoResponse
+	if retval, err2 = p.handler.UpdateTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTodo: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteTodoResult{}
+	var retval *DeleteTodoResponse
+	if retval, err2 = p.handler.DeleteTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteTodo: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetSummaryResult{}
+	var retval *GetSummaryResponse
+	if retval, err2 = p.handler.GetSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSummary: "+err2.Error())
+		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListSummaryResult{}
+	var retval *ListSummaryResponse
+	if retval, err2 = p.handler.ListSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSummary: "+err2.Error())
+		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUpdateSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateSummaryResult{}
+	var retval *UpdateSummaryResponse
+	if retval, err2 = p.handler.UpdateSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSummary: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteSummaryResult{}
+	var retval *DeleteSummaryResponse
+	if retval, err2 = p.handler.DeleteSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSummary: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetCourseList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetCourseList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetCourseListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetCourseListResult{}
+	var retval *CourseListResponse
+	if retval, err2 = p.handler.GetCourseList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCourseList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetTermList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetTermList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTermListResult{}
+	var retval *CourseTermListResponse
+	if retval, err2 = p.handler.GetTermList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermList: "+err2.Error())
+		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTermList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetTermsList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetTermsList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermsListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTermsListResult{}
+	var retval *TermListResponse
+	if retval, err2 = p.handler.GetTermsList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermsList: "+err2.Error())
+		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTermsList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetTerm struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetTerm) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTermResult{}
+	var retval *TermResponse
+	if retval, err2 = p.handler.GetTerm(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTerm: "+err2.Error())
+		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTerm", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDailySchedule struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDailySchedule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDailyScheduleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDailyScheduleResult{}
+	var retval *DailyScheduleResponse
+	if retval, err2 = p.handler.DailySchedule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DailySchedule: "+err2.Error())
+		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DailySchedule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorCreateUserMCPServer struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorCreateUserMCPServer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceCreateUserMCPServerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceCreateUserMCPServerResult{}
+	var retval *CreateUserMCPServerResponse
+	if retval, err2 = p.handler.CreateUserMCPServer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateUserMCPServer: "+err2.Error())
+		oprot.WriteMessageBegin("CreateUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateUserMCPServer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListUserMCPServer struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListUserMCPServer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListUserMCPServerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListUserMCPServerResult{}
+	var retval *ListUserMCPServerResponse
+	if retval, err2 = p.handler.ListUserMCPServer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListUserMCPServer: "+err2.Error())
+		oprot.WriteMessageBegin("ListUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListUserMCPServer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteUserMCPServer struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteUserMCPServer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteUserMCPServerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteUserMCPServerResult{}
+	var retval *DeleteUserMCPServerResponse
+	if retval, err2 = p.handler.DeleteUserMCPServer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteUserMCPServer: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteUserMCPServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteUserMCPServer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ApiServiceChatArgs struct {
+	Req *ChatRequest `thrift:"req,1"`
+}
+
+func NewApiServiceChatArgs() *ApiServiceChatArgs {
+	return &ApiServiceChatArgs{}
+}
+
+func (p *ApiServiceChatArgs) InitDefault() {
+}
+
+var ApiServiceChatArgs_Req_DEFAULT *ChatRequest
+
+func (p *ApiServiceChatArgs) GetReq() (v *ChatRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceChatArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceChatArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceChatArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ApiServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatArgs(%+v)", *p)
+
+}
+
+type ApiServiceChatResult struct {
+	Success *ChatResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceChatResult() *ApiServiceChatResult {
+	return &ApiServiceChatResult{}
+}
+
+func (p *ApiServiceChatResult) InitDefault() {
+}
+
+var ApiServiceChatResult_Success_DEFAULT *ChatResponse
+
+func (p *ApiServiceChatResult) GetSuccess() (v *ChatResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceChatResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceChatResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceChatResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ApiServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceChatResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatResult(%+v)", *p)
+
+}
+
+type ApiServiceChatSSEArgs struct {
+	Req *ChatSSEHandlerRequest `thrift:"req,1"`
+}
+
+func NewApiServiceChatSSEArgs() *ApiServiceChatSSEArgs {
+	return &ApiServiceChatSSEArgs{}
+}
+
+func (p *ApiServiceChatSSEArgs) InitDefault() {
+}
+
+var ApiServiceChatSSEArgs_Req_DEFAULT *ChatSSEHandlerRequest
+
+func (p *ApiServiceChatSSEArgs) GetReq() (v *ChatSSEHandlerRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceChatSSEArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceChatSSEArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceChatSSEArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceChatSSEArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatSSEHandlerRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ApiServiceChatSSEArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatSSE_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatSSEArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceChatSSEArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatSSEArgs(%+v)", *p)
+
+}
+
+type ApiServiceChatSSEResult struct {
+	Success *ChatSSEHandlerResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceChatSSEResult() *ApiServiceChatSSEResult {
+	return &ApiServiceChatSSEResult{}
+}
+
+func (p *ApiServiceChatSSEResult) InitDefault() {
+}
+
+var ApiServiceChatSSEResult_Success_DEFAULT *ChatSSEHandlerResponse
+
+func (p *ApiServiceChatSSEResult) GetSuccess() (v *ChatSSEHandlerResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceChatSSEResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceChatSSEResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceChatSSEResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceChatSSEResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorDeleteConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteConversationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatSSEHandlerResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteConversationResult{}
-	var retval *DeleteConversationResponse
-	if retval, err2 = p.handler.DeleteConversation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteConversation: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteConversation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatSSEResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatSSE_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type apiServiceProcessorListConversations struct {
-	handler ApiService
+func (p *ApiServiceChatSSEResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *apiServiceProcessorListConversations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListConversationsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatSSEResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ApiServiceChatSSEResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListConversationsResult{}
-	var retval *ListConversationsResponse
-	if retval, err2 = p.handler.ListConversations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListConversations: "+err2.Error())
-		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListConversations", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type apiServiceProcessorSummarizeConversation struct {
-	handler ApiService
+type ApiServiceApproveToolCallArgs struct {
+	Req *ApproveToolCallRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorSummarizeConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceSummarizeConversationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceApproveToolCallArgs() *ApiServiceApproveToolCallArgs {
+	return &ApiServiceApproveToolCallArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceSummarizeConversationResult{}
-	var retval *SummarizeConversationResponse
-	if retval, err2 = p.handler.SummarizeConversation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SummarizeConversation: "+err2.Error())
-		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SummarizeConversation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceApproveToolCallArgs) InitDefault() {
+}
+
+var ApiServiceApproveToolCallArgs_Req_DEFAULT *ApproveToolCallRequest
+
+func (p *ApiServiceApproveToolCallArgs) GetReq() (v *ApproveToolCallRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceApproveToolCallArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorGetLoginData struct {
-	handler ApiService
+var fieldIDToName_ApiServiceApproveToolCallArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorGetLoginData) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetLoginDataArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceApproveToolCallArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetLoginDataResult{}
-	var retval *GetLoginDataResponse
-	if retval, err2 = p.handler.GetLoginData(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginData: "+err2.Error())
-		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetLoginData", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceApproveToolCallArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorGetUserInfo struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceApproveToolCallArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetUserInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceApproveToolCallArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewApproveToolCallRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetUserInfoResult{}
-	var retval *GetUserInfoResponse
-	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInfo: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceApproveToolCallArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApproveToolCall_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceApproveToolCallArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorUpdateUserSetting struct {
-	handler ApiService
+func (p *ApiServiceApproveToolCallArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceApproveToolCallArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorUpdateUserSetting) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateUserSettingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceApproveToolCallResult struct {
+	Success *ApproveToolCallResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateUserSettingResult{}
-	var retval *UpdateUserSettingResponse
-	if retval, err2 = p.handler.UpdateUserSetting(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserSetting: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateUserSetting", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceApproveToolCallResult() *ApiServiceApproveToolCallResult {
+	return &ApiServiceApproveToolCallResult{}
 }
 
-type apiServiceProcessorCreateTodo struct {
-	handler ApiService
+func (p *ApiServiceApproveToolCallResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorCreateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceCreateTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceApproveToolCallResult_Success_DEFAULT *ApproveToolCallResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceCreateTodoResult{}
-	var retval *CreateTodoResponse
-	if retval, err2 = p.handler.CreateTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTodo: "+err2.Error())
-		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceApproveToolCallResult) GetSuccess() (v *ApproveToolCallResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceApproveToolCallResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorGetTodo struct {
-	handler ApiService
+var fieldIDToName_ApiServiceApproveToolCallResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorGetTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceApproveToolCallResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTodoResult{}
-	var retval *GetTodoResponse
-	if retval, err2 = p.handler.GetTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTodo: "+err2.Error())
-		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceApproveToolCallResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorListTodo struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceApproveToolCallResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorListTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceApproveToolCallResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewApproveToolCallResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListTodoResult{}
-	var retval *ListTodoResponse
-	if retval, err2 = p.handler.ListTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTodo: "+err2.Error())
-		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceApproveToolCallResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApproveToolCall_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ListTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceApproveToolCallResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceApproveToolCallResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceApproveToolCallResult(%+v)", *p)
+
 }
 
-type apiServiceProcessorSearchTodo struct {
-	handler ApiService
+type ApiServiceTemplateArgs struct {
+	Req *TemplateRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorSearchTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceSearchTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceTemplateArgs() *ApiServiceTemplateArgs {
+	return &ApiServiceTemplateArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceSearchTodoResult{}
-	var retval *SearchTodoResponse
-	if retval, err2 = p.handler.SearchTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTodo: "+err2.Error())
-		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SearchTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceTemplateArgs) InitDefault() {
+}
+
+var ApiServiceTemplateArgs_Req_DEFAULT *TemplateRequest
+
+func (p *ApiServiceTemplateArgs) GetReq() (v *TemplateRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTemplateArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorUpdateTodo struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTemplateArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorUpdateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTemplateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateTodoResult{}
-	var retval *UpdateTodoResponse
-	if retval, err2 = p.handler.UpdateTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTodo: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTemplateArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type apiServiceProcessorDeleteTodo struct {
-	handler ApiService
+func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTemplateRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *apiServiceProcessorDeleteTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTemplateArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Template_args"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteTodoResult{}
-	var retval *DeleteTodoResponse
-	if retval, err2 = p.handler.DeleteTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteTodo: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorGetSummary struct {
-	handler ApiService
+func (p *ApiServiceTemplateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceTemplateArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorGetSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceTemplateResult struct {
+	Success *TemplateResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetSummaryResult{}
-	var retval *GetSummaryResponse
-	if retval, err2 = p.handler.GetSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSummary: "+err2.Error())
-		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceTemplateResult() *ApiServiceTemplateResult {
+	return &ApiServiceTemplateResult{}
 }
 
-type apiServiceProcessorListSummary struct {
-	handler ApiService
+func (p *ApiServiceTemplateResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorListSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceTemplateResult_Success_DEFAULT *TemplateResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListSummaryResult{}
-	var retval *ListSummaryResponse
-	if retval, err2 = p.handler.ListSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSummary: "+err2.Error())
-		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTemplateResult) GetSuccess() (v *TemplateResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTemplateResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceTemplateResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceTemplateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceTemplateResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorUpdateSummary struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUpdateSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTemplateResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateSummaryResult{}
-	var retval *UpdateSummaryResponse
-	if retval, err2 = p.handler.UpdateSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSummary: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTemplateResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Template_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceTemplateResult(%+v)", *p)
+
 }
 
-type apiServiceProcessorDeleteSummary struct {
-	handler ApiService
+type ApiServiceGetConversationHistoryArgs struct {
+	Req *GetConversationHistoryRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorDeleteSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceGetConversationHistoryArgs() *ApiServiceGetConversationHistoryArgs {
+	return &ApiServiceGetConversationHistoryArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteSummaryResult{}
-	var retval *DeleteSummaryResponse
-	if retval, err2 = p.handler.DeleteSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSummary: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceGetConversationHistoryArgs) InitDefault() {
+}
+
+var ApiServiceGetConversationHistoryArgs_Req_DEFAULT *GetConversationHistoryRequest
+
+func (p *ApiServiceGetConversationHistoryArgs) GetReq() (v *GetConversationHistoryRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceGetConversationHistoryArgs_Req_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceGetConversationHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceGetConversationHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceGetConversationHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorGetCourseList struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorGetCourseList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetCourseListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetConversationHistoryRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetCourseListResult{}
-	var retval *CourseListResponse
-	if retval, err2 = p.handler.GetCourseList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseList: "+err2.Error())
-		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceGetConversationHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetConversationHistory_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetCourseList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceGetConversationHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorGetTermList struct {
-	handler ApiService
+func (p *ApiServiceGetConversationHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceGetConversationHistoryArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorGetTermList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceGetConversationHistoryResult struct {
+	Success *GetConversationHistoryResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermListResult{}
-	var retval *CourseTermListResponse
-	if retval, err2 = p.handler.GetTermList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermList: "+err2.Error())
-		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTermList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceGetConversationHistoryResult() *ApiServiceGetConversationHistoryResult {
+	return &ApiServiceGetConversationHistoryResult{}
 }
 
-type apiServiceProcessorGetTermsList struct {
-	handler ApiService
+func (p *ApiServiceGetConversationHistoryResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorGetTermsList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermsListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceGetConversationHistoryResult_Success_DEFAULT *GetConversationHistoryResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermsListResult{}
-	var retval *TermListResponse
-	if retval, err2 = p.handler.GetTermsList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermsList: "+err2.Error())
-		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTermsList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceGetConversationHistoryResult) GetSuccess() (v *GetConversationHistoryResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceGetConversationHistoryResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorGetTerm struct {
-	handler ApiService
+var fieldIDToName_ApiServiceGetConversationHistoryResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorGetTerm) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceGetConversationHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermResult{}
-	var retval *TermResponse
-	if retval, err2 = p.handler.GetTerm(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTerm: "+err2.Error())
-		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTerm", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceGetConversationHistoryResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorDailySchedule struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorDailySchedule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDailyScheduleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetConversationHistoryResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDailyScheduleResult{}
-	var retval *DailyScheduleResponse
-	if retval, err2 = p.handler.DailySchedule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DailySchedule: "+err2.Error())
-		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceGetConversationHistoryResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetConversationHistory_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("DailySchedule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceGetConversationHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceGetConversationHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceGetConversationHistoryResult(%+v)", *p)
+
 }
 
-type ApiServiceChatArgs struct {
-	Req *ChatRequest `thrift:"req,1"`
+type ApiServiceDeleteConversationArgs struct {
+	Req *DeleteConversationRequest `thrift:"req,1"`
 }
 
-func NewApiServiceChatArgs() *ApiServiceChatArgs {
-	return &ApiServiceChatArgs{}
+func NewApiServiceDeleteConversationArgs() *ApiServiceDeleteConversationArgs {
+	return &ApiServiceDeleteConversationArgs{}
 }
 
-func (p *ApiServiceChatArgs) InitDefault() {
+func (p *ApiServiceDeleteConversationArgs) InitDefault() {
 }
 
-var ApiServiceChatArgs_Req_DEFAULT *ChatRequest
+var ApiServiceDeleteConversationArgs_Req_DEFAULT *DeleteConversationRequest
 
-func (p *ApiServiceChatArgs) GetReq() (v *ChatRequest) {
+func (p *ApiServiceDeleteConversationArgs) GetReq() (v *DeleteConversationRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceChatArgs_Req_DEFAULT
+		return ApiServiceDeleteConversationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceChatArgs = map[int16]string{
+var fieldIDToName_ApiServiceDeleteConversationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceChatArgs) IsSetReq() bool {
+func (p *ApiServiceDeleteConversationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12260,7 +18073,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12270,8 +18083,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChatRequest()
+func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteConversationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12279,9 +18092,9 @@ func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteConversation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12307,7 +18120,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12324,43 +18137,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) String() string {
+func (p *ApiServiceDeleteConversationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteConversationArgs(%+v)", *p)
 
 }
 
-type ApiServiceChatResult struct {
-	Success *ChatResponse `thrift:"success,0,optional"`
+type ApiServiceDeleteConversationResult struct {
+	Success *DeleteConversationResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceChatResult() *ApiServiceChatResult {
-	return &ApiServiceChatResult{}
+func NewApiServiceDeleteConversationResult() *ApiServiceDeleteConversationResult {
+	return &ApiServiceDeleteConversationResult{}
 }
 
-func (p *ApiServiceChatResult) InitDefault() {
+func (p *ApiServiceDeleteConversationResult) InitDefault() {
 }
 
-var ApiServiceChatResult_Success_DEFAULT *ChatResponse
+var ApiServiceDeleteConversationResult_Success_DEFAULT *DeleteConversationResponse
 
-func (p *ApiServiceChatResult) GetSuccess() (v *ChatResponse) {
+func (p *ApiServiceDeleteConversationResult) GetSuccess() (v *DeleteConversationResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceChatResult_Success_DEFAULT
+		return ApiServiceDeleteConversationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceChatResult = map[int16]string{
+var fieldIDToName_ApiServiceDeleteConversationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceChatResult) IsSetSuccess() bool {
+func (p *ApiServiceDeleteConversationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12406,7 +18219,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12416,8 +18229,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChatResponse()
+func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteConversationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12425,9 +18238,9 @@ func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteConversation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12453,7 +18266,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12472,43 +18285,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) String() string {
+func (p *ApiServiceDeleteConversationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteConversationResult(%+v)", *p)
 
 }
 
-type ApiServiceChatSSEArgs struct {
-	Req *ChatSSEHandlerRequest `thrift:"req,1"`
+type ApiServiceListConversationsArgs struct {
+	Req *ListConversationsRequest `thrift:"req,1"`
 }
 
-func NewApiServiceChatSSEArgs() *ApiServiceChatSSEArgs {
-	return &ApiServiceChatSSEArgs{}
+func NewApiServiceListConversationsArgs() *ApiServiceListConversationsArgs {
+	return &ApiServiceListConversationsArgs{}
 }
 
-func (p *ApiServiceChatSSEArgs) InitDefault() {
+func (p *ApiServiceListConversationsArgs) InitDefault() {
 }
 
-var ApiServiceChatSSEArgs_Req_DEFAULT *ChatSSEHandlerRequest
+var ApiServiceListConversationsArgs_Req_DEFAULT *ListConversationsRequest
 
-func (p *ApiServiceChatSSEArgs) GetReq() (v *ChatSSEHandlerRequest) {
+func (p *ApiServiceListConversationsArgs) GetReq() (v *ListConversationsRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceChatSSEArgs_Req_DEFAULT
+		return ApiServiceListConversationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceChatSSEArgs = map[int16]string{
+var fieldIDToName_ApiServiceListConversationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceChatSSEArgs) IsSetReq() bool {
+func (p *ApiServiceListConversationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceChatSSEArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12554,7 +18367,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12564,8 +18377,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChatSSEHandlerRequest()
+func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListConversationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12573,9 +18386,9 @@ func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatSSEArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSE_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListConversations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12601,7 +18414,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12618,43 +18431,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) String() string {
+func (p *ApiServiceListConversationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatSSEArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListConversationsArgs(%+v)", *p)
 
 }
 
-type ApiServiceChatSSEResult struct {
-	Success *ChatSSEHandlerResponse `thrift:"success,0,optional"`
+type ApiServiceListConversationsResult struct {
+	Success *ListConversationsResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceChatSSEResult() *ApiServiceChatSSEResult {
-	return &ApiServiceChatSSEResult{}
+func NewApiServiceListConversationsResult() *ApiServiceListConversationsResult {
+	return &ApiServiceListConversationsResult{}
 }
 
-func (p *ApiServiceChatSSEResult) InitDefault() {
+func (p *ApiServiceListConversationsResult) InitDefault() {
 }
 
-var ApiServiceChatSSEResult_Success_DEFAULT *ChatSSEHandlerResponse
+var ApiServiceListConversationsResult_Success_DEFAULT *ListConversationsResponse
 
-func (p *ApiServiceChatSSEResult) GetSuccess() (v *ChatSSEHandlerResponse) {
+func (p *ApiServiceListConversationsResult) GetSuccess() (v *ListConversationsResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceChatSSEResult_Success_DEFAULT
+		return ApiServiceListConversationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceChatSSEResult = map[int16]string{
+var fieldIDToName_ApiServiceListConversationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceChatSSEResult) IsSetSuccess() bool {
+func (p *ApiServiceListConversationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceChatSSEResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12700,7 +18513,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12710,8 +18523,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChatSSEHandlerResponse()
+func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListConversationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12719,9 +18532,9 @@ func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatSSEResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSE_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListConversations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12747,7 +18560,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12766,43 +18579,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) String() string {
+func (p *ApiServiceListConversationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatSSEResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListConversationsResult(%+v)", *p)
 
 }
 
-type ApiServiceTemplateArgs struct {
-	Req *TemplateRequest `thrift:"req,1"`
+type ApiServiceSummarizeConversationArgs struct {
+	Req *SummarizeConversationRequest `thrift:"req,1"`
 }
 
-func NewApiServiceTemplateArgs() *ApiServiceTemplateArgs {
-	return &ApiServiceTemplateArgs{}
+func NewApiServiceSummarizeConversationArgs() *ApiServiceSummarizeConversationArgs {
+	return &ApiServiceSummarizeConversationArgs{}
 }
 
-func (p *ApiServiceTemplateArgs) InitDefault() {
+func (p *ApiServiceSummarizeConversationArgs) InitDefault() {
 }
 
-var ApiServiceTemplateArgs_Req_DEFAULT *TemplateRequest
+var ApiServiceSummarizeConversationArgs_Req_DEFAULT *SummarizeConversationRequest
 
-func (p *ApiServiceTemplateArgs) GetReq() (v *TemplateRequest) {
+func (p *ApiServiceSummarizeConversationArgs) GetReq() (v *SummarizeConversationRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTemplateArgs_Req_DEFAULT
+		return ApiServiceSummarizeConversationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTemplateArgs = map[int16]string{
+var fieldIDToName_ApiServiceSummarizeConversationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTemplateArgs) IsSetReq() bool {
+func (p *ApiServiceSummarizeConversationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTemplateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12848,7 +18661,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12858,8 +18671,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTemplateRequest()
+func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSummarizeConversationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12867,9 +18680,9 @@ func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceTemplateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Template_args"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12895,7 +18708,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12912,43 +18725,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) String() string {
+func (p *ApiServiceSummarizeConversationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTemplateArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSummarizeConversationArgs(%+v)", *p)
 
 }
 
-type ApiServiceTemplateResult struct {
-	Success *TemplateResponse `thrift:"success,0,optional"`
+type ApiServiceSummarizeConversationResult struct {
+	Success *SummarizeConversationResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceTemplateResult() *ApiServiceTemplateResult {
-	return &ApiServiceTemplateResult{}
+func NewApiServiceSummarizeConversationResult() *ApiServiceSummarizeConversationResult {
+	return &ApiServiceSummarizeConversationResult{}
 }
 
-func (p *ApiServiceTemplateResult) InitDefault() {
+func (p *ApiServiceSummarizeConversationResult) InitDefault() {
 }
 
-var ApiServiceTemplateResult_Success_DEFAULT *TemplateResponse
+var ApiServiceSummarizeConversationResult_Success_DEFAULT *SummarizeConversationResponse
 
-func (p *ApiServiceTemplateResult) GetSuccess() (v *TemplateResponse) {
+func (p *ApiServiceSummarizeConversationResult) GetSuccess() (v *SummarizeConversationResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTemplateResult_Success_DEFAULT
+		return ApiServiceSummarizeConversationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTemplateResult = map[int16]string{
+var fieldIDToName_ApiServiceSummarizeConversationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTemplateResult) IsSetSuccess() bool {
+func (p *ApiServiceSummarizeConversationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTemplateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12994,7 +18807,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13004,8 +18817,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewTemplateResponse()
+func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSummarizeConversationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13013,9 +18826,9 @@ func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceTemplateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Template_result"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13041,7 +18854,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13060,43 +18873,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) String() string {
+func (p *ApiServiceSummarizeConversationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTemplateResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSummarizeConversationResult(%+v)", *p)
 
 }
 
-type ApiServiceGetConversationHistoryArgs struct {
-	Req *GetConversationHistoryRequest `thrift:"req,1"`
+type ApiServiceGetLoginDataArgs struct {
+	Req *GetLoginDataRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetConversationHistoryArgs() *ApiServiceGetConversationHistoryArgs {
-	return &ApiServiceGetConversationHistoryArgs{}
+func NewApiServiceGetLoginDataArgs() *ApiServiceGetLoginDataArgs {
+	return &ApiServiceGetLoginDataArgs{}
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) InitDefault() {
+func (p *ApiServiceGetLoginDataArgs) InitDefault() {
 }
 
-var ApiServiceGetConversationHistoryArgs_Req_DEFAULT *GetConversationHistoryRequest
+var ApiServiceGetLoginDataArgs_Req_DEFAULT *GetLoginDataRequest
 
-func (p *ApiServiceGetConversationHistoryArgs) GetReq() (v *GetConversationHistoryRequest) {
+func (p *ApiServiceGetLoginDataArgs) GetReq() (v *GetLoginDataRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetConversationHistoryArgs_Req_DEFAULT
+		return ApiServiceGetLoginDataArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetConversationHistoryArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetLoginDataArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) IsSetReq() bool {
+func (p *ApiServiceGetLoginDataArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13142,7 +18955,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13152,8 +18965,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetConversationHistoryRequest()
+func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetLoginDataRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13161,9 +18974,9 @@ func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistory_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginData_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13189,7 +19002,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13206,43 +19019,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) String() string {
+func (p *ApiServiceGetLoginDataArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetConversationHistoryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetLoginDataArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetConversationHistoryResult struct {
-	Success *GetConversationHistoryResponse `thrift:"success,0,optional"`
+type ApiServiceGetLoginDataResult struct {
+	Success *GetLoginDataResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetConversationHistoryResult() *ApiServiceGetConversationHistoryResult {
-	return &ApiServiceGetConversationHistoryResult{}
+func NewApiServiceGetLoginDataResult() *ApiServiceGetLoginDataResult {
+	return &ApiServiceGetLoginDataResult{}
 }
 
-func (p *ApiServiceGetConversationHistoryResult) InitDefault() {
+func (p *ApiServiceGetLoginDataResult) InitDefault() {
 }
 
-var ApiServiceGetConversationHistoryResult_Success_DEFAULT *GetConversationHistoryResponse
+var ApiServiceGetLoginDataResult_Success_DEFAULT *GetLoginDataResponse
 
-func (p *ApiServiceGetConversationHistoryResult) GetSuccess() (v *GetConversationHistoryResponse) {
+func (p *ApiServiceGetLoginDataResult) GetSuccess() (v *GetLoginDataResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetConversationHistoryResult_Success_DEFAULT
+		return ApiServiceGetLoginDataResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetConversationHistoryResult = map[int16]string{
+var fieldIDToName_ApiServiceGetLoginDataResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetConversationHistoryResult) IsSetSuccess() bool {
+func (p *ApiServiceGetLoginDataResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetConversationHistoryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13288,7 +19101,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13298,8 +19111,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetConversationHistoryResponse()
+func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetLoginDataResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13307,9 +19120,9 @@ func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ApiServiceGetConversationHistoryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistory_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginData_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13335,7 +19148,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13354,43 +19167,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) String() string {
+func (p *ApiServiceGetLoginDataResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetConversationHistoryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetLoginDataResult(%+v)", *p)
 
 }
 
-type ApiServiceDeleteConversationArgs struct {
-	Req *DeleteConversationRequest `thrift:"req,1"`
+type ApiServiceGetUserInfoArgs struct {
+	Req *GetUserInfoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceDeleteConversationArgs() *ApiServiceDeleteConversationArgs {
-	return &ApiServiceDeleteConversationArgs{}
+func NewApiServiceGetUserInfoArgs() *ApiServiceGetUserInfoArgs {
+	return &ApiServiceGetUserInfoArgs{}
 }
 
-func (p *ApiServiceDeleteConversationArgs) InitDefault() {
+func (p *ApiServiceGetUserInfoArgs) InitDefault() {
 }
 
-var ApiServiceDeleteConversationArgs_Req_DEFAULT *DeleteConversationRequest
+var ApiServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoRequest
 
-func (p *ApiServiceDeleteConversationArgs) GetReq() (v *DeleteConversationRequest) {
+func (p *ApiServiceGetUserInfoArgs) GetReq() (v *GetUserInfoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceDeleteConversationArgs_Req_DEFAULT
+		return ApiServiceGetUserInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceDeleteConversationArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetUserInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceDeleteConversationArgs) IsSetReq() bool {
+func (p *ApiServiceGetUserInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceDeleteConversationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13436,7 +19249,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13446,8 +19259,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteConversationRequest()
+func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13455,9 +19268,9 @@ func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *ApiServiceDeleteConversationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversation_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13483,7 +19296,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13500,43 +19313,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) String() string {
+func (p *ApiServiceGetUserInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteConversationArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserInfoArgs(%+v)", *p)
 
 }
 
-type ApiServiceDeleteConversationResult struct {
-	Success *DeleteConversationResponse `thrift:"success,0,optional"`
+type ApiServiceGetUserInfoResult struct {
+	Success *GetUserInfoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceDeleteConversationResult() *ApiServiceDeleteConversationResult {
-	return &ApiServiceDeleteConversationResult{}
+func NewApiServiceGetUserInfoResult() *ApiServiceGetUserInfoResult {
+	return &ApiServiceGetUserInfoResult{}
 }
 
-func (p *ApiServiceDeleteConversationResult) InitDefault() {
+func (p *ApiServiceGetUserInfoResult) InitDefault() {
 }
 
-var ApiServiceDeleteConversationResult_Success_DEFAULT *DeleteConversationResponse
+var ApiServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResponse
 
-func (p *ApiServiceDeleteConversationResult) GetSuccess() (v *DeleteConversationResponse) {
+func (p *ApiServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceDeleteConversationResult_Success_DEFAULT
+		return ApiServiceGetUserInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceDeleteConversationResult = map[int16]string{
+var fieldIDToName_ApiServiceGetUserInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceDeleteConversationResult) IsSetSuccess() bool {
+func (p *ApiServiceGetUserInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceDeleteConversationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13582,7 +19395,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13592,8 +19405,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteConversationResponse()
+func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13601,9 +19414,9 @@ func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ApiServiceDeleteConversationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversation_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13629,7 +19442,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13648,43 +19461,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) String() string {
+func (p *ApiServiceGetUserInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteConversationResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserInfoResult(%+v)", *p)
 
 }
 
-type ApiServiceListConversationsArgs struct {
-	Req *ListConversationsRequest `thrift:"req,1"`
+type ApiServiceUpdateUserSettingArgs struct {
+	Req *UpdateUserSettingRequest `thrift:"req,1"`
 }
 
-func NewApiServiceListConversationsArgs() *ApiServiceListConversationsArgs {
-	return &ApiServiceListConversationsArgs{}
+func NewApiServiceUpdateUserSettingArgs() *ApiServiceUpdateUserSettingArgs {
+	return &ApiServiceUpdateUserSettingArgs{}
 }
 
-func (p *ApiServiceListConversationsArgs) InitDefault() {
+func (p *ApiServiceUpdateUserSettingArgs) InitDefault() {
 }
 
-var ApiServiceListConversationsArgs_Req_DEFAULT *ListConversationsRequest
+var ApiServiceUpdateUserSettingArgs_Req_DEFAULT *UpdateUserSettingRequest
 
-func (p *ApiServiceListConversationsArgs) GetReq() (v *ListConversationsRequest) {
+func (p *ApiServiceUpdateUserSettingArgs) GetReq() (v *UpdateUserSettingRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceListConversationsArgs_Req_DEFAULT
+		return ApiServiceUpdateUserSettingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceListConversationsArgs = map[int16]string{
+var fieldIDToName_ApiServiceUpdateUserSettingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceListConversationsArgs) IsSetReq() bool {
+func (p *ApiServiceUpdateUserSettingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceListConversationsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13730,7 +19543,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13740,8 +19553,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListConversationsRequest()
+func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserSettingRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13749,9 +19562,9 @@ func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *ApiServiceListConversationsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListConversations_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSetting_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13777,7 +19590,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13794,43 +19607,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) String() string {
+func (p *ApiServiceUpdateUserSettingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListConversationsArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateUserSettingArgs(%+v)", *p)
 
 }
 
-type ApiServiceListConversationsResult struct {
-	Success *ListConversationsResponse `thrift:"success,0,optional"`
+type ApiServiceUpdateUserSettingResult struct {
+	Success *UpdateUserSettingResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceListConversationsResult() *ApiServiceListConversationsResult {
-	return &ApiServiceListConversationsResult{}
+func NewApiServiceUpdateUserSettingResult() *ApiServiceUpdateUserSettingResult {
+	return &ApiServiceUpdateUserSettingResult{}
 }
 
-func (p *ApiServiceListConversationsResult) InitDefault() {
+func (p *ApiServiceUpdateUserSettingResult) InitDefault() {
 }
 
-var ApiServiceListConversationsResult_Success_DEFAULT *ListConversationsResponse
+var ApiServiceUpdateUserSettingResult_Success_DEFAULT *UpdateUserSettingResponse
 
-func (p *ApiServiceListConversationsResult) GetSuccess() (v *ListConversationsResponse) {
+func (p *ApiServiceUpdateUserSettingResult) GetSuccess() (v *UpdateUserSettingResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceListConversationsResult_Success_DEFAULT
+		return ApiServiceUpdateUserSettingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceListConversationsResult = map[int16]string{
+var fieldIDToName_ApiServiceUpdateUserSettingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceListConversationsResult) IsSetSuccess() bool {
+func (p *ApiServiceUpdateUserSettingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceListConversationsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13876,7 +19689,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13886,8 +19699,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListConversationsResponse()
+func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserSettingResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13895,9 +19708,9 @@ func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ApiServiceListConversationsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListConversations_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSetting_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13923,7 +19736,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13942,43 +19755,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) String() string {
+func (p *ApiServiceUpdateUserSettingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListConversationsResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateUserSettingResult(%+v)", *p)
 
 }
 
-type ApiServiceSummarizeConversationArgs struct {
-	Req *SummarizeConversationRequest `thrift:"req,1"`
+type ApiServiceGetUserUsageArgs struct {
+	Req *GetUserUsageRequest `thrift:"req,1"`
 }
 
-func NewApiServiceSummarizeConversationArgs() *ApiServiceSummarizeConversationArgs {
-	return &ApiServiceSummarizeConversationArgs{}
+func NewApiServiceGetUserUsageArgs() *ApiServiceGetUserUsageArgs {
+	return &ApiServiceGetUserUsageArgs{}
 }
 
-func (p *ApiServiceSummarizeConversationArgs) InitDefault() {
+func (p *ApiServiceGetUserUsageArgs) InitDefault() {
 }
 
-var ApiServiceSummarizeConversationArgs_Req_DEFAULT *SummarizeConversationRequest
+var ApiServiceGetUserUsageArgs_Req_DEFAULT *GetUserUsageRequest
 
-func (p *ApiServiceSummarizeConversationArgs) GetReq() (v *SummarizeConversationRequest) {
+func (p *ApiServiceGetUserUsageArgs) GetReq() (v *GetUserUsageRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceSummarizeConversationArgs_Req_DEFAULT
+		return ApiServiceGetUserUsageArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceSummarizeConversationArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetUserUsageArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceSummarizeConversationArgs) IsSetReq() bool {
+func (p *ApiServiceGetUserUsageArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceSummarizeConversationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14024,7 +19837,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserUsageArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14034,8 +19847,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSummarizeConversationRequest()
+func (p *ApiServiceGetUserUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserUsageRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14043,9 +19856,9 @@ func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ApiServiceSummarizeConversationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversation_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserUsage_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14071,7 +19884,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14088,43 +19901,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) String() string {
+func (p *ApiServiceGetUserUsageArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSummarizeConversationArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserUsageArgs(%+v)", *p)
 
 }
 
-type ApiServiceSummarizeConversationResult struct {
-	Success *SummarizeConversationResponse `thrift:"success,0,optional"`
+type ApiServiceGetUserUsageResult struct {
+	Success *GetUserUsageResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceSummarizeConversationResult() *ApiServiceSummarizeConversationResult {
-	return &ApiServiceSummarizeConversationResult{}
+func NewApiServiceGetUserUsageResult() *ApiServiceGetUserUsageResult {
+	return &ApiServiceGetUserUsageResult{}
 }
 
-func (p *ApiServiceSummarizeConversationResult) InitDefault() {
+func (p *ApiServiceGetUserUsageResult) InitDefault() {
 }
 
-var ApiServiceSummarizeConversationResult_Success_DEFAULT *SummarizeConversationResponse
+var ApiServiceGetUserUsageResult_Success_DEFAULT *GetUserUsageResponse
 
-func (p *ApiServiceSummarizeConversationResult) GetSuccess() (v *SummarizeConversationResponse) {
+func (p *ApiServiceGetUserUsageResult) GetSuccess() (v *GetUserUsageResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceSummarizeConversationResult_Success_DEFAULT
+		return ApiServiceGetUserUsageResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceSummarizeConversationResult = map[int16]string{
+var fieldIDToName_ApiServiceGetUserUsageResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceSummarizeConversationResult) IsSetSuccess() bool {
+func (p *ApiServiceGetUserUsageResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceSummarizeConversationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14170,7 +19983,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserUsageResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14180,8 +19993,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSummarizeConversationResponse()
+func (p *ApiServiceGetUserUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserUsageResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14189,9 +20002,9 @@ func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ApiServiceSummarizeConversationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversation_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserUsage_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14217,7 +20030,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14236,43 +20049,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) String() string {
+func (p *ApiServiceGetUserUsageResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSummarizeConversationResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserUsageResult(%+v)", *p)
 
 }
 
-type ApiServiceGetLoginDataArgs struct {
-	Req *GetLoginDataRequest `thrift:"req,1"`
+type ApiServiceCreateTodoArgs struct {
+	Req *CreateTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetLoginDataArgs() *ApiServiceGetLoginDataArgs {
-	return &ApiServiceGetLoginDataArgs{}
+func NewApiServiceCreateTodoArgs() *ApiServiceCreateTodoArgs {
+	return &ApiServiceCreateTodoArgs{}
 }
 
-func (p *ApiServiceGetLoginDataArgs) InitDefault() {
+func (p *ApiServiceCreateTodoArgs) InitDefault() {
 }
 
-var ApiServiceGetLoginDataArgs_Req_DEFAULT *GetLoginDataRequest
+var ApiServiceCreateTodoArgs_Req_DEFAULT *CreateTodoRequest
 
-func (p *ApiServiceGetLoginDataArgs) GetReq() (v *GetLoginDataRequest) {
+func (p *ApiServiceCreateTodoArgs) GetReq() (v *CreateTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetLoginDataArgs_Req_DEFAULT
+		return ApiServiceCreateTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetLoginDataArgs = map[int16]string{
+var fieldIDToName_ApiServiceCreateTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetLoginDataArgs) IsSetReq() bool {
+func (p *ApiServiceCreateTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetLoginDataArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14318,7 +20131,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14328,8 +20141,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetLoginDataRequest()
+func (p *ApiServiceCreateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14337,9 +20150,9 @@ func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetLoginDataArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginData_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14365,7 +20178,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14382,43 +20195,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) String() string {
+func (p *ApiServiceCreateTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetLoginDataArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceCreateTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetLoginDataResult struct {
-	Success *GetLoginDataResponse `thrift:"success,0,optional"`
+type ApiServiceCreateTodoResult struct {
+	Success *CreateTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetLoginDataResult() *ApiServiceGetLoginDataResult {
-	return &ApiServiceGetLoginDataResult{}
+func NewApiServiceCreateTodoResult() *ApiServiceCreateTodoResult {
+	return &ApiServiceCreateTodoResult{}
 }
 
-func (p *ApiServiceGetLoginDataResult) InitDefault() {
+func (p *ApiServiceCreateTodoResult) InitDefault() {
 }
 
-var ApiServiceGetLoginDataResult_Success_DEFAULT *GetLoginDataResponse
+var ApiServiceCreateTodoResult_Success_DEFAULT *CreateTodoResponse
 
-func (p *ApiServiceGetLoginDataResult) GetSuccess() (v *GetLoginDataResponse) {
+func (p *ApiServiceCreateTodoResult) GetSuccess() (v *CreateTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetLoginDataResult_Success_DEFAULT
+		return ApiServiceCreateTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetLoginDataResult = map[int16]string{
+var fieldIDToName_ApiServiceCreateTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetLoginDataResult) IsSetSuccess() bool {
+func (p *ApiServiceCreateTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetLoginDataResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14464,7 +20277,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14474,8 +20287,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetLoginDataResponse()
+func (p *ApiServiceCreateTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14483,9 +20296,9 @@ func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceGetLoginDataResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginData_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14511,7 +20324,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14530,43 +20343,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) String() string {
+func (p *ApiServiceCreateTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetLoginDataResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceCreateTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceGetUserInfoArgs struct {
-	Req *GetUserInfoRequest `thrift:"req,1"`
+type ApiServiceGetTodoArgs struct {
+	Req *GetTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetUserInfoArgs() *ApiServiceGetUserInfoArgs {
-	return &ApiServiceGetUserInfoArgs{}
+func NewApiServiceGetTodoArgs() *ApiServiceGetTodoArgs {
+	return &ApiServiceGetTodoArgs{}
 }
 
-func (p *ApiServiceGetUserInfoArgs) InitDefault() {
+func (p *ApiServiceGetTodoArgs) InitDefault() {
 }
 
-var ApiServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoRequest
+var ApiServiceGetTodoArgs_Req_DEFAULT *GetTodoRequest
 
-func (p *ApiServiceGetUserInfoArgs) GetReq() (v *GetUserInfoRequest) {
+func (p *ApiServiceGetTodoArgs) GetReq() (v *GetTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetUserInfoArgs_Req_DEFAULT
+		return ApiServiceGetTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *ApiServiceGetTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14612,7 +20425,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14622,8 +20435,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoRequest()
+func (p *ApiServiceGetTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14631,9 +20444,9 @@ func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14659,7 +20472,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14676,43 +20489,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) String() string {
+func (p *ApiServiceGetTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetUserInfoResult struct {
-	Success *GetUserInfoResponse `thrift:"success,0,optional"`
+type ApiServiceGetTodoResult struct {
+	Success *GetTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetUserInfoResult() *ApiServiceGetUserInfoResult {
-	return &ApiServiceGetUserInfoResult{}
+func NewApiServiceGetTodoResult() *ApiServiceGetTodoResult {
+	return &ApiServiceGetTodoResult{}
 }
 
-func (p *ApiServiceGetUserInfoResult) InitDefault() {
+func (p *ApiServiceGetTodoResult) InitDefault() {
 }
 
-var ApiServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResponse
+var ApiServiceGetTodoResult_Success_DEFAULT *GetTodoResponse
 
-func (p *ApiServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResponse) {
+func (p *ApiServiceGetTodoResult) GetSuccess() (v *GetTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetUserInfoResult_Success_DEFAULT
+		return ApiServiceGetTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceGetTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceGetTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14758,7 +20571,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14768,8 +20581,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoResponse()
+func (p *ApiServiceGetTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14777,9 +20590,9 @@ func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14805,7 +20618,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14824,43 +20637,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) String() string {
+func (p *ApiServiceGetTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceUpdateUserSettingArgs struct {
-	Req *UpdateUserSettingRequest `thrift:"req,1"`
+type ApiServiceListTodoArgs struct {
+	Req *ListTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUpdateUserSettingArgs() *ApiServiceUpdateUserSettingArgs {
-	return &ApiServiceUpdateUserSettingArgs{}
+func NewApiServiceListTodoArgs() *ApiServiceListTodoArgs {
+	return &ApiServiceListTodoArgs{}
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) InitDefault() {
+func (p *ApiServiceListTodoArgs) InitDefault() {
 }
 
-var ApiServiceUpdateUserSettingArgs_Req_DEFAULT *UpdateUserSettingRequest
+var ApiServiceListTodoArgs_Req_DEFAULT *ListTodoRequest
 
-func (p *ApiServiceUpdateUserSettingArgs) GetReq() (v *UpdateUserSettingRequest) {
+func (p *ApiServiceListTodoArgs) GetReq() (v *ListTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUpdateUserSettingArgs_Req_DEFAULT
+		return ApiServiceListTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUpdateUserSettingArgs = map[int16]string{
+var fieldIDToName_ApiServiceListTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) IsSetReq() bool {
+func (p *ApiServiceListTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14906,7 +20719,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14916,8 +20729,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserSettingRequest()
+func (p *ApiServiceListTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14925,9 +20738,9 @@ func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSetting_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14953,7 +20766,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14970,43 +20783,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) String() string {
+func (p *ApiServiceListTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateUserSettingArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceUpdateUserSettingResult struct {
-	Success *UpdateUserSettingResponse `thrift:"success,0,optional"`
+type ApiServiceListTodoResult struct {
+	Success *ListTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUpdateUserSettingResult() *ApiServiceUpdateUserSettingResult {
-	return &ApiServiceUpdateUserSettingResult{}
+func NewApiServiceListTodoResult() *ApiServiceListTodoResult {
+	return &ApiServiceListTodoResult{}
 }
 
-func (p *ApiServiceUpdateUserSettingResult) InitDefault() {
+func (p *ApiServiceListTodoResult) InitDefault() {
 }
 
-var ApiServiceUpdateUserSettingResult_Success_DEFAULT *UpdateUserSettingResponse
+var ApiServiceListTodoResult_Success_DEFAULT *ListTodoResponse
 
-func (p *ApiServiceUpdateUserSettingResult) GetSuccess() (v *UpdateUserSettingResponse) {
+func (p *ApiServiceListTodoResult) GetSuccess() (v *ListTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUpdateUserSettingResult_Success_DEFAULT
+		return ApiServiceListTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUpdateUserSettingResult = map[int16]string{
+var fieldIDToName_ApiServiceListTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUpdateUserSettingResult) IsSetSuccess() bool {
+func (p *ApiServiceListTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUpdateUserSettingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15052,7 +20865,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15062,8 +20875,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserSettingResponse()
+func (p *ApiServiceListTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15071,9 +20884,9 @@ func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ApiServiceUpdateUserSettingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSetting_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15099,7 +20912,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -15118,43 +20931,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) String() string {
+func (p *ApiServiceListTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateUserSettingResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceCreateTodoArgs struct {
-	Req *CreateTodoRequest `thrift:"req,1"`
+type ApiServiceSearchTodoArgs struct {
+	Req *SearchTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceCreateTodoArgs() *ApiServiceCreateTodoArgs {
-	return &ApiServiceCreateTodoArgs{}
+func NewApiServiceSearchTodoArgs() *ApiServiceSearchTodoArgs {
+	return &ApiServiceSearchTodoArgs{}
 }
 
-func (p *ApiServiceCreateTodoArgs) InitDefault() {
+func (p *ApiServiceSearchTodoArgs) InitDefault() {
 }
 
-var ApiServiceCreateTodoArgs_Req_DEFAULT *CreateTodoRequest
+var ApiServiceSearchTodoArgs_Req_DEFAULT *SearchTodoRequest
 
-func (p *ApiServiceCreateTodoArgs) GetReq() (v *CreateTodoRequest) {
+func (p *ApiServiceSearchTodoArgs) GetReq() (v *SearchTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceCreateTodoArgs_Req_DEFAULT
+		return ApiServiceSearchTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceCreateTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceSearchTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceCreateTodoArgs) IsSetReq() bool {
+func (p *ApiServiceSearchTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceCreateTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15200,7 +21013,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSearchTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15210,8 +21023,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateTodoRequest()
+func (p *ApiServiceSearchTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15219,9 +21032,9 @@ func (p *ApiServiceCreateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceCreateTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15247,7 +21060,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15264,43 +21077,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoArgs) String() string {
+func (p *ApiServiceSearchTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceCreateTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSearchTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceCreateTodoResult struct {
-	Success *CreateTodoResponse `thrift:"success,0,optional"`
+type ApiServiceSearchTodoResult struct {
+	Success *SearchTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceCreateTodoResult() *ApiServiceCreateTodoResult {
-	return &ApiServiceCreateTodoResult{}
+func NewApiServiceSearchTodoResult() *ApiServiceSearchTodoResult {
+	return &ApiServiceSearchTodoResult{}
 }
 
-func (p *ApiServiceCreateTodoResult) InitDefault() {
+func (p *ApiServiceSearchTodoResult) InitDefault() {
 }
 
-var ApiServiceCreateTodoResult_Success_DEFAULT *CreateTodoResponse
+var ApiServiceSearchTodoResult_Success_DEFAULT *SearchTodoResponse
 
-func (p *ApiServiceCreateTodoResult) GetSuccess() (v *CreateTodoResponse) {
+func (p *ApiServiceSearchTodoResult) GetSuccess() (v *SearchTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceCreateTodoResult_Success_DEFAULT
+		return ApiServiceSearchTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceCreateTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceSearchTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceCreateTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceSearchTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceCreateTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15346,7 +21159,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSearchTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15356,8 +21169,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateTodoResponse()
+func (p *ApiServiceSearchTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15365,9 +21178,9 @@ func (p *ApiServiceCreateTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceCreateTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15393,7 +21206,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSearchTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -15412,43 +21225,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceCreateTodoResult) String() string {
+func (p *ApiServiceSearchTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceCreateTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSearchTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceGetTodoArgs struct {
-	Req *GetTodoRequest `thrift:"req,1"`
+type ApiServiceUpdateTodoArgs struct {
+	Req *UpdateTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetTodoArgs() *ApiServiceGetTodoArgs {
-	return &ApiServiceGetTodoArgs{}
+func NewApiServiceUpdateTodoArgs() *ApiServiceUpdateTodoArgs {
+	return &ApiServiceUpdateTodoArgs{}
 }
 
-func (p *ApiServiceGetTodoArgs) InitDefault() {
+func (p *ApiServiceUpdateTodoArgs) InitDefault() {
 }
 
-var ApiServiceGetTodoArgs_Req_DEFAULT *GetTodoRequest
+var ApiServiceUpdateTodoArgs_Req_DEFAULT *UpdateTodoRequest
 
-func (p *ApiServiceGetTodoArgs) GetReq() (v *GetTodoRequest) {
+func (p *ApiServiceUpdateTodoArgs) GetReq() (v *UpdateTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetTodoArgs_Req_DEFAULT
+		return ApiServiceUpdateTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceUpdateTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetTodoArgs) IsSetReq() bool {
+func (p *ApiServiceUpdateTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15494,7 +21307,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15504,8 +21317,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTodoRequest()
+func (p *ApiServiceUpdateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15513,9 +21326,9 @@ func (p *ApiServiceGetTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15541,7 +21354,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15558,43 +21371,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoArgs) String() string {
+func (p *ApiServiceUpdateTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetTodoResult struct {
-	Success *GetTodoResponse `thrift:"success,0,optional"`
+type ApiServiceUpdateTodoResult struct {
+	Success *UpdateTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetTodoResult() *ApiServiceGetTodoResult {
-	return &ApiServiceGetTodoResult{}
+func NewApiServiceUpdateTodoResult() *ApiServiceUpdateTodoResult {
+	return &ApiServiceUpdateTodoResult{}
 }
 
-func (p *ApiServiceGetTodoResult) InitDefault() {
+func (p *ApiServiceUpdateTodoResult) InitDefault() {
 }
 
-var ApiServiceGetTodoResult_Success_DEFAULT *GetTodoResponse
+var ApiServiceUpdateTodoResult_Success_DEFAULT *UpdateTodoResponse
 
-func (p *ApiServiceGetTodoResult) GetSuccess() (v *GetTodoResponse) {
+func (p *ApiServiceUpdateTodoResult) GetSuccess() (v *UpdateTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetTodoResult_Success_DEFAULT
+		return ApiServiceUpdateTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceUpdateTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceUpdateTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15640,7 +21453,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15650,8 +21463,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTodoResponse()
+func (p *ApiServiceUpdateTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15659,9 +21472,9 @@ func (p *ApiServiceGetTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15687,7 +21500,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -15706,43 +21519,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTodoResult) String() string {
+func (p *ApiServiceUpdateTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceListTodoArgs struct {
-	Req *ListTodoRequest `thrift:"req,1"`
+type ApiServiceDeleteTodoArgs struct {
+	Req *DeleteTodoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceListTodoArgs() *ApiServiceListTodoArgs {
-	return &ApiServiceListTodoArgs{}
+func NewApiServiceDeleteTodoArgs() *ApiServiceDeleteTodoArgs {
+	return &ApiServiceDeleteTodoArgs{}
 }
 
-func (p *ApiServiceListTodoArgs) InitDefault() {
+func (p *ApiServiceDeleteTodoArgs) InitDefault() {
 }
 
-var ApiServiceListTodoArgs_Req_DEFAULT *ListTodoRequest
+var ApiServiceDeleteTodoArgs_Req_DEFAULT *DeleteTodoRequest
 
-func (p *ApiServiceListTodoArgs) GetReq() (v *ListTodoRequest) {
+func (p *ApiServiceDeleteTodoArgs) GetReq() (v *DeleteTodoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceListTodoArgs_Req_DEFAULT
+		return ApiServiceDeleteTodoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceListTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceDeleteTodoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceListTodoArgs) IsSetReq() bool {
+func (p *ApiServiceDeleteTodoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceListTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15788,7 +21601,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteTodoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15798,8 +21611,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListTodoRequest()
+func (p *ApiServiceDeleteTodoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteTodoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15807,9 +21620,9 @@ func (p *ApiServiceListTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceListTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteTodo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15835,7 +21648,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15852,43 +21665,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoArgs) String() string {
+func (p *ApiServiceDeleteTodoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteTodoArgs(%+v)", *p)
 
 }
 
-type ApiServiceListTodoResult struct {
-	Success *ListTodoResponse `thrift:"success,0,optional"`
+type ApiServiceDeleteTodoResult struct {
+	Success *DeleteTodoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceListTodoResult() *ApiServiceListTodoResult {
-	return &ApiServiceListTodoResult{}
+func NewApiServiceDeleteTodoResult() *ApiServiceDeleteTodoResult {
+	return &ApiServiceDeleteTodoResult{}
 }
 
-func (p *ApiServiceListTodoResult) InitDefault() {
+func (p *ApiServiceDeleteTodoResult) InitDefault() {
 }
 
-var ApiServiceListTodoResult_Success_DEFAULT *ListTodoResponse
+var ApiServiceDeleteTodoResult_Success_DEFAULT *DeleteTodoResponse
 
-func (p *ApiServiceListTodoResult) GetSuccess() (v *ListTodoResponse) {
+func (p *ApiServiceDeleteTodoResult) GetSuccess() (v *DeleteTodoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceListTodoResult_Success_DEFAULT
+		return ApiServiceDeleteTodoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceListTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceDeleteTodoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceListTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceDeleteTodoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceListTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15934,7 +21747,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteTodoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15944,8 +21757,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListTodoResponse()
+func (p *ApiServiceDeleteTodoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteTodoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15953,9 +21766,9 @@ func (p *ApiServiceListTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceListTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteTodo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15981,7 +21794,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -16000,43 +21813,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceListTodoResult) String() string {
+func (p *ApiServiceDeleteTodoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteTodoResult(%+v)", *p)
 
 }
 
-type ApiServiceSearchTodoArgs struct {
-	Req *SearchTodoRequest `thrift:"req,1"`
+type ApiServiceGetSummaryArgs struct {
+	Req *GetSummaryRequest `thrift:"req,1"`
 }
 
-func NewApiServiceSearchTodoArgs() *ApiServiceSearchTodoArgs {
-	return &ApiServiceSearchTodoArgs{}
+func NewApiServiceGetSummaryArgs() *ApiServiceGetSummaryArgs {
+	return &ApiServiceGetSummaryArgs{}
 }
 
-func (p *ApiServiceSearchTodoArgs) InitDefault() {
+func (p *ApiServiceGetSummaryArgs) InitDefault() {
 }
 
-var ApiServiceSearchTodoArgs_Req_DEFAULT *SearchTodoRequest
+var ApiServiceGetSummaryArgs_Req_DEFAULT *GetSummaryRequest
 
-func (p *ApiServiceSearchTodoArgs) GetReq() (v *SearchTodoRequest) {
+func (p *ApiServiceGetSummaryArgs) GetReq() (v *GetSummaryRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceSearchTodoArgs_Req_DEFAULT
+		return ApiServiceGetSummaryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceSearchTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetSummaryArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceSearchTodoArgs) IsSetReq() bool {
+func (p *ApiServiceGetSummaryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceSearchTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16082,7 +21895,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSearchTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetSummaryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16092,8 +21905,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchTodoRequest()
+func (p *ApiServiceGetSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetSummaryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16101,9 +21914,9 @@ func (p *ApiServiceSearchTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceSearchTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummary_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16129,7 +21942,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16146,43 +21959,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoArgs) String() string {
+func (p *ApiServiceGetSummaryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSearchTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetSummaryArgs(%+v)", *p)
 
 }
 
-type ApiServiceSearchTodoResult struct {
-	Success *SearchTodoResponse `thrift:"success,0,optional"`
+type ApiServiceGetSummaryResult struct {
+	Success *GetSummaryResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceSearchTodoResult() *ApiServiceSearchTodoResult {
-	return &ApiServiceSearchTodoResult{}
+func NewApiServiceGetSummaryResult() *ApiServiceGetSummaryResult {
+	return &ApiServiceGetSummaryResult{}
 }
 
-func (p *ApiServiceSearchTodoResult) InitDefault() {
+func (p *ApiServiceGetSummaryResult) InitDefault() {
 }
 
-var ApiServiceSearchTodoResult_Success_DEFAULT *SearchTodoResponse
+var ApiServiceGetSummaryResult_Success_DEFAULT *GetSummaryResponse
 
-func (p *ApiServiceSearchTodoResult) GetSuccess() (v *SearchTodoResponse) {
+func (p *ApiServiceGetSummaryResult) GetSuccess() (v *GetSummaryResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceSearchTodoResult_Success_DEFAULT
+		return ApiServiceGetSummaryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceSearchTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceGetSummaryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceSearchTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceGetSummaryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceSearchTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16228,7 +22041,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSearchTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetSummaryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16238,8 +22051,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchTodoResponse()
+func (p *ApiServiceGetSummaryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetSummaryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16247,9 +22060,9 @@ func (p *ApiServiceSearchTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceSearchTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummary_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16275,7 +22088,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -16294,43 +22107,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceSearchTodoResult) String() string {
+func (p *ApiServiceGetSummaryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSearchTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetSummaryResult(%+v)", *p)
 
 }
 
-type ApiServiceUpdateTodoArgs struct {
-	Req *UpdateTodoRequest `thrift:"req,1"`
+type ApiServiceListSummaryArgs struct {
+	Req *ListSummaryRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUpdateTodoArgs() *ApiServiceUpdateTodoArgs {
-	return &ApiServiceUpdateTodoArgs{}
+func NewApiServiceListSummaryArgs() *ApiServiceListSummaryArgs {
+	return &ApiServiceListSummaryArgs{}
 }
 
-func (p *ApiServiceUpdateTodoArgs) InitDefault() {
+func (p *ApiServiceListSummaryArgs) InitDefault() {
 }
 
-var ApiServiceUpdateTodoArgs_Req_DEFAULT *UpdateTodoRequest
+var ApiServiceListSummaryArgs_Req_DEFAULT *ListSummaryRequest
 
-func (p *ApiServiceUpdateTodoArgs) GetReq() (v *UpdateTodoRequest) {
+func (p *ApiServiceListSummaryArgs) GetReq() (v *ListSummaryRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUpdateTodoArgs_Req_DEFAULT
+		return ApiServiceListSummaryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUpdateTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceListSummaryArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUpdateTodoArgs) IsSetReq() bool {
+func (p *ApiServiceListSummaryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUpdateTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16376,7 +22189,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListSummaryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16386,8 +22199,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateTodoRequest()
+func (p *ApiServiceListSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSummaryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16395,9 +22208,9 @@ func (p *ApiServiceUpdateTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceUpdateTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListSummary_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16423,7 +22236,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16440,43 +22253,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoArgs) String() string {
+func (p *ApiServiceListSummaryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListSummaryArgs(%+v)", *p)
 
 }
 
-type ApiServiceUpdateTodoResult struct {
-	Success *UpdateTodoResponse `thrift:"success,0,optional"`
+type ApiServiceListSummaryResult struct {
+	Success *ListSummaryResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUpdateTodoResult() *ApiServiceUpdateTodoResult {
-	return &ApiServiceUpdateTodoResult{}
+func NewApiServiceListSummaryResult() *ApiServiceListSummaryResult {
+	return &ApiServiceListSummaryResult{}
 }
 
-func (p *ApiServiceUpdateTodoResult) InitDefault() {
+func (p *ApiServiceListSummaryResult) InitDefault() {
 }
 
-var ApiServiceUpdateTodoResult_Success_DEFAULT *UpdateTodoResponse
+var ApiServiceListSummaryResult_Success_DEFAULT *ListSummaryResponse
 
-func (p *ApiServiceUpdateTodoResult) GetSuccess() (v *UpdateTodoResponse) {
+func (p *ApiServiceListSummaryResult) GetSuccess() (v *ListSummaryResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUpdateTodoResult_Success_DEFAULT
+		return ApiServiceListSummaryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUpdateTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceListSummaryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUpdateTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceListSummaryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUpdateTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16522,7 +22335,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListSummaryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16532,8 +22345,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateTodoResponse()
+func (p *ApiServiceListSummaryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSummaryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16541,9 +22354,9 @@ func (p *ApiServiceUpdateTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceUpdateTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListSummary_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16569,7 +22382,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -16588,43 +22401,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateTodoResult) String() string {
+func (p *ApiServiceListSummaryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListSummaryResult(%+v)", *p)
 
 }
 
-type ApiServiceDeleteTodoArgs struct {
-	Req *DeleteTodoRequest `thrift:"req,1"`
+type ApiServiceUpdateSummaryArgs struct {
+	Req *UpdateSummaryRequest `thrift:"req,1"`
 }
 
-func NewApiServiceDeleteTodoArgs() *ApiServiceDeleteTodoArgs {
-	return &ApiServiceDeleteTodoArgs{}
+func NewApiServiceUpdateSummaryArgs() *ApiServiceUpdateSummaryArgs {
+	return &ApiServiceUpdateSummaryArgs{}
 }
 
-func (p *ApiServiceDeleteTodoArgs) InitDefault() {
+func (p *ApiServiceUpdateSummaryArgs) InitDefault() {
 }
 
-var ApiServiceDeleteTodoArgs_Req_DEFAULT *DeleteTodoRequest
+var ApiServiceUpdateSummaryArgs_Req_DEFAULT *UpdateSummaryRequest
 
-func (p *ApiServiceDeleteTodoArgs) GetReq() (v *DeleteTodoRequest) {
+func (p *ApiServiceUpdateSummaryArgs) GetReq() (v *UpdateSummaryRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceDeleteTodoArgs_Req_DEFAULT
+		return ApiServiceUpdateSummaryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceDeleteTodoArgs = map[int16]string{
+var fieldIDToName_ApiServiceUpdateSummaryArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceDeleteTodoArgs) IsSetReq() bool {
+func (p *ApiServiceUpdateSummaryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceDeleteTodoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16670,7 +22483,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteTodoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateSummaryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16680,8 +22493,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteTodoRequest()
+func (p *ApiServiceUpdateSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateSummaryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16689,9 +22502,9 @@ func (p *ApiServiceDeleteTodoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceDeleteTodoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodo_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummary_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16717,7 +22530,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16734,43 +22547,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoArgs) String() string {
+func (p *ApiServiceUpdateSummaryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteTodoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateSummaryArgs(%+v)", *p)
 
 }
 
-type ApiServiceDeleteTodoResult struct {
-	Success *DeleteTodoResponse `thrift:"success,0,optional"`
+type ApiServiceUpdateSummaryResult struct {
+	Success *UpdateSummaryResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceDeleteTodoResult() *ApiServiceDeleteTodoResult {
-	return &ApiServiceDeleteTodoResult{}
+func NewApiServiceUpdateSummaryResult() *ApiServiceUpdateSummaryResult {
+	return &ApiServiceUpdateSummaryResult{}
 }
 
-func (p *ApiServiceDeleteTodoResult) InitDefault() {
+func (p *ApiServiceUpdateSummaryResult) InitDefault() {
 }
 
-var ApiServiceDeleteTodoResult_Success_DEFAULT *DeleteTodoResponse
+var ApiServiceUpdateSummaryResult_Success_DEFAULT *UpdateSummaryResponse
 
-func (p *ApiServiceDeleteTodoResult) GetSuccess() (v *DeleteTodoResponse) {
+func (p *ApiServiceUpdateSummaryResult) GetSuccess() (v *UpdateSummaryResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceDeleteTodoResult_Success_DEFAULT
+		return ApiServiceUpdateSummaryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceDeleteTodoResult = map[int16]string{
+var fieldIDToName_ApiServiceUpdateSummaryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceDeleteTodoResult) IsSetSuccess() bool {
+func (p *ApiServiceUpdateSummaryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceDeleteTodoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16816,7 +22629,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteTodoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateSummaryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16826,8 +22639,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteTodoResponse()
+func (p *ApiServiceUpdateSummaryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateSummaryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16835,9 +22648,9 @@ func (p *ApiServiceDeleteTodoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceDeleteTodoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodo_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummary_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16863,7 +22676,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -16882,43 +22695,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteTodoResult) String() string {
+func (p *ApiServiceUpdateSummaryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteTodoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateSummaryResult(%+v)", *p)
 
 }
 
-type ApiServiceGetSummaryArgs struct {
-	Req *GetSummaryRequest `thrift:"req,1"`
+type ApiServiceDeleteSummaryArgs struct {
+	Req *DeleteSummaryRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetSummaryArgs() *ApiServiceGetSummaryArgs {
-	return &ApiServiceGetSummaryArgs{}
+func NewApiServiceDeleteSummaryArgs() *ApiServiceDeleteSummaryArgs {
+	return &ApiServiceDeleteSummaryArgs{}
 }
 
-func (p *ApiServiceGetSummaryArgs) InitDefault() {
+func (p *ApiServiceDeleteSummaryArgs) InitDefault() {
 }
 
-var ApiServiceGetSummaryArgs_Req_DEFAULT *GetSummaryRequest
+var ApiServiceDeleteSummaryArgs_Req_DEFAULT *DeleteSummaryRequest
 
-func (p *ApiServiceGetSummaryArgs) GetReq() (v *GetSummaryRequest) {
+func (p *ApiServiceDeleteSummaryArgs) GetReq() (v *DeleteSummaryRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetSummaryArgs_Req_DEFAULT
+		return ApiServiceDeleteSummaryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetSummaryArgs = map[int16]string{
+var fieldIDToName_ApiServiceDeleteSummaryArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetSummaryArgs) IsSetReq() bool {
+func (p *ApiServiceDeleteSummaryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16964,7 +22777,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetSummaryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteSummaryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16974,8 +22787,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetSummaryRequest()
+func (p *ApiServiceDeleteSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteSummaryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -16983,9 +22796,9 @@ func (p *ApiServiceGetSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummary_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteSummary_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17011,7 +22824,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17028,43 +22841,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryArgs) String() string {
+func (p *ApiServiceDeleteSummaryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetSummaryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteSummaryArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetSummaryResult struct {
-	Success *GetSummaryResponse `thrift:"success,0,optional"`
+type ApiServiceDeleteSummaryResult struct {
+	Success *DeleteSummaryResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetSummaryResult() *ApiServiceGetSummaryResult {
-	return &ApiServiceGetSummaryResult{}
+func NewApiServiceDeleteSummaryResult() *ApiServiceDeleteSummaryResult {
+	return &ApiServiceDeleteSummaryResult{}
 }
 
-func (p *ApiServiceGetSummaryResult) InitDefault() {
+func (p *ApiServiceDeleteSummaryResult) InitDefault() {
 }
 
-var ApiServiceGetSummaryResult_Success_DEFAULT *GetSummaryResponse
+var ApiServiceDeleteSummaryResult_Success_DEFAULT *DeleteSummaryResponse
 
-func (p *ApiServiceGetSummaryResult) GetSuccess() (v *GetSummaryResponse) {
+func (p *ApiServiceDeleteSummaryResult) GetSuccess() (v *DeleteSummaryResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetSummaryResult_Success_DEFAULT
+		return ApiServiceDeleteSummaryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetSummaryResult = map[int16]string{
+var fieldIDToName_ApiServiceDeleteSummaryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetSummaryResult) IsSetSuccess() bool {
+func (p *ApiServiceDeleteSummaryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetSummaryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17110,7 +22923,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetSummaryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteSummaryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17120,8 +22933,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetSummaryResponse()
+func (p *ApiServiceDeleteSummaryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteSummaryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17129,9 +22942,9 @@ func (p *ApiServiceGetSummaryResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetSummaryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummary_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteSummary_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17157,7 +22970,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17176,43 +22989,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetSummaryResult) String() string {
+func (p *ApiServiceDeleteSummaryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetSummaryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteSummaryResult(%+v)", *p)
 
 }
 
-type ApiServiceListSummaryArgs struct {
-	Req *ListSummaryRequest `thrift:"req,1"`
+type ApiServiceGetCourseListArgs struct {
+	Req *CourseListRequest `thrift:"req,1"`
 }
 
-func NewApiServiceListSummaryArgs() *ApiServiceListSummaryArgs {
-	return &ApiServiceListSummaryArgs{}
+func NewApiServiceGetCourseListArgs() *ApiServiceGetCourseListArgs {
+	return &ApiServiceGetCourseListArgs{}
 }
 
-func (p *ApiServiceListSummaryArgs) InitDefault() {
+func (p *ApiServiceGetCourseListArgs) InitDefault() {
 }
 
-var ApiServiceListSummaryArgs_Req_DEFAULT *ListSummaryRequest
+var ApiServiceGetCourseListArgs_Req_DEFAULT *CourseListRequest
 
-func (p *ApiServiceListSummaryArgs) GetReq() (v *ListSummaryRequest) {
+func (p *ApiServiceGetCourseListArgs) GetReq() (v *CourseListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceListSummaryArgs_Req_DEFAULT
+		return ApiServiceGetCourseListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceListSummaryArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetCourseListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceListSummaryArgs) IsSetReq() bool {
+func (p *ApiServiceGetCourseListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceListSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17258,7 +23071,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListSummaryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetCourseListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17268,8 +23081,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListSummaryRequest()
+func (p *ApiServiceGetCourseListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCourseListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17277,9 +23090,9 @@ func (p *ApiServiceListSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceListSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSummary_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetCourseList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17305,7 +23118,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17322,43 +23135,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryArgs) String() string {
+func (p *ApiServiceGetCourseListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListSummaryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetCourseListArgs(%+v)", *p)
 
 }
 
-type ApiServiceListSummaryResult struct {
-	Success *ListSummaryResponse `thrift:"success,0,optional"`
+type ApiServiceGetCourseListResult struct {
+	Success *CourseListResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceListSummaryResult() *ApiServiceListSummaryResult {
-	return &ApiServiceListSummaryResult{}
+func NewApiServiceGetCourseListResult() *ApiServiceGetCourseListResult {
+	return &ApiServiceGetCourseListResult{}
 }
 
-func (p *ApiServiceListSummaryResult) InitDefault() {
+func (p *ApiServiceGetCourseListResult) InitDefault() {
 }
 
-var ApiServiceListSummaryResult_Success_DEFAULT *ListSummaryResponse
+var ApiServiceGetCourseListResult_Success_DEFAULT *CourseListResponse
 
-func (p *ApiServiceListSummaryResult) GetSuccess() (v *ListSummaryResponse) {
+func (p *ApiServiceGetCourseListResult) GetSuccess() (v *CourseListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceListSummaryResult_Success_DEFAULT
+		return ApiServiceGetCourseListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceListSummaryResult = map[int16]string{
+var fieldIDToName_ApiServiceGetCourseListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceListSummaryResult) IsSetSuccess() bool {
+func (p *ApiServiceGetCourseListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceListSummaryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17404,7 +23217,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListSummaryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetCourseListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17414,8 +23227,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListSummaryResponse()
+func (p *ApiServiceGetCourseListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCourseListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17423,9 +23236,9 @@ func (p *ApiServiceListSummaryResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceListSummaryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSummary_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetCourseList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17451,7 +23264,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetCourseListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17470,43 +23283,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceListSummaryResult) String() string {
+func (p *ApiServiceGetCourseListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListSummaryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetCourseListResult(%+v)", *p)
 
 }
 
-type ApiServiceUpdateSummaryArgs struct {
-	Req *UpdateSummaryRequest `thrift:"req,1"`
+type ApiServiceGetTermListArgs struct {
+	Req *CourseTermListRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUpdateSummaryArgs() *ApiServiceUpdateSummaryArgs {
-	return &ApiServiceUpdateSummaryArgs{}
+func NewApiServiceGetTermListArgs() *ApiServiceGetTermListArgs {
+	return &ApiServiceGetTermListArgs{}
 }
 
-func (p *ApiServiceUpdateSummaryArgs) InitDefault() {
+func (p *ApiServiceGetTermListArgs) InitDefault() {
 }
 
-var ApiServiceUpdateSummaryArgs_Req_DEFAULT *UpdateSummaryRequest
+var ApiServiceGetTermListArgs_Req_DEFAULT *CourseTermListRequest
 
-func (p *ApiServiceUpdateSummaryArgs) GetReq() (v *UpdateSummaryRequest) {
+func (p *ApiServiceGetTermListArgs) GetReq() (v *CourseTermListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUpdateSummaryArgs_Req_DEFAULT
+		return ApiServiceGetTermListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUpdateSummaryArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetTermListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUpdateSummaryArgs) IsSetReq() bool {
+func (p *ApiServiceGetTermListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUpdateSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17552,7 +23365,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateSummaryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17562,8 +23375,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateSummaryRequest()
+func (p *ApiServiceGetTermListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCourseTermListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17571,9 +23384,9 @@ func (p *ApiServiceUpdateSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceUpdateSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummary_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTermList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17599,7 +23412,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17616,43 +23429,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryArgs) String() string {
+func (p *ApiServiceGetTermListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateSummaryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermListArgs(%+v)", *p)
 
 }
 
-type ApiServiceUpdateSummaryResult struct {
-	Success *UpdateSummaryResponse `thrift:"success,0,optional"`
+type ApiServiceGetTermListResult struct {
+	Success *CourseTermListResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUpdateSummaryResult() *ApiServiceUpdateSummaryResult {
-	return &ApiServiceUpdateSummaryResult{}
+func NewApiServiceGetTermListResult() *ApiServiceGetTermListResult {
+	return &ApiServiceGetTermListResult{}
 }
 
-func (p *ApiServiceUpdateSummaryResult) InitDefault() {
+func (p *ApiServiceGetTermListResult) InitDefault() {
 }
 
-var ApiServiceUpdateSummaryResult_Success_DEFAULT *UpdateSummaryResponse
+var ApiServiceGetTermListResult_Success_DEFAULT *CourseTermListResponse
 
-func (p *ApiServiceUpdateSummaryResult) GetSuccess() (v *UpdateSummaryResponse) {
+func (p *ApiServiceGetTermListResult) GetSuccess() (v *CourseTermListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUpdateSummaryResult_Success_DEFAULT
+		return ApiServiceGetTermListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUpdateSummaryResult = map[int16]string{
+var fieldIDToName_ApiServiceGetTermListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUpdateSummaryResult) IsSetSuccess() bool {
+func (p *ApiServiceGetTermListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUpdateSummaryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17698,7 +23511,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateSummaryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17708,8 +23521,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateSummaryResponse()
+func (p *ApiServiceGetTermListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCourseTermListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17717,9 +23530,9 @@ func (p *ApiServiceUpdateSummaryResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceUpdateSummaryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummary_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTermList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17745,7 +23558,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17764,43 +23577,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateSummaryResult) String() string {
+func (p *ApiServiceGetTermListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateSummaryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermListResult(%+v)", *p)
 
 }
 
-type ApiServiceDeleteSummaryArgs struct {
-	Req *DeleteSummaryRequest `thrift:"req,1"`
+type ApiServiceGetTermsListArgs struct {
+	Req *TermListRequest `thrift:"req,1"`
 }
 
-func NewApiServiceDeleteSummaryArgs() *ApiServiceDeleteSummaryArgs {
-	return &ApiServiceDeleteSummaryArgs{}
+func NewApiServiceGetTermsListArgs() *ApiServiceGetTermsListArgs {
+	return &ApiServiceGetTermsListArgs{}
 }
 
-func (p *ApiServiceDeleteSummaryArgs) InitDefault() {
+func (p *ApiServiceGetTermsListArgs) InitDefault() {
 }
 
-var ApiServiceDeleteSummaryArgs_Req_DEFAULT *DeleteSummaryRequest
+var ApiServiceGetTermsListArgs_Req_DEFAULT *TermListRequest
 
-func (p *ApiServiceDeleteSummaryArgs) GetReq() (v *DeleteSummaryRequest) {
+func (p *ApiServiceGetTermsListArgs) GetReq() (v *TermListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceDeleteSummaryArgs_Req_DEFAULT
+		return ApiServiceGetTermsListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceDeleteSummaryArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetTermsListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceDeleteSummaryArgs) IsSetReq() bool {
+func (p *ApiServiceGetTermsListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceDeleteSummaryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17846,7 +23659,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteSummaryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermsListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17856,8 +23669,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteSummaryRequest()
+func (p *ApiServiceGetTermsListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTermListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -17865,9 +23678,9 @@ func (p *ApiServiceDeleteSummaryArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceDeleteSummaryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummary_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTermsList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17893,7 +23706,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17910,43 +23723,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryArgs) String() string {
+func (p *ApiServiceGetTermsListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteSummaryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermsListArgs(%+v)", *p)
 
 }
 
-type ApiServiceDeleteSummaryResult struct {
-	Success *DeleteSummaryResponse `thrift:"success,0,optional"`
+type ApiServiceGetTermsListResult struct {
+	Success *TermListResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceDeleteSummaryResult() *ApiServiceDeleteSummaryResult {
-	return &ApiServiceDeleteSummaryResult{}
+func NewApiServiceGetTermsListResult() *ApiServiceGetTermsListResult {
+	return &ApiServiceGetTermsListResult{}
 }
 
-func (p *ApiServiceDeleteSummaryResult) InitDefault() {
+func (p *ApiServiceGetTermsListResult) InitDefault() {
 }
 
-var ApiServiceDeleteSummaryResult_Success_DEFAULT *DeleteSummaryResponse
+var ApiServiceGetTermsListResult_Success_DEFAULT *TermListResponse
 
-func (p *ApiServiceDeleteSummaryResult) GetSuccess() (v *DeleteSummaryResponse) {
+func (p *ApiServiceGetTermsListResult) GetSuccess() (v *TermListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceDeleteSummaryResult_Success_DEFAULT
+		return ApiServiceGetTermsListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceDeleteSummaryResult = map[int16]string{
+var fieldIDToName_ApiServiceGetTermsListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceDeleteSummaryResult) IsSetSuccess() bool {
+func (p *ApiServiceGetTermsListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceDeleteSummaryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17992,7 +23805,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteSummaryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermsListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18002,8 +23815,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteSummaryResponse()
+func (p *ApiServiceGetTermsListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTermListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18011,9 +23824,9 @@ func (p *ApiServiceDeleteSummaryResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceDeleteSummaryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummary_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTermsList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18039,7 +23852,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermsListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18058,43 +23871,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteSummaryResult) String() string {
+func (p *ApiServiceGetTermsListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteSummaryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermsListResult(%+v)", *p)
 
 }
 
-type ApiServiceGetCourseListArgs struct {
-	Req *CourseListRequest `thrift:"req,1"`
+type ApiServiceGetTermArgs struct {
+	Req *TermRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetCourseListArgs() *ApiServiceGetCourseListArgs {
-	return &ApiServiceGetCourseListArgs{}
+func NewApiServiceGetTermArgs() *ApiServiceGetTermArgs {
+	return &ApiServiceGetTermArgs{}
 }
 
-func (p *ApiServiceGetCourseListArgs) InitDefault() {
+func (p *ApiServiceGetTermArgs) InitDefault() {
 }
 
-var ApiServiceGetCourseListArgs_Req_DEFAULT *CourseListRequest
+var ApiServiceGetTermArgs_Req_DEFAULT *TermRequest
 
-func (p *ApiServiceGetCourseListArgs) GetReq() (v *CourseListRequest) {
+func (p *ApiServiceGetTermArgs) GetReq() (v *TermRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetCourseListArgs_Req_DEFAULT
+		return ApiServiceGetTermArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetCourseListArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetTermArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetCourseListArgs) IsSetReq() bool {
+func (p *ApiServiceGetTermArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetCourseListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18140,7 +23953,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetCourseListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18150,8 +23963,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCourseListRequest()
+func (p *ApiServiceGetTermArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTermRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18159,9 +23972,9 @@ func (p *ApiServiceGetCourseListArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetCourseListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseList_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTerm_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18187,7 +24000,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18204,43 +24017,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListArgs) String() string {
+func (p *ApiServiceGetTermArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetCourseListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetCourseListResult struct {
-	Success *CourseListResponse `thrift:"success,0,optional"`
+type ApiServiceGetTermResult struct {
+	Success *TermResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetCourseListResult() *ApiServiceGetCourseListResult {
-	return &ApiServiceGetCourseListResult{}
+func NewApiServiceGetTermResult() *ApiServiceGetTermResult {
+	return &ApiServiceGetTermResult{}
 }
 
-func (p *ApiServiceGetCourseListResult) InitDefault() {
+func (p *ApiServiceGetTermResult) InitDefault() {
 }
 
-var ApiServiceGetCourseListResult_Success_DEFAULT *CourseListResponse
+var ApiServiceGetTermResult_Success_DEFAULT *TermResponse
 
-func (p *ApiServiceGetCourseListResult) GetSuccess() (v *CourseListResponse) {
+func (p *ApiServiceGetTermResult) GetSuccess() (v *TermResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetCourseListResult_Success_DEFAULT
+		return ApiServiceGetTermResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetCourseListResult = map[int16]string{
+var fieldIDToName_ApiServiceGetTermResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetCourseListResult) IsSetSuccess() bool {
+func (p *ApiServiceGetTermResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetCourseListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18286,7 +24099,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetCourseListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18296,8 +24109,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCourseListResponse()
+func (p *ApiServiceGetTermResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTermResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18305,9 +24118,9 @@ func (p *ApiServiceGetCourseListResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceGetCourseListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseList_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTerm_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18333,7 +24146,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetTermResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18352,43 +24165,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetCourseListResult) String() string {
+func (p *ApiServiceGetTermResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetCourseListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetTermResult(%+v)", *p)
 
 }
 
-type ApiServiceGetTermListArgs struct {
-	Req *CourseTermListRequest `thrift:"req,1"`
+type ApiServiceDailyScheduleArgs struct {
+	Req *DailyScheduleRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetTermListArgs() *ApiServiceGetTermListArgs {
-	return &ApiServiceGetTermListArgs{}
+func NewApiServiceDailyScheduleArgs() *ApiServiceDailyScheduleArgs {
+	return &ApiServiceDailyScheduleArgs{}
 }
 
-func (p *ApiServiceGetTermListArgs) InitDefault() {
+func (p *ApiServiceDailyScheduleArgs) InitDefault() {
 }
 
-var ApiServiceGetTermListArgs_Req_DEFAULT *CourseTermListRequest
+var ApiServiceDailyScheduleArgs_Req_DEFAULT *DailyScheduleRequest
 
-func (p *ApiServiceGetTermListArgs) GetReq() (v *CourseTermListRequest) {
+func (p *ApiServiceDailyScheduleArgs) GetReq() (v *DailyScheduleRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetTermListArgs_Req_DEFAULT
+		return ApiServiceDailyScheduleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetTermListArgs = map[int16]string{
+var fieldIDToName_ApiServiceDailyScheduleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetTermListArgs) IsSetReq() bool {
+func (p *ApiServiceDailyScheduleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetTermListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18434,7 +24247,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDailyScheduleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18444,8 +24257,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCourseTermListRequest()
+func (p *ApiServiceDailyScheduleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDailyScheduleRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18453,9 +24266,9 @@ func (p *ApiServiceGetTermListArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTermListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTermList_args"); err != nil {
+	if err = oprot.WriteStructBegin("DailySchedule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18481,7 +24294,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18498,43 +24311,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListArgs) String() string {
+func (p *ApiServiceDailyScheduleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDailyScheduleArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetTermListResult struct {
-	Success *CourseTermListResponse `thrift:"success,0,optional"`
+type ApiServiceDailyScheduleResult struct {
+	Success *DailyScheduleResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetTermListResult() *ApiServiceGetTermListResult {
-	return &ApiServiceGetTermListResult{}
+func NewApiServiceDailyScheduleResult() *ApiServiceDailyScheduleResult {
+	return &ApiServiceDailyScheduleResult{}
 }
 
-func (p *ApiServiceGetTermListResult) InitDefault() {
+func (p *ApiServiceDailyScheduleResult) InitDefault() {
 }
 
-var ApiServiceGetTermListResult_Success_DEFAULT *CourseTermListResponse
+var ApiServiceDailyScheduleResult_Success_DEFAULT *DailyScheduleResponse
 
-func (p *ApiServiceGetTermListResult) GetSuccess() (v *CourseTermListResponse) {
+func (p *ApiServiceDailyScheduleResult) GetSuccess() (v *DailyScheduleResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetTermListResult_Success_DEFAULT
+		return ApiServiceDailyScheduleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetTermListResult = map[int16]string{
+var fieldIDToName_ApiServiceDailyScheduleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetTermListResult) IsSetSuccess() bool {
+func (p *ApiServiceDailyScheduleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetTermListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18580,7 +24393,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDailyScheduleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18590,8 +24403,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCourseTermListResponse()
+func (p *ApiServiceDailyScheduleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDailyScheduleResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18599,9 +24412,9 @@ func (p *ApiServiceGetTermListResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTermListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTermList_result"); err != nil {
+	if err = oprot.WriteStructBegin("DailySchedule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18627,7 +24440,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDailyScheduleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18646,43 +24459,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermListResult) String() string {
+func (p *ApiServiceDailyScheduleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDailyScheduleResult(%+v)", *p)
 
 }
 
-type ApiServiceGetTermsListArgs struct {
-	Req *TermListRequest `thrift:"req,1"`
+type ApiServiceCreateUserMCPServerArgs struct {
+	Req *CreateUserMCPServerRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetTermsListArgs() *ApiServiceGetTermsListArgs {
-	return &ApiServiceGetTermsListArgs{}
+func NewApiServiceCreateUserMCPServerArgs() *ApiServiceCreateUserMCPServerArgs {
+	return &ApiServiceCreateUserMCPServerArgs{}
 }
 
-func (p *ApiServiceGetTermsListArgs) InitDefault() {
+func (p *ApiServiceCreateUserMCPServerArgs) InitDefault() {
 }
 
-var ApiServiceGetTermsListArgs_Req_DEFAULT *TermListRequest
+var ApiServiceCreateUserMCPServerArgs_Req_DEFAULT *CreateUserMCPServerRequest
 
-func (p *ApiServiceGetTermsListArgs) GetReq() (v *TermListRequest) {
+func (p *ApiServiceCreateUserMCPServerArgs) GetReq() (v *CreateUserMCPServerRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetTermsListArgs_Req_DEFAULT
+		return ApiServiceCreateUserMCPServerArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetTermsListArgs = map[int16]string{
+var fieldIDToName_ApiServiceCreateUserMCPServerArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetTermsListArgs) IsSetReq() bool {
+func (p *ApiServiceCreateUserMCPServerArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetTermsListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18728,7 +24541,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermsListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateUserMCPServerArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18738,8 +24551,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTermListRequest()
+func (p *ApiServiceCreateUserMCPServerArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateUserMCPServerRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18747,9 +24560,9 @@ func (p *ApiServiceGetTermsListArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTermsListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTermsList_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateUserMCPServer_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18775,7 +24588,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18792,43 +24605,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListArgs) String() string {
+func (p *ApiServiceCreateUserMCPServerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermsListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceCreateUserMCPServerArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetTermsListResult struct {
-	Success *TermListResponse `thrift:"success,0,optional"`
+type ApiServiceCreateUserMCPServerResult struct {
+	Success *CreateUserMCPServerResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetTermsListResult() *ApiServiceGetTermsListResult {
-	return &ApiServiceGetTermsListResult{}
+func NewApiServiceCreateUserMCPServerResult() *ApiServiceCreateUserMCPServerResult {
+	return &ApiServiceCreateUserMCPServerResult{}
 }
 
-func (p *ApiServiceGetTermsListResult) InitDefault() {
+func (p *ApiServiceCreateUserMCPServerResult) InitDefault() {
 }
 
-var ApiServiceGetTermsListResult_Success_DEFAULT *TermListResponse
+var ApiServiceCreateUserMCPServerResult_Success_DEFAULT *CreateUserMCPServerResponse
 
-func (p *ApiServiceGetTermsListResult) GetSuccess() (v *TermListResponse) {
+func (p *ApiServiceCreateUserMCPServerResult) GetSuccess() (v *CreateUserMCPServerResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetTermsListResult_Success_DEFAULT
+		return ApiServiceCreateUserMCPServerResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetTermsListResult = map[int16]string{
+var fieldIDToName_ApiServiceCreateUserMCPServerResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetTermsListResult) IsSetSuccess() bool {
+func (p *ApiServiceCreateUserMCPServerResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetTermsListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18874,7 +24687,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermsListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceCreateUserMCPServerResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18884,8 +24697,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewTermListResponse()
+func (p *ApiServiceCreateUserMCPServerResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateUserMCPServerResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -18893,9 +24706,9 @@ func (p *ApiServiceGetTermsListResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceGetTermsListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTermsList_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateUserMCPServer_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18921,7 +24734,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceCreateUserMCPServerResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18940,43 +24753,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermsListResult) String() string {
+func (p *ApiServiceCreateUserMCPServerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermsListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceCreateUserMCPServerResult(%+v)", *p)
 
 }
 
-type ApiServiceGetTermArgs struct {
-	Req *TermRequest `thrift:"req,1"`
+type ApiServiceListUserMCPServerArgs struct {
+	Req *ListUserMCPServerRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetTermArgs() *ApiServiceGetTermArgs {
-	return &ApiServiceGetTermArgs{}
+func NewApiServiceListUserMCPServerArgs() *ApiServiceListUserMCPServerArgs {
+	return &ApiServiceListUserMCPServerArgs{}
 }
 
-func (p *ApiServiceGetTermArgs) InitDefault() {
+func (p *ApiServiceListUserMCPServerArgs) InitDefault() {
 }
 
-var ApiServiceGetTermArgs_Req_DEFAULT *TermRequest
+var ApiServiceListUserMCPServerArgs_Req_DEFAULT *ListUserMCPServerRequest
 
-func (p *ApiServiceGetTermArgs) GetReq() (v *TermRequest) {
+func (p *ApiServiceListUserMCPServerArgs) GetReq() (v *ListUserMCPServerRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetTermArgs_Req_DEFAULT
+		return ApiServiceListUserMCPServerArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetTermArgs = map[int16]string{
+var fieldIDToName_ApiServiceListUserMCPServerArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetTermArgs) IsSetReq() bool {
+func (p *ApiServiceListUserMCPServerArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetTermArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19022,7 +24835,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListUserMCPServerArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19032,8 +24845,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTermRequest()
+func (p *ApiServiceListUserMCPServerArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListUserMCPServerRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -19041,9 +24854,9 @@ func (p *ApiServiceGetTermArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTermArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTerm_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListUserMCPServer_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19069,7 +24882,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19086,43 +24899,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermArgs) String() string {
+func (p *ApiServiceListUserMCPServerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListUserMCPServerArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetTermResult struct {
-	Success *TermResponse `thrift:"success,0,optional"`
+type ApiServiceListUserMCPServerResult struct {
+	Success *ListUserMCPServerResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetTermResult() *ApiServiceGetTermResult {
-	return &ApiServiceGetTermResult{}
+func NewApiServiceListUserMCPServerResult() *ApiServiceListUserMCPServerResult {
+	return &ApiServiceListUserMCPServerResult{}
 }
 
-func (p *ApiServiceGetTermResult) InitDefault() {
+func (p *ApiServiceListUserMCPServerResult) InitDefault() {
 }
 
-var ApiServiceGetTermResult_Success_DEFAULT *TermResponse
+var ApiServiceListUserMCPServerResult_Success_DEFAULT *ListUserMCPServerResponse
 
-func (p *ApiServiceGetTermResult) GetSuccess() (v *TermResponse) {
+func (p *ApiServiceListUserMCPServerResult) GetSuccess() (v *ListUserMCPServerResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetTermResult_Success_DEFAULT
+		return ApiServiceListUserMCPServerResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetTermResult = map[int16]string{
+var fieldIDToName_ApiServiceListUserMCPServerResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetTermResult) IsSetSuccess() bool {
+func (p *ApiServiceListUserMCPServerResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetTermResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19168,7 +24981,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetTermResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListUserMCPServerResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19178,8 +24991,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewTermResponse()
+func (p *ApiServiceListUserMCPServerResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListUserMCPServerResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -19187,9 +25000,9 @@ func (p *ApiServiceGetTermResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetTermResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTerm_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListUserMCPServer_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19215,7 +25028,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListUserMCPServerResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19234,43 +25047,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetTermResult) String() string {
+func (p *ApiServiceListUserMCPServerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetTermResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListUserMCPServerResult(%+v)", *p)
 
 }
 
-type ApiServiceDailyScheduleArgs struct {
-	Req *DailyScheduleRequest `thrift:"req,1"`
+type ApiServiceDeleteUserMCPServerArgs struct {
+	Req *DeleteUserMCPServerRequest `thrift:"req,1"`
 }
 
-func NewApiServiceDailyScheduleArgs() *ApiServiceDailyScheduleArgs {
-	return &ApiServiceDailyScheduleArgs{}
+func NewApiServiceDeleteUserMCPServerArgs() *ApiServiceDeleteUserMCPServerArgs {
+	return &ApiServiceDeleteUserMCPServerArgs{}
 }
 
-func (p *ApiServiceDailyScheduleArgs) InitDefault() {
+func (p *ApiServiceDeleteUserMCPServerArgs) InitDefault() {
 }
 
-var ApiServiceDailyScheduleArgs_Req_DEFAULT *DailyScheduleRequest
+var ApiServiceDeleteUserMCPServerArgs_Req_DEFAULT *DeleteUserMCPServerRequest
 
-func (p *ApiServiceDailyScheduleArgs) GetReq() (v *DailyScheduleRequest) {
+func (p *ApiServiceDeleteUserMCPServerArgs) GetReq() (v *DeleteUserMCPServerRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceDailyScheduleArgs_Req_DEFAULT
+		return ApiServiceDeleteUserMCPServerArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceDailyScheduleArgs = map[int16]string{
+var fieldIDToName_ApiServiceDeleteUserMCPServerArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceDailyScheduleArgs) IsSetReq() bool {
+func (p *ApiServiceDeleteUserMCPServerArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceDailyScheduleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19316,7 +25129,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDailyScheduleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteUserMCPServerArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19326,8 +25139,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDailyScheduleRequest()
+func (p *ApiServiceDeleteUserMCPServerArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteUserMCPServerRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -19335,9 +25148,9 @@ func (p *ApiServiceDailyScheduleArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceDailyScheduleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DailySchedule_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteUserMCPServer_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19363,7 +25176,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19380,43 +25193,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleArgs) String() string {
+func (p *ApiServiceDeleteUserMCPServerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDailyScheduleArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteUserMCPServerArgs(%+v)", *p)
 
 }
 
-type ApiServiceDailyScheduleResult struct {
-	Success *DailyScheduleResponse `thrift:"success,0,optional"`
+type ApiServiceDeleteUserMCPServerResult struct {
+	Success *DeleteUserMCPServerResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceDailyScheduleResult() *ApiServiceDailyScheduleResult {
-	return &ApiServiceDailyScheduleResult{}
+func NewApiServiceDeleteUserMCPServerResult() *ApiServiceDeleteUserMCPServerResult {
+	return &ApiServiceDeleteUserMCPServerResult{}
 }
 
-func (p *ApiServiceDailyScheduleResult) InitDefault() {
+func (p *ApiServiceDeleteUserMCPServerResult) InitDefault() {
 }
 
-var ApiServiceDailyScheduleResult_Success_DEFAULT *DailyScheduleResponse
+var ApiServiceDeleteUserMCPServerResult_Success_DEFAULT *DeleteUserMCPServerResponse
 
-func (p *ApiServiceDailyScheduleResult) GetSuccess() (v *DailyScheduleResponse) {
+func (p *ApiServiceDeleteUserMCPServerResult) GetSuccess() (v *DeleteUserMCPServerResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceDailyScheduleResult_Success_DEFAULT
+		return ApiServiceDeleteUserMCPServerResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceDailyScheduleResult = map[int16]string{
+var fieldIDToName_ApiServiceDeleteUserMCPServerResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceDailyScheduleResult) IsSetSuccess() bool {
+func (p *ApiServiceDeleteUserMCPServerResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceDailyScheduleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19462,7 +25275,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDailyScheduleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteUserMCPServerResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19472,8 +25285,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDailyScheduleResponse()
+func (p *ApiServiceDeleteUserMCPServerResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteUserMCPServerResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -19481,9 +25294,9 @@ func (p *ApiServiceDailyScheduleResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceDailyScheduleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DailySchedule_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteUserMCPServer_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19509,7 +25322,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteUserMCPServerResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19528,10 +25341,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceDailyScheduleResult) String() string {
+func (p *ApiServiceDeleteUserMCPServerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDailyScheduleResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteUserMCPServerResult(%+v)", *p)
 
 }
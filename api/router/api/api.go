@@ -23,6 +23,7 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			_v1.POST("/chat", append(_chat0Mw(), api.Chat)...)
 			_chat := _v1.Group("/chat", _chatMw()...)
+			_chat.POST("/approve", append(_approvetoolcallMw(), api.ApproveToolCall)...)
 			_chat.POST("/sse", append(_chatsseMw(), api.ChatSSE)...)
 			_v1.POST("/template", append(_templateMw(), api.Template)...)
 			{
@@ -40,6 +41,15 @@ func Register(r *server.Hertz) {
 					_term.GET("/list", append(_gettermlistMw(), api.GetTermList)...)
 				}
 			}
+			{
+				_mcp := _v1.Group("/mcp", _mcpMw()...)
+				{
+					_server := _mcp.Group("/server", _serverMw()...)
+					_server.POST("/create", append(_createusermcpserverMw(), api.CreateUserMCPServer)...)
+					_server.DELETE("/delete", append(_deleteusermcpserverMw(), api.DeleteUserMCPServer)...)
+					_server.GET("/list", append(_listusermcpserverMw(), api.ListUserMCPServer)...)
+				}
+			}
 			{
 				_schedule := _v1.Group("/schedule", _scheduleMw()...)
 				_schedule.GET("/daily", append(_dailyscheduleMw(), api.DailySchedule)...)
@@ -70,6 +80,7 @@ func Register(r *server.Hertz) {
 				_user.GET("/info", append(_getuserinfoMw(), api.GetUserInfo)...)
 				_user.POST("/login", append(_getlogindataMw(), api.GetLoginData)...)
 				_user.PUT("/setting", append(_updateusersettingMw(), api.UpdateUserSetting)...)
+				_user.GET("/usage", append(_getuserusageMw(), api.GetUserUsage)...)
 			}
 		}
 	}
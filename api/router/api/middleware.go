@@ -217,3 +217,41 @@ func _dailyscheduleMw() []app.HandlerFunc {
 	}
 	return nil
 }
+
+func _mcpMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.GetHeaderParams(),
+	}
+}
+
+func _serverMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createusermcpserverMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteusermcpserverMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listusermcpserverMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _approvetoolcallMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getuserusageMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
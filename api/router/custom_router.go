@@ -2,9 +2,16 @@ package router
 
 import (
 	"github.com/FantasyRL/go-mcp-demo/api/handler"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
 func customizedRegister(r *server.Hertz) {
 	r.GET("/ping", handler.Ping)
+	// MCP Streamable HTTP 授权：host 兼任授权服务器
+	if config.MCP.Auth.Enable {
+		r.POST(constant.MCPAuthTokenPath, handler.OAuthToken)
+		r.GET(constant.MCPAuthServerMetadataPath, handler.OAuthServerMetadata)
+	}
 }
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os/signal"
+	"syscall"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_server"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+var (
+	configPath  = flag.String("cfg", "config/config.yaml", "config file path")
+	serviceName = flag.String("service", constant.ServiceNameMCPLocal, "service name, selects services.<name> and mcp.servers.<name>")
+)
+
+// serveConfig 取 mcp.servers.<服务名>，未启用任何传输层时按 mcp.transport 启用一种
+func serveConfig(name string) config.MCPServe {
+	serve := config.MCP.Servers[name]
+	if serve.Stdio || serve.HTTP.Enable || serve.SSE.Enable {
+		return serve
+	}
+	switch config.MCP.Transport {
+	case constant.MCPTransportStdio:
+		serve.Stdio = true
+	case constant.MCPTransportSSE:
+		serve.SSE.Enable = true
+	default:
+		serve.HTTP.Enable = true
+	}
+	return serve
+}
+
+func main() {
+	// 在 main 而非 init 中解析参数，go test 不会因 -test.* 参数而退出
+	flag.Parse()
+	config.Load(*configPath, *serviceName)
+	logger.Init(*serviceName, config.GetLoggerLevel())
+
+	serve := serveConfig(*serviceName)
+	deps, err := mcp.InjectDependencies(serve.Groups)
+	if err != nil {
+		logger.Fatalf("mcp_server: %v", err)
+	}
+	defer deps.ClientSet.Close()
+	sets, err := mcp.BuildSets(deps, serve.Groups)
+	if err != nil {
+		logger.Fatalf("mcp_server: %v", err)
+	}
+	logger.Infof("starting mcp server %s, groups = %v, tools = %d, stdio = %v, http = %v, sse = %v",
+		*serviceName, serve.Groups, len(sets.Tools.Tools), serve.Stdio, serve.HTTP.Enable, serve.SSE.Enable)
+	// 初始化MCP核心业务，各传输层共享同一个核心 Server
+	coreServer := mcp_server.NewCoreServer(config.MCP.ServerName, config.Server.Version, sets.Tools, sets.Prompts, sets.Resources)
+
+	// 收到 SIGINT/SIGTERM 或任一传输层退出（如 stdin 关闭）后，其余传输层也依次优雅关闭
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	var runners []func(ctx context.Context) error
+	if serve.Stdio {
+		runners = append(runners, func(ctx context.Context) error {
+			return mcp_server.ServeStdio(ctx, coreServer)
+		})
+	}
+	if serve.HTTP.Enable {
+		addr := serve.HTTP.Addr
+		if addr == "" {
+			if addr, err = utils.GetAvailablePort(); err != nil {
+				logger.Fatalf("mcp_server: get available port failed, err: %v", err)
+			}
+		}
+		logger.Infof("mcp_server: streamable http server listening at %s", addr)
+		srv := mcp_server.NewStreamableHTTPServer(coreServer, *serviceName, addr,
+			mcp_server.WithHealthChecker(deps.ClientSet.HealthCheck),
+		)
+		runners = append(runners, srv.Run)
+	}
+	if serve.SSE.Enable {
+		if serve.SSE.Addr == "" {
+			logger.Fatalf("mcp_server: mcp.servers.%s.sse.addr is required", *serviceName)
+		}
+		logger.Infof("mcp_server: sse server listening at %s", serve.SSE.Addr)
+		runners = append(runners, mcp_server.NewHTTPSSEServer(coreServer, serve.SSE.Addr).Run)
+	}
+	// host 写入用户数据后经 Redis 广播资源变更，转发给订阅了对应资源的会话
+	if cache := deps.ClientSet.Cache; cache != nil && sets.Resources != nil {
+		go mcp_server.ListenResourceUpdates(ctx, coreServer, cache)
+	}
+
+	errCh := make(chan error, len(runners))
+	for _, run := range runners {
+		go func() {
+			err := run(ctx)
+			stop()
+			errCh <- err
+		}()
+	}
+	var errs []error
+	for range runners {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		logger.Errorf("mcp_server: %v", err)
+	}
+}
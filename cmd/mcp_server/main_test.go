@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServeConfig(t *testing.T) {
+	load := func(data string) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		config.Load(path, constant.ServiceNameMCPLocal)
+	}
+	const servers = `
+  servers:
+    mcp_local:
+      groups: ["time", "dev"]
+      stdio: true
+      sse:
+        enable: true
+`
+
+	Convey("serveConfig", t, func() {
+		cases := []struct {
+			name             string
+			data             string
+			service          string
+			stdio, http, sse bool
+			groups           []string
+		}{
+			{"configured transports are kept", "mcp:\n  transport: \"http\"" + servers, "mcp_local", true, false, true, []string{"time", "dev"}},
+			{"unknown services fall back to mcp.transport stdio", "mcp:\n  transport: \"stdio\"" + servers, "other", true, false, false, nil},
+			{"falls back to sse", "mcp:\n  transport: \"sse\"\n", "mcp_local", false, false, true, nil},
+			{"falls back to http by default", "mcp:\n  transport: \"\"\n", "mcp_local", false, true, false, nil},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				load(c.data)
+				serve := serveConfig(c.service)
+				So(serve.Stdio, ShouldEqual, c.stdio)
+				So(serve.HTTP.Enable, ShouldEqual, c.http)
+				So(serve.SSE.Enable, ShouldEqual, c.sse)
+				So(serve.Groups, ShouldResemble, c.groups)
+			})
+		}
+	})
+}
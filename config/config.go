@@ -39,6 +39,10 @@ func Load(path string, srv string) {
 	PgSQL = &cfg.PgSQL
 	Redis = &cfg.Redis
 	Service = getService(srv)
+
+	if MCP.Auth.Enable && (MCP.Auth.SigningKey == "" || MCP.Auth.SigningKey == Server.Secret) {
+		log.Fatal("mcp.auth.signing_key is required when mcp.auth.enable is set and must differ from server.private-key")
+	}
 }
 
 // GetLoggerLevel 会返回服务的日志等级
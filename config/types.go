@@ -5,10 +5,11 @@ import (
 )
 
 type server struct {
-	Secret   string `mapstructure:"private-key"`
-	Version  string
-	Name     string
-	LogLevel string `mapstructure:"log-level"`
+	Secret        string `mapstructure:"private-key"`
+	EncryptionKey string `mapstructure:"encryption-key"` // 加密落库的敏感数据（如用户 MCP 凭据），与签发令牌的 private-key 分开
+	Version       string
+	Name          string
+	LogLevel      string `mapstructure:"log-level"`
 }
 
 type OllamaOptions struct {
@@ -27,6 +28,59 @@ type AiProviderConfig struct {
 	Model   string                 `mapstructure:"model"`    // e.g. qwen3:1.7b
 	Remote  AiProviderRemoteConfig `mapstructure:"remote"`
 	Options OllamaOptions          `mapstructure:"options"`
+	// 对话请求可覆盖的生成参数的服务端限制
+	ChatOptions AiChatOptionsLimit `mapstructure:"chat_options"`
+	// 多个模型服务，按请求的模型名路由；为空时按 mode 从上面的单一配置构建
+	Providers []AiProviderEntry `mapstructure:"providers"`
+	// 单个服务内的重试与熔断，每个服务各自计数
+	Retry          AiRetryConfig          `mapstructure:"retry"`
+	CircuitBreaker AiCircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	// 每日 token 配额，用量按用户/对话/模型记录在 token_usages
+	Quota AiQuotaConfig `mapstructure:"quota"`
+}
+
+// AiRetryConfig 429/5xx/超时/连接失败时的重试，流式请求只在收到第一个分片前重试
+type AiRetryConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts"` // 每个服务最多尝试次数（含首次），默认 3，1 表示不重试
+	BaseDelay   time.Duration `mapstructure:"base_delay"`   // 指数退避的初始等待，默认 500ms，实际等待带随机抖动
+	MaxDelay    time.Duration `mapstructure:"max_delay"`    // 单次等待上限，默认 8s；Retry-After 超过该值时不再重试，直接降级
+}
+
+// AiCircuitBreakerConfig 服务连续失败后熔断，熔断期间请求直接降级到备用服务
+type AiCircuitBreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"` // 连续失败次数阈值，默认 5，负数关闭熔断
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`      // 熔断持续时间，到期后放行一次探测请求，默认 30s
+}
+
+// AiQuotaConfig 每个用户每日可消耗的 token 数，按服务器本地时区的自然日统计
+type AiQuotaConfig struct {
+	DailyTokens int64         `mapstructure:"daily_tokens"` // 未匹配 roles 的用户的配额，0 表示不限制
+	Roles       []AiRoleQuota `mapstructure:"roles"`        // 按 users.role 覆盖配额
+}
+
+type AiRoleQuota struct {
+	Role        string `mapstructure:"role"`
+	DailyTokens int64  `mapstructure:"daily_tokens"` // 0 表示不限制
+}
+
+// AiProviderEntry 一个 OpenAI 兼容服务或 Ollama 实例
+type AiProviderEntry struct {
+	Name      string        `mapstructure:"name"`      // 唯一名称，供 fallbacks 引用
+	Type      string        `mapstructure:"type"`      // "openai" | "ollama"
+	BaseURL   string        `mapstructure:"base_url"`  // openai 填完整 API 地址（含 /v1），ollama 填服务根地址
+	APIKey    string        `mapstructure:"api_key"`   // ollama 可留空
+	Models    []string      `mapstructure:"models"`    // 该服务提供的模型，支持 path.Match 风格的 glob，第一个为降级时的默认模型
+	Timeout   time.Duration `mapstructure:"timeout"`   // 等待响应头（首字节）的超时，不限制流式读取的时长，默认 60s
+	Fallbacks []string      `mapstructure:"fallbacks"` // 5xx/超时/限流时按顺序降级到的服务名
+}
+
+// AiChatOptionsLimit 对话请求中 model/max_tokens/system_prompt 的允许范围，
+// temperature/top_p/top_k 的范围见 constant.Chat*
+type AiChatOptionsLimit struct {
+	Models               []string `mapstructure:"models"`                  // 请求可指定的模型，ai_provider.model 始终允许
+	MaxTokens            int      `mapstructure:"max_tokens"`              // max_tokens 上限，默认 constant.ChatMaxTokensLimit
+	SystemPromptMaxChars int      `mapstructure:"system_prompt_max_chars"` // 系统提示词最大字符数，默认 constant.ChatSystemPromptMaxChars
+	VisionModels         []string `mapstructure:"vision_models"`           // 支持图片输入的模型，支持 path.Match 风格的 glob；工具返回的图片只附给这些模型
 }
 type AiProviderRemoteConfig struct {
 	Provider string `mapstructure:"provider"`
@@ -52,11 +106,139 @@ type mcpHTTP struct {
 	BaseURL string `mapstructure:"base_url"` // 直连时使用，如 "http://127.0.0.1:8080/mcp"
 }
 
+// mcpUser 用户自定义 MCP 服务的连接限制
+type mcpUser struct {
+	MaxServersPerUser int           `mapstructure:"max_servers_per_user"` // 单用户可注册/连接的 MCP 服务数，默认 5
+	MaxConnections    int           `mapstructure:"max_connections"`      // host 持有的用户 MCP 连接总数上限，默认 200
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`         // 用户连接空闲多久后被回收，默认 10m
+	AllowPrivate      bool          `mapstructure:"allow_private"`        // 是否允许连接内网/回环地址，默认禁止
+}
+
+// mcpAuthClient 允许在 host 的 /oauth/token 上以 client_credentials 换取令牌的客户端
+type mcpAuthClient struct {
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"` // 该客户端可申请的 scope
+}
+
+// mcpAuth Streamable HTTP 传输的 OAuth 2.1 授权配置，server 端校验令牌，host 端签发与获取令牌
+type mcpAuth struct {
+	Enable     bool              `mapstructure:"enable"`
+	SigningKey string            `mapstructure:"signing_key"` // HS256 签名密钥，启用时必填且不能与 server.private-key 相同
+	Issuer     string            `mapstructure:"issuer"`      // 授权服务器地址，例如 "http://127.0.0.1:10001"
+	Audience   string            `mapstructure:"audience"`    // 令牌受众，默认 "go-mcp-demo"
+	TokenTTL   time.Duration     `mapstructure:"token_ttl"`   // 默认 1h
+	Clients    []mcpAuthClient   `mapstructure:"clients"`     // 授权服务器登记的客户端
+	ToolScopes map[string]string `mapstructure:"tool_scopes"` // 工具名 -> 调用所需 scope，未列出的工具只需 mcp:tools
+
+	// host 作为 MCP 客户端使用的凭据
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	TokenURL     string   `mapstructure:"token_url"` // 留空时为 Issuer + /oauth/token
+	Scopes       []string `mapstructure:"scopes"`    // 留空时申请该客户端允许的全部 scope
+}
+
+// mcpSandbox code_run 的沙箱限制，时长为 0 或未配置的数值使用 constant.Sandbox* 默认值
+type mcpSandbox struct {
+	Isolation       string        `mapstructure:"isolation"`        // "namespace"(默认，Linux user/pid/net 命名空间) | "none"
+	Network         bool          `mapstructure:"network"`          // 是否允许联网，默认不允许（仅 namespace 隔离下生效）
+	AllowedCommands []string      `mapstructure:"allowed_commands"` // 允许执行的命令名，如 ["python3", "go"]
+	Timeout         time.Duration `mapstructure:"timeout"`          // 单次运行的墙钟超时上限，默认 120s
+	CPUTime         time.Duration `mapstructure:"cpu_time"`         // CPU 时间上限，默认与 timeout 相同
+	MemoryMB        int           `mapstructure:"memory_mb"`        // 虚拟内存上限，默认 1024
+	MaxPids         int           `mapstructure:"max_pids"`         // 进程数上限，默认 64，仅配置 cgroup 时生效
+	MaxFileSizeMB   int           `mapstructure:"max_file_size_mb"` // 单文件写入大小上限，默认 64
+	MaxOutputBytes  int           `mapstructure:"max_output_bytes"` // stdout/stderr 各自保留的最大字节数，默认 64KiB
+	ReadOnlyPaths   []string      `mapstructure:"readonly_paths"`   // namespace 隔离下额外只读放行的路径（如自定义工具链目录），默认只放行系统目录
+	Cgroup          string        `mapstructure:"cgroup"`           // 委派给服务用户的 cgroup v2 目录，每次运行在其下建子组并设置 pids.max；为空时不限制进程数
+}
+
+// mcpDevRunner 本地开发工具（fs_tree/fs_cat/code_run）的配置
+type mcpDevRunner struct {
+	Roots        []string   `mapstructure:"roots"`         // 允许访问的工作区根目录，code_run 未指定 root 时使用临时工作区
+	DenyPatterns []string   `mapstructure:"deny_patterns"` // 额外的敏感文件 glob，与默认规则（.env、*.pem、config*.yaml 等）合并
+	Sandbox      mcpSandbox `mapstructure:"sandbox"`
+}
+
+// mcpWebFetch web_fetch 的抓取限制
+type mcpWebFetch struct {
+	Timeout        time.Duration `mapstructure:"timeout"`         // 单次抓取超时，默认 15s
+	MaxBytes       int64         `mapstructure:"max_bytes"`       // 最多读取的响应体字节数，默认 2MiB
+	MaxChars       int           `mapstructure:"max_chars"`       // 返回正文的最大字符数，默认 20000
+	AllowedDomains []string      `mapstructure:"allowed_domains"` // 非空时只允许这些域名及其子域名
+	BlockedDomains []string      `mapstructure:"blocked_domains"` // 禁止访问的域名及其子域名
+	AllowPrivate   bool          `mapstructure:"allow_private"`   // 是否允许访问内网/回环地址，默认禁止
+	CacheTTL       time.Duration `mapstructure:"cache_ttl"`       // Redis 缓存时间，默认 1h，负数表示不缓存
+}
+
+// mcpToolPolicy 工具调用策略，零值字段表示沿用上一级配置
+type mcpToolPolicy struct {
+	Timeout   time.Duration `mapstructure:"timeout"`    // 单次调用超时，0 表示不限制
+	RateLimit float64       `mapstructure:"rate_limit"` // 每个用户每秒补充的令牌数，0 表示不限流
+	Burst     int           `mapstructure:"burst"`      // 令牌桶容量，默认为 rate_limit 向上取整
+	Audit     *bool         `mapstructure:"audit"`      // 是否记录参数与结果大小的审计日志
+}
+
+// mcpToolRule 按工具名（支持 path.Match 通配，如 "math_*"）覆盖默认策略，后出现的规则优先
+type mcpToolRule struct {
+	Name          string `mapstructure:"name"`
+	mcpToolPolicy `mapstructure:",squash"`
+}
+
+// mcpTools 工具中间件配置（超时、限流、审计），指标与 panic 恢复对所有工具生效
+type mcpTools struct {
+	Default mcpToolPolicy `mapstructure:"default"`
+	Rules   []mcpToolRule `mapstructure:"rules"`
+}
+
+// mcpWebSearch web_search / web_fetch 工具配置
+type mcpWebSearch struct {
+	Provider   string        `mapstructure:"provider"`    // "duckduckgo" | "searxng" | "bing"，默认 duckduckgo
+	Endpoint   string        `mapstructure:"endpoint"`    // 搜索接口地址，留空使用 provider 默认地址（searxng 必填）
+	APIKey     string        `mapstructure:"api_key"`     // bing 的订阅密钥
+	MaxResults int           `mapstructure:"max_results"` // 默认返回条数，默认 5
+	Timeout    time.Duration `mapstructure:"timeout"`     // 搜索请求超时，默认 10s
+	Fetch      mcpWebFetch   `mapstructure:"fetch"`
+}
+
 type mcpConfig struct {
-	ServerName string   `mapstructure:"server_name"`
-	Transport  string   `mapstructure:"transport"` // "stdio" | "sse" | "http"
-	Stdio      mcpStdio `mapstructure:"stdio"`
-	HTTP       mcpHTTP  `mapstructure:"http"`
+	ServerName string       `mapstructure:"server_name"`
+	Transport  string       `mapstructure:"transport"` // "stdio" | "sse" | "http"
+	Stdio      mcpStdio     `mapstructure:"stdio"`
+	HTTP       mcpHTTP      `mapstructure:"http"`
+	User       mcpUser      `mapstructure:"user"`
+	Auth       mcpAuth      `mapstructure:"auth"`
+	DevRunner  mcpDevRunner `mapstructure:"dev_runner"`
+	WebSearch  mcpWebSearch `mapstructure:"web_search"`
+	Tools      mcpTools     `mapstructure:"tools"`
+	Approval   mcpApproval  `mapstructure:"approval"`
+	// 服务名 -> cmd/mcp_server 以该服务名启动时启用的工具组与传输层
+	Servers map[string]MCPServe `mapstructure:"servers"`
+}
+
+// mcpApproval host 端高风险工具调用的人工确认
+type mcpApproval struct {
+	Timeout time.Duration     `mapstructure:"timeout"` // 等待确认的最长时间，默认 5m，超时视为拒绝
+	Rules   []mcpToolRiskRule `mapstructure:"rules"`   // 后出现的规则优先，未匹配的工具为 low
+}
+
+type mcpToolRiskRule struct {
+	Name string `mapstructure:"name"` // 工具名，支持 path.Match 风格的 glob
+	Risk string `mapstructure:"risk"` // "low" | "medium" | "high"
+}
+
+// MCPServe 单个 MCP 服务进程的工具组与传输层，stdio/http/sse 可同时启用；
+// 均未启用时按 mcp.transport 只启用一种
+type MCPServe struct {
+	Groups []string  `mapstructure:"groups"` // 启用的工具组，见 constant.MCPToolGroup*，为空时启用除 dev 外的全部
+	Stdio  bool      `mapstructure:"stdio"`
+	HTTP   MCPListen `mapstructure:"http"` // Streamable HTTP，注册到注册中心
+	SSE    MCPListen `mapstructure:"sse"`  // 已废弃的 HTTP+SSE，仅为兼容旧客户端，不注册
+}
+
+type MCPListen struct {
+	Enable bool   `mapstructure:"enable"`
+	Addr   string `mapstructure:"addr"` // 留空时从 services.<服务名>.addr 中选取可用地址
 }
 
 type consulConfig struct {
@@ -69,14 +251,25 @@ type consulConfig struct {
 	Path       string `mapstructure:"path"`       // 例如 "/mcp"
 }
 
+type etcdConfig struct {
+	Endpoints   []string      `mapstructure:"endpoints"`    // 例如 ["127.0.0.1:2379"]
+	Username    string        `mapstructure:"username"`     // 可空
+	Password    string        `mapstructure:"password"`     // 可空
+	DialTimeout time.Duration `mapstructure:"dial_timeout"` // 默认 5s
+	Prefix      string        `mapstructure:"prefix"`       // 默认 "/go-mcp-demo/services"
+	LeaseTTL    time.Duration `mapstructure:"lease_ttl"`    // 默认 15s，租约过期后实例自动注销
+}
+
 // registryConfig 是“注册中心/地址解析”的顶层入口
-// Provider: "consul" | "static" | "none"（或留空）
+// Provider: "consul" | "etcd" | "static" | "none"（或留空）
 // - 当 Provider=consul 时使用 Consul 子配置
+// - 当 Provider=etcd 时使用 Etcd 子配置
 // - 当 Provider=static 时使用 services.* 的静态地址（见 services 配置）
 // - 当 BaseURL 非空时，优先使用 BaseURL（由 MCP 自身指定）
 type registryConfig struct {
-	Provider        string        `mapstructure:"provider"` // "consul" | "none"
+	Provider        string        `mapstructure:"provider"` // "consul" | "etcd" | "none"
 	Consul          consulConfig  `mapstructure:"consul"`
+	Etcd            etcdConfig    `mapstructure:"etcd"`
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 	ResolveTimeout  time.Duration `mapstructure:"resolve_timeout"`
 }
@@ -16,8 +16,12 @@ fi
 
 echo "==> Building service: ${RUN_NAME}"
 
-# 进入对应服务模块
-cd "./cmd/${RUN_NAME}"
+# 进入对应服务模块，所有 MCP 服务共用 cmd/mcp_server
+CMD_DIR="${RUN_NAME}"
+case "${RUN_NAME}" in
+  mcp_*) CMD_DIR="mcp_server" ;;
+esac
+cd "./cmd/${CMD_DIR}"
 
 # 产物目录：/app/output/{SERVICE}
 mkdir -p "${ROOT_DIR}/output/${RUN_NAME}"
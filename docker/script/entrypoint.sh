@@ -42,5 +42,10 @@ fi
 #   docker run ... -e ARGS="--debug --some-flag=1"
 : "${ARGS:=}"
 
+# MCP 服务共用同一个二进制，以 -service 选择 mcp.servers.<服务名>
+case "$SERVICE" in
+  mcp_*) ARGS="-service $SERVICE $ARGS" ;;
+esac
+
 echo "==> Starting $SERVICE"
 exec "$BIN" -cfg "$CFG_FILE" $ARGS
@@ -2,6 +2,7 @@ create table users(
     id VARCHAR(32) NOT NULL PRIMARY KEY,
     name VARCHAR(32) NOT NULL,
     setting_json JSONB,
+    role VARCHAR(16) NOT NULL DEFAULT 'user',
     created_at TIMESTAMP NOT NULL DEFAULT current_timestamp,
     updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
     deleted_at TIMESTAMP NULL
@@ -11,6 +12,7 @@ comment on table users is '用户表';
 comment on column users.id is '用户ID,使用oauthID';
 comment on column users.name is '用户名称';
 comment on column users.setting_json is '用户设置，JSON格式存储';
+comment on column users.role is '用户角色，决定每日 token 配额';
 comment on column users.created_at is '创建时间';
 comment on column users.updated_at is '更新时间';
 comment on column users.deleted_at is '删除时间';
@@ -21,6 +23,7 @@ create table conversations (
     messages     jsonb       NOT NULL,
     is_summarized smallint   NOT NULL DEFAULT 0,
     title        varchar(128),
+    chat_options jsonb,
     created_at   TIMESTAMP NOT NULL DEFAULT now(),
     updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
     deleted_at   TIMESTAMP
@@ -39,6 +42,7 @@ comment on column conversations.user_id is '用户ID';
 comment on column conversations.messages is '对话消息，JSON格式存储';
 comment on column conversations.is_summarized is '是否已生成摘要，0-否，1-是';
 comment on column conversations.title is '对话标题';
+comment on column conversations.chat_options is '对话生成参数（模型、温度等），JSON格式存储';
 comment on column conversations.created_at is '创建时间';
 comment on column conversations.updated_at is '更新时间';
 comment on column conversations.deleted_at is '删除时间';
@@ -102,3 +106,60 @@ comment on column todolists.category is '待办事项标签';
 comment on column todolists.created_at is '创建时间';
 comment on column todolists.updated_at is '更新时间';
 comment on column todolists.deleted_at is '删除时间';
+
+create table user_mcp_servers(
+    id           uuid        PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      varchar(32) NOT NULL,
+    name         varchar(32) NOT NULL,
+    url          text        NOT NULL,
+    credentials  text        NOT NULL,
+    enabled      smallint    NOT NULL DEFAULT 1,
+    created_at   TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    deleted_at   TIMESTAMP
+);
+
+create unique index idx_user_mcp_servers_user_id_name
+    on user_mcp_servers (user_id, name)
+    WHERE deleted_at IS NULL;
+
+comment on table user_mcp_servers is '用户自定义MCP服务表';
+comment on column user_mcp_servers.id is 'MCP服务ID';
+comment on column user_mcp_servers.user_id is '用户ID';
+comment on column user_mcp_servers.name is 'MCP服务名称，同一用户下唯一';
+comment on column user_mcp_servers.url is 'MCP服务地址（Streamable HTTP）';
+comment on column user_mcp_servers.credentials is '加密后的凭据（bearer token 与自定义请求头）';
+comment on column user_mcp_servers.enabled is '是否启用，0-否，1-是';
+comment on column user_mcp_servers.created_at is '创建时间';
+comment on column user_mcp_servers.updated_at is '更新时间';
+comment on column user_mcp_servers.deleted_at is '删除时间';
+
+create table token_usages(
+    id                uuid         PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id           varchar(32)  NOT NULL,
+    conversation_id   uuid,
+    provider          varchar(32)  NOT NULL,
+    model             varchar(128) NOT NULL,
+    prompt_tokens     integer      NOT NULL DEFAULT 0,
+    completion_tokens integer      NOT NULL DEFAULT 0,
+    total_tokens      integer      NOT NULL DEFAULT 0,
+    created_at        TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMP(6) WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at        TIMESTAMP(6) WITH TIME ZONE
+);
+
+create index idx_token_usages_user_id_created_at
+    on token_usages (user_id, created_at);
+
+comment on table token_usages is '模型 token 用量表，每次模型调用一条记录';
+comment on column token_usages.id is '用量记录ID';
+comment on column token_usages.user_id is '用户ID';
+comment on column token_usages.conversation_id is '对话ID，非对话场景（如每日日程）为空';
+comment on column token_usages.provider is '模型提供方名称';
+comment on column token_usages.model is '模型名称';
+comment on column token_usages.prompt_tokens is '输入 token 数';
+comment on column token_usages.completion_tokens is '输出 token 数';
+comment on column token_usages.total_tokens is '总 token 数';
+comment on column token_usages.created_at is '创建时间';
+comment on column token_usages.updated_at is '更新时间';
+comment on column token_usages.deleted_at is '删除时间';
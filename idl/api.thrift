@@ -19,6 +19,54 @@ struct ChatRequest{
         description:"前端生成的UUID，多轮会话唯一标识",
         type:"string"
     }')
+    4: optional list<string> resources(api.body="resources", openapi.property='{
+        title:"附带资源",
+        description:"本轮附带的 MCP 资源 URI，如 todo://{user_id}/{id}、course://{user_id}/{term}、summary://{conversation_id}，最多 5 个",
+        type:"array",
+        items:{type:"string"}
+    }')
+    5: optional list<string> enable_tools(api.body="enable_tools", openapi.property='{
+        title:"本轮启用的工具",
+        description:"覆盖用户设置中 tools.disabled 的工具名（支持 * 通配），仅对本轮生效",
+        type:"array",
+        items:{type:"string"}
+    }')
+    6: optional list<string> disable_tools(api.body="disable_tools", openapi.property='{
+        title:"本轮禁用的工具",
+        description:"本轮额外禁用的工具名（支持 * 通配），优先于 enable_tools",
+        type:"array",
+        items:{type:"string"}
+    }')
+    7: optional string model(api.body="model", openapi.property='{
+        title:"模型",
+        description:"本轮及后续轮次使用的模型，须在服务端允许列表内，缺省沿用对话已保存的设置",
+        type:"string"
+    }')
+    8: optional double temperature(api.body="temperature", openapi.property='{
+        title:"温度",
+        description:"采样温度，0 ~ 2",
+        type:"number"
+    }')
+    9: optional double top_p(api.body="top_p", openapi.property='{
+        title:"Top P",
+        description:"核采样概率，(0, 1]",
+        type:"number"
+    }')
+    10: optional i64 top_k(api.body="top_k", openapi.property='{
+        title:"Top K",
+        description:"候选词数量，1 ~ 100，仅部分模型支持",
+        type:"integer"
+    }')
+    11: optional i64 max_tokens(api.body="max_tokens", openapi.property='{
+        title:"最大生成长度",
+        description:"单次回复最多生成的 token 数，不超过服务端上限",
+        type:"integer"
+    }')
+    12: optional string system_prompt(api.body="system_prompt", openapi.property='{
+        title:"系统提示词",
+        description:"替换默认系统提示词，传空字符串恢复默认",
+        type:"string"
+    }')
 }(
     openapi.schema='{
         title: "聊天请求",
@@ -62,6 +110,54 @@ struct ChatSSEHandlerRequest{
         description:"前端生成的UUID，多轮会话标识",
         type:"string"
     }')
+    4: optional list<string> resources(api.query="resources", openapi.property='{
+        title:"附带资源",
+        description:"本轮附带的 MCP 资源 URI，可重复传参，最多 5 个",
+        type:"array",
+        items:{type:"string"}
+    }')
+    5: optional list<string> enable_tools(api.query="enable_tools", openapi.property='{
+        title:"本轮启用的工具",
+        description:"覆盖用户设置中 tools.disabled 的工具名（支持 * 通配），仅对本轮生效",
+        type:"array",
+        items:{type:"string"}
+    }')
+    6: optional list<string> disable_tools(api.query="disable_tools", openapi.property='{
+        title:"本轮禁用的工具",
+        description:"本轮额外禁用的工具名（支持 * 通配），优先于 enable_tools",
+        type:"array",
+        items:{type:"string"}
+    }')
+    7: optional string model(api.query="model", openapi.property='{
+        title:"模型",
+        description:"本轮及后续轮次使用的模型，须在服务端允许列表内，缺省沿用对话已保存的设置",
+        type:"string"
+    }')
+    8: optional double temperature(api.query="temperature", openapi.property='{
+        title:"温度",
+        description:"采样温度，0 ~ 2",
+        type:"number"
+    }')
+    9: optional double top_p(api.query="top_p", openapi.property='{
+        title:"Top P",
+        description:"核采样概率，(0, 1]",
+        type:"number"
+    }')
+    10: optional i64 top_k(api.query="top_k", openapi.property='{
+        title:"Top K",
+        description:"候选词数量，1 ~ 100，仅部分模型支持",
+        type:"integer"
+    }')
+    11: optional i64 max_tokens(api.query="max_tokens", openapi.property='{
+        title:"最大生成长度",
+        description:"单次回复最多生成的 token 数，不超过服务端上限",
+        type:"integer"
+    }')
+    12: optional string system_prompt(api.query="system_prompt", openapi.property='{
+        title:"系统提示词",
+        description:"替换默认系统提示词，传空字符串恢复默认",
+        type:"string"
+    }')
 }(
      openapi.schema='{
          title: "流式聊天请求",
@@ -844,7 +940,7 @@ struct CourseTermListResponse{
 struct UpdateUserSettingRequest {
     1: string setting_json(api.body="setting_json", openapi.property='{
         title: "用户设置JSON",
-        description: "用户设置JSON字符串",
+        description: "用户设置JSON字符串；tools 段为工具偏好：web_search(bool) 是否启用联网搜索，disabled 禁用的工具名（支持 * 通配），disabled_servers 禁用的自定义 MCP 服务名",
         type: "string"
     }')
 }(
@@ -966,11 +1062,290 @@ struct DailyScheduleResponse {
     }'
 )
 
+// ==================== 用户自定义 MCP 服务 ====================
+
+struct CreateUserMCPServerRequest {
+    1: required string name(api.body="name", openapi.property='{
+        title: "服务名称",
+        description: "同一用户下唯一，仅允许字母、数字、下划线和中划线，工具重名时作为前缀",
+        type: "string"
+    }')
+    2: required string url(api.body="url", openapi.property='{
+        title: "服务地址",
+        description: "MCP Streamable HTTP 地址，例如 https://example.com/mcp",
+        type: "string"
+    }')
+    3: optional string token(api.body="token", openapi.property='{
+        title: "Bearer Token",
+        description: "访问该服务使用的 bearer token，加密存储",
+        type: "string"
+    }')
+    4: optional map<string, string> headers(api.body="headers", openapi.property='{
+        title: "自定义请求头",
+        description: "访问该服务时附带的请求头，加密存储",
+        type: "object"
+    }')
+}(
+    openapi.schema='{
+        title: "添加用户MCP服务请求",
+        description: "注册一个用户私有的 MCP 服务",
+        required: ["name", "url"]
+    }'
+)
+
+struct CreateUserMCPServerResponse {
+    1: string id(api.body="id", openapi.property='{
+        title: "服务ID",
+        description: "创建成功的用户MCP服务ID",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "添加用户MCP服务响应",
+        description: "返回创建成功的服务ID",
+        required: ["id"]
+    }'
+)
+
+struct UserMCPServerItem {
+    1: string id(api.body="id", openapi.property='{
+        title: "服务ID",
+        type: "string"
+    }')
+    2: string name(api.body="name", openapi.property='{
+        title: "服务名称",
+        type: "string"
+    }')
+    3: string url(api.body="url", openapi.property='{
+        title: "服务地址",
+        type: "string"
+    }')
+    4: bool has_token(api.body="has_token", openapi.property='{
+        title: "是否配置了Token",
+        description: "凭据不会回传，仅标识是否配置",
+        type: "boolean"
+    }')
+    5: list<string> header_keys(api.body="header_keys", openapi.property='{
+        title: "自定义请求头名称",
+        description: "仅返回请求头名称，不返回值",
+        type: "array"
+    }')
+    6: bool enabled(api.body="enabled", openapi.property='{
+        title: "是否启用",
+        type: "boolean"
+    }')
+    7: i64 created_at(api.body="created_at", openapi.property='{
+        title: "创建时间",
+        description: "unix毫秒时间戳",
+        type: "integer",
+        format: "int64"
+    }')
+}(
+    openapi.schema='{
+        title: "用户MCP服务",
+        description: "用户MCP服务信息（不含凭据）",
+        required: ["id", "name", "url", "has_token", "header_keys", "enabled", "created_at"]
+    }'
+)
+
+struct ListUserMCPServerRequest {
+}(
+    openapi.schema='{
+        title: "用户MCP服务列表请求",
+        description: "获取当前用户的全部MCP服务"
+    }'
+)
+
+struct ListUserMCPServerResponse {
+    1: list<UserMCPServerItem> servers(api.body="servers", openapi.property='{
+        title: "用户MCP服务列表",
+        type: "array"
+    }')
+}(
+    openapi.schema='{
+        title: "用户MCP服务列表响应",
+        description: "返回当前用户的MCP服务列表",
+        required: ["servers"]
+    }'
+)
+
+struct DeleteUserMCPServerRequest {
+    1: string id(api.query="id", openapi.property='{
+        title: "服务ID",
+        description: "要删除的用户MCP服务ID",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "删除用户MCP服务请求",
+        description: "根据ID删除用户MCP服务",
+        required: ["id"]
+    }'
+)
+
+struct ApproveToolCallRequest {
+    1: required string approval_id(api.body="approval_id", openapi.property='{
+        title: "审批ID",
+        description: "tool_approval_required 事件中的 approval_id",
+        type: "string"
+    }')
+    2: required string decision(api.body="decision", openapi.property='{
+        title: "审批结果",
+        description: "approve 执行、deny 拒绝、edit 使用修改后的参数执行",
+        type: "string",
+        enum: ["approve", "deny", "edit"]
+    }')
+    3: optional string args(api.body="args", openapi.property='{
+        title: "修改后的参数",
+        description: "decision 为 edit 时必填，工具参数的 JSON 对象字符串",
+        type: "string"
+    }')
+    4: optional string reason(api.body="reason", openapi.property='{
+        title: "拒绝原因",
+        description: "decision 为 deny 时可选，会告知模型",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "工具调用审批请求",
+        description: "对等待确认的高风险工具调用做出决定，流式对话随即继续",
+        required: ["approval_id", "decision"]
+    }'
+)
+
+struct ApproveToolCallResponse {
+    1: string approval_id(api.body="approval_id", openapi.property='{
+        title: "审批ID",
+        type: "string"
+    }')
+    2: string status(api.body="status", openapi.property='{
+        title: "审批状态",
+        description: "approved、denied 或 edited",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "工具调用审批响应",
+        description: "返回审批后的状态",
+        required: ["approval_id", "status"]
+    }'
+)
+
+struct DeleteUserMCPServerResponse {
+    1: string id(api.body="id", openapi.property='{
+        title: "服务ID",
+        description: "已删除的用户MCP服务ID",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "删除用户MCP服务响应",
+        description: "返回已删除的服务ID",
+        required: ["id"]
+    }'
+)
+
+struct GetUserUsageRequest {
+    1: optional i64 days(api.query="days", openapi.property='{
+        title: "统计天数",
+        description: "统计最近多少天（含今天）的用量，默认 7，最大 90",
+        type: "integer",
+        format: "int64"
+    }')
+}(
+    openapi.schema='{
+        title: "用户用量请求",
+        description: "获取当前用户的 token 用量与每日配额"
+    }'
+)
+
+struct UsageItem {
+    1: string key(api.body="key", openapi.property='{
+        title: "聚合维度",
+        description: "按日聚合时为日期（YYYY-MM-DD），按模型聚合时为模型名，按对话聚合时为对话ID",
+        type: "string"
+    }')
+    2: i64 prompt_tokens(api.body="prompt_tokens", openapi.property='{
+        title: "输入token数",
+        type: "integer",
+        format: "int64"
+    }')
+    3: i64 completion_tokens(api.body="completion_tokens", openapi.property='{
+        title: "输出token数",
+        type: "integer",
+        format: "int64"
+    }')
+    4: i64 total_tokens(api.body="total_tokens", openapi.property='{
+        title: "总token数",
+        type: "integer",
+        format: "int64"
+    }')
+}(
+    openapi.schema='{
+        title: "用量聚合项",
+        description: "某一日期、模型或对话的 token 用量合计",
+        required: ["key", "prompt_tokens", "completion_tokens", "total_tokens"]
+    }'
+)
+
+struct GetUserUsageResponse {
+    1: string role(api.body="role", openapi.property='{
+        title: "用户角色",
+        description: "决定每日 token 配额",
+        type: "string"
+    }')
+    2: i64 daily_quota(api.body="daily_quota", openapi.property='{
+        title: "每日配额",
+        description: "每日可消耗的 token 数，0 表示不限制",
+        type: "integer",
+        format: "int64"
+    }')
+    3: i64 today_tokens(api.body="today_tokens", openapi.property='{
+        title: "今日用量",
+        type: "integer",
+        format: "int64"
+    }')
+    4: i64 remaining_tokens(api.body="remaining_tokens", openapi.property='{
+        title: "今日剩余",
+        description: "今日剩余可用 token 数，不限制时为 -1",
+        type: "integer",
+        format: "int64"
+    }')
+    5: i64 total_tokens(api.body="total_tokens", openapi.property='{
+        title: "统计期间总用量",
+        type: "integer",
+        format: "int64"
+    }')
+    6: list<UsageItem> daily(api.body="daily", openapi.property='{
+        title: "按日用量",
+        description: "按日期升序，无用量的日期不返回",
+        type: "array"
+    }')
+    7: list<UsageItem> models(api.body="models", openapi.property='{
+        title: "按模型用量",
+        description: "按总用量降序",
+        type: "array"
+    }')
+    8: list<UsageItem> conversations(api.body="conversations", openapi.property='{
+        title: "按对话用量",
+        description: "按总用量降序，最多 20 个，不含每日日程等非对话场景",
+        type: "array"
+    }')
+}(
+    openapi.schema='{
+        title: "用户用量响应",
+        description: "返回今日用量、配额以及统计期间按日、模型、对话的聚合",
+        required: ["role", "daily_quota", "today_tokens", "remaining_tokens", "total_tokens", "daily", "models", "conversations"]
+    }'
+)
+
 service ApiService {
     // 非流式对话
     ChatResponse Chat(1: ChatRequest req)(api.post="/api/v1/chat")
     // 流式对话
     ChatSSEHandlerResponse ChatSSE(1: ChatSSEHandlerRequest req)(api.post="/api/v1/chat/sse")
+    // 审批流式对话中等待确认的高风险工具调用
+    ApproveToolCallResponse ApproveToolCall(1: ApproveToolCallRequest req)(api.post="/api/v1/chat/approve")
     // 示例接口 idl写好后运行make hertz-gen-api生成脚手架
     TemplateResponse Template(1: TemplateRequest req)(api.post="/api/v1/template")
     // 获取会话历史
@@ -987,6 +1362,8 @@ service ApiService {
     GetUserInfoResponse GetUserInfo(1: GetUserInfoRequest req)(api.get="/api/v1/user/info")
     // 更新用户设置
     UpdateUserSettingResponse UpdateUserSetting(1: UpdateUserSettingRequest req)(api.put="/api/v1/user/setting")
+    // 获取用户 token 用量与每日配额
+    GetUserUsageResponse GetUserUsage(1: GetUserUsageRequest req)(api.get="/api/v1/user/usage")
     
     // 待办事项管理
     // 创建待办事项
@@ -1023,4 +1400,12 @@ service ApiService {
     TermResponse GetTerm(1: TermRequest req) (api.get="/api/v1/terms/info")
     // 每日日程
     DailyScheduleResponse DailySchedule(1: DailyScheduleRequest req) (api.get="/api/v1/schedule/daily")
+
+    // 用户自定义 MCP 服务
+    // 添加MCP服务
+    CreateUserMCPServerResponse CreateUserMCPServer(1: CreateUserMCPServerRequest req)(api.post="/api/v1/mcp/server/create")
+    // 获取MCP服务列表
+    ListUserMCPServerResponse ListUserMCPServer(1: ListUserMCPServerRequest req)(api.get="/api/v1/mcp/server/list")
+    // 删除MCP服务
+    DeleteUserMCPServerResponse DeleteUserMCPServer(1: DeleteUserMCPServerRequest req)(api.delete="/api/v1/mcp/server/delete")
 }
\ No newline at end of file
@@ -0,0 +1,201 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/api"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/openai/openai-go/v2"
+)
+
+// toolRisk 按 mcp.approval.rules 计算工具风险等级，后出现的规则优先，未匹配的工具为 low
+func toolRisk(name string) string {
+	risk := constant.ToolRiskLow
+	for _, r := range config.MCP.Approval.Rules {
+		if ok, _ := path.Match(r.Name, name); ok {
+			risk = r.Risk
+		}
+	}
+	return risk
+}
+
+// toolSchema 从发给模型的工具列表中取出工具的参数 schema，找不到时返回 nil
+func toolSchema(tools []openai.ChatCompletionToolUnionParam, name string) *mcp.ToolInputSchema {
+	for _, t := range tools {
+		if t.OfFunction == nil || t.OfFunction.Function.Name != name {
+			continue
+		}
+		data, err := json.Marshal(t.OfFunction.Function.Parameters)
+		if err != nil {
+			return nil
+		}
+		schema := new(mcp.ToolInputSchema)
+		if err := json.Unmarshal(data, schema); err != nil {
+			return nil
+		}
+		return schema
+	}
+	return nil
+}
+
+// validateEditedArgs 按工具 schema 校验并纠正用户修改后的参数，没有 schema 时原样返回
+func validateEditedArgs(schema *mcp.ToolInputSchema, args map[string]any) (map[string]any, error) {
+	if schema == nil {
+		return args, nil
+	}
+	return tool_set.Validate(*schema, args)
+}
+
+func approvalTimeout() time.Duration {
+	if t := config.MCP.Approval.Timeout; t > 0 {
+		return t
+	}
+	return constant.ToolApprovalDefaultTimeout
+}
+
+// awaitToolApproval 在高风险工具执行前暂停本轮：保存待确认的调用并推送 tool_approval_required，
+// 等待用户通过 /api/v1/chat/approve 确认或超时。返回实际执行使用的参数；
+// 用户拒绝、超时或审批不可用时返回交给模型的工具错误，工具不会被执行
+func (h *Host) awaitToolApproval(
+	ctx context.Context,
+	userID, conversationID string,
+	round int,
+	toolCallID, name string,
+	args map[string]any,
+	schema *mcp.ToolInputSchema,
+	emit func(event string, v any) error,
+) (map[string]any, *mcp_client.ToolResult) {
+	timeout := approvalTimeout()
+	approval := &repository.ToolApproval{
+		ID:             uuid.NewString(),
+		UserID:         userID,
+		ConversationID: conversationID,
+		ToolCallID:     toolCallID,
+		Name:           name,
+		Args:           args,
+		Schema:         schema,
+		Risk:           toolRisk(name),
+		ExpiresAt:      time.Now().Add(timeout),
+	}
+	if err := h.templateRepository.CreateToolApproval(ctx, approval); err != nil {
+		logger.Errorf("tool approval: create for %s failed: %v", name, err)
+		return nil, mcp_client.ErrorToolResult(fmt.Errorf("tool %s requires user approval, but approval is unavailable", name))
+	}
+	_ = emit(constant.SSEEventToolApprovalRequired, map[string]any{
+		"approval_id": approval.ID,
+		"round":       round,
+		"name":        name,
+		"args":        args,
+		"risk":        approval.Risk,
+		"expires_at":  approval.ExpiresAt,
+	})
+
+	waitCtx, cancel := context.WithDeadline(ctx, approval.ExpiresAt)
+	defer cancel()
+	decision, err := h.templateRepository.WaitToolApprovalDecision(waitCtx, approval.ID)
+	if err != nil {
+		// 超时（或客户端断开）：抢先写入 timeout，之后的确认请求会被拒绝；用户恰好同时确认时以用户结果为准
+		decision = &repository.ToolApprovalDecision{Decision: constant.ToolApprovalTimeout}
+		bg := context.WithoutCancel(ctx)
+		if ok, derr := h.templateRepository.DecideToolApproval(bg, approval.ID, decision); derr == nil && !ok {
+			readCtx, cancelRead := context.WithTimeout(bg, time.Second)
+			if d, werr := h.templateRepository.WaitToolApprovalDecision(readCtx, approval.ID); werr == nil {
+				decision = d
+			}
+			cancelRead()
+		}
+	}
+
+	if decision.Decision == constant.ToolApprovalEdit {
+		// 结果来自 Redis，执行前再按 schema 校验一次，不符合时按拒绝处理
+		edited, err := validateEditedArgs(schema, decision.Args)
+		if err != nil {
+			decision = &repository.ToolApprovalDecision{
+				Decision: constant.ToolApprovalDeny,
+				Reason:   "the edited arguments are invalid: " + err.Error(),
+			}
+		} else {
+			args = edited
+		}
+	}
+	_ = emit(constant.SSEEventToolApprovalResolved, map[string]any{
+		"approval_id": approval.ID,
+		"round":       round,
+		"name":        name,
+		"status":      approvalStatus(decision.Decision),
+		"args":        args,
+	})
+	switch decision.Decision {
+	case constant.ToolApprovalApprove, constant.ToolApprovalEdit:
+		return args, nil
+	case constant.ToolApprovalTimeout:
+		return nil, mcp_client.ErrorToolResult(fmt.Errorf("the user did not approve tool %s within %s, it was not executed", name, timeout))
+	default:
+		msg := fmt.Sprintf("the user denied tool %s, it was not executed", name)
+		if decision.Reason != "" {
+			msg += ": " + decision.Reason
+		}
+		return nil, mcp_client.ErrorToolResult(fmt.Errorf("%s", msg))
+	}
+}
+
+func approvalStatus(decision string) string {
+	switch decision {
+	case constant.ToolApprovalApprove:
+		return constant.ToolApprovalStatusApproved
+	case constant.ToolApprovalEdit:
+		return constant.ToolApprovalStatusEdited
+	case constant.ToolApprovalTimeout:
+		return constant.ToolApprovalStatusTimeout
+	default:
+		return constant.ToolApprovalStatusDenied
+	}
+}
+
+// ApproveToolCallLogic 对流式对话中等待确认的工具调用做出决定，等待中的对话随即继续
+func (h *Host) ApproveToolCallLogic(req *api.ApproveToolCallRequest, userID string) (string, error) {
+	decision := &repository.ToolApprovalDecision{Decision: req.Decision, Reason: req.GetReason()}
+	switch req.Decision {
+	case constant.ToolApprovalApprove, constant.ToolApprovalDeny:
+	case constant.ToolApprovalEdit:
+		if err := json.Unmarshal([]byte(req.GetArgs()), &decision.Args); err != nil || decision.Args == nil {
+			return "", errno.NewErrNo(errno.ParamFormatCode, "修改后的参数必须是 JSON 对象")
+		}
+	default:
+		return "", errno.NewErrNo(errno.ParamValueCode, "decision 只能是 approve、deny 或 edit")
+	}
+
+	approval, err := h.templateRepository.GetToolApproval(h.ctx, req.ApprovalID)
+	if err != nil {
+		return "", err
+	}
+	// 他人的审批与不存在一样处理，不暴露审批是否存在
+	if approval == nil || approval.UserID != userID {
+		return "", errno.NewErrNo(errno.BizNotExist, "审批不存在或已过期")
+	}
+	if req.Decision == constant.ToolApprovalEdit {
+		if decision.Args, err = validateEditedArgs(approval.Schema, decision.Args); err != nil {
+			return "", errno.NewErrNo(errno.ParamValueCode, "修改后的参数不符合工具定义："+err.Error())
+		}
+	}
+	ok, err := h.templateRepository.DecideToolApproval(h.ctx, approval.ID, decision)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errno.NewErrNo(errno.BizLogicCode, "审批已处理或已超时")
+	}
+	return approvalStatus(req.Decision), nil
+}
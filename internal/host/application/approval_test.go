@@ -0,0 +1,181 @@
+package application
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/api"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/infra"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const approvalConfig = `
+server:
+  private-key: "user-secret"
+mcp:
+  approval:
+    timeout: 300ms
+    rules:
+      - name: "fs_*"
+        risk: "high"
+      - name: "fs_stat"
+        risk: "low"
+      - name: "code_run"
+        risk: "high"
+`
+
+// approvalOutcome awaitToolApproval 的返回值
+type approvalOutcome struct {
+	args map[string]any
+	res  *mcp_client.ToolResult
+}
+
+// loadConfig 以临时配置文件加载 host 配置
+func loadConfig(t *testing.T, data string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.Load(path, "host")
+}
+
+func TestToolApproval(t *testing.T) {
+	loadConfig(t, approvalConfig)
+
+	mr := miniredis.RunT(t)
+	cache := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer cache.Close()
+	h := &Host{ctx: context.Background(), templateRepository: infra.NewTemplateRepository(nil, cache)}
+
+	tools := (&mcp_client.MCPClient{Tools: []mcp.Tool{
+		mcp.NewTool("code_run", mcp.WithString("code", mcp.Required()), mcp.WithNumber("timeout")),
+	}}).ConvertToolsToOpenAI()
+	schema := toolSchema(tools, "code_run")
+
+	// start 在后台等待审批，返回审批 id 与结果通道；事件只写入通道，断言都在测试 goroutine 中
+	start := func(userID string) (string, <-chan approvalOutcome, <-chan map[string]any) {
+		events := make(chan map[string]any, 4)
+		emit := func(event string, v any) error {
+			payload := v.(map[string]any)
+			payload["event"] = event
+			events <- payload
+			return nil
+		}
+		out := make(chan approvalOutcome, 1)
+		go func() {
+			args, res := h.awaitToolApproval(context.Background(), userID, "c1", 1, "call_1", "code_run",
+				map[string]any{"code": "print(1)"}, schema, emit)
+			out <- approvalOutcome{args: args, res: res}
+		}()
+		required := <-events
+		return required["approval_id"].(string), out, events
+	}
+	approve := func(userID, id, decision string, args *string) (string, error) {
+		return h.ApproveToolCallLogic(&api.ApproveToolCallRequest{ApprovalID: id, Decision: decision, Args: args}, userID)
+	}
+
+	Convey("tool approval", t, func() {
+		Convey("rules are matched in order with later rules winning", func() {
+			So(toolRisk("fs_write"), ShouldEqual, constant.ToolRiskHigh)
+			So(toolRisk("fs_stat"), ShouldEqual, constant.ToolRiskLow)
+			So(toolRisk("todo_list"), ShouldEqual, constant.ToolRiskLow)
+		})
+
+		Convey("approve runs the tool with the original arguments", func() {
+			id, out, events := start("u1")
+			status, err := approve("u1", id, constant.ToolApprovalApprove, nil)
+			So(err, ShouldBeNil)
+			So(status, ShouldEqual, constant.ToolApprovalStatusApproved)
+			o := <-out
+			So(o.res, ShouldBeNil)
+			So(o.args, ShouldResemble, map[string]any{"code": "print(1)"})
+			So((<-events)["status"], ShouldEqual, constant.ToolApprovalStatusApproved)
+
+			_, err = approve("u1", id, constant.ToolApprovalDeny, nil)
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.BizLogicCode)
+		})
+
+		Convey("deny returns the reason to the model", func() {
+			id, out, _ := start("u1")
+			_, err := h.ApproveToolCallLogic(&api.ApproveToolCallRequest{ApprovalID: id, Decision: constant.ToolApprovalDeny, Reason: ptr("too risky")}, "u1")
+			So(err, ShouldBeNil)
+			o := <-out
+			So(o.res.IsError, ShouldBeTrue)
+			So(o.res.Text, ShouldContainSubstring, "too risky")
+		})
+
+		Convey("edit runs the tool with validated arguments", func() {
+			id, out, _ := start("u1")
+			status, err := approve("u1", id, constant.ToolApprovalEdit, ptr(`{"code":"print(2)","timeout":"5"}`))
+			So(err, ShouldBeNil)
+			So(status, ShouldEqual, constant.ToolApprovalStatusEdited)
+			o := <-out
+			So(o.res, ShouldBeNil)
+			So(o.args, ShouldResemble, map[string]any{"code": "print(2)", "timeout": float64(5)})
+		})
+
+		Convey("edit rejects arguments that do not match the schema and keeps the approval open", func() {
+			id, out, _ := start("u1")
+			_, err := approve("u1", id, constant.ToolApprovalEdit, ptr(`{"timeout":5}`))
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamValueCode)
+			_, err = approve("u1", id, constant.ToolApprovalEdit, ptr(`[1]`))
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamFormatCode)
+
+			_, err = approve("u1", id, constant.ToolApprovalApprove, nil)
+			So(err, ShouldBeNil)
+			So((<-out).res, ShouldBeNil)
+		})
+
+		Convey("edited arguments are validated again before the call", func() {
+			id, out, _ := start("u1")
+			ok, err := h.templateRepository.DecideToolApproval(context.Background(), id, &repository.ToolApprovalDecision{
+				Decision: constant.ToolApprovalEdit,
+				Args:     map[string]any{"timeout": "soon"},
+			})
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			o := <-out
+			So(o.args, ShouldBeNil)
+			So(o.res.IsError, ShouldBeTrue)
+			So(o.res.Text, ShouldContainSubstring, "edited arguments are invalid")
+		})
+
+		Convey("another user's approval looks like a missing one", func() {
+			id, out, _ := start("u1")
+			_, err := approve("u2", id, constant.ToolApprovalApprove, nil)
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.BizNotExist)
+			_, err = approve("u2", "missing", constant.ToolApprovalApprove, nil)
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.BizNotExist)
+
+			_, err = approve("u1", id, constant.ToolApprovalDeny, nil)
+			So(err, ShouldBeNil)
+			So((<-out).res.IsError, ShouldBeTrue)
+		})
+
+		Convey("times out and refuses late decisions", func() {
+			id, out, events := start("u1")
+			select {
+			case o := <-out:
+				So(o.res.IsError, ShouldBeTrue)
+				So(o.res.Text, ShouldContainSubstring, "did not approve")
+			case <-time.After(2 * time.Second):
+				t.Fatal("approval did not time out")
+			}
+			So((<-events)["status"], ShouldEqual, constant.ToolApprovalStatusTimeout)
+			_, err := approve("u1", id, constant.ToolApprovalApprove, nil)
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.BizLogicCode)
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 )
@@ -158,33 +159,38 @@ func (h *Host) StreamChat(
 
 	// 执行工具
 	for _, tc := range toolCalls {
-		args, err := ai_provider.ParseToolArguments(tc.Function.Arguments)
-		if err != nil {
-			args = map[string]any{"_error": err.Error()}
-		}
+		args, argErr := ai_provider.ParseToolArguments(tc.Function.Arguments)
 
 		_ = emit(constant.SSEEventToolCall, map[string]any{
 			"name": tc.Function.Name,
 			"args": args,
 		})
 
-		out, callErr := h.mcpCli.CallTool(ctx, tc.Function.Name, args)
-		if callErr != nil {
-			out = "tool error: " + callErr.Error()
+		// 非法参数直接作为工具错误回给模型
+		var res *mcp_client.ToolResult
+		if argErr != nil {
+			res = mcp_client.ErrorToolResult(argErr)
+		} else {
+			var callErr error
+			res, callErr = h.mcpCli.CallTool(ctx, tc.Function.Name, args)
+			if callErr != nil {
+				res = mcp_client.ErrorToolResult(callErr)
+			}
 		}
 
 		// 工具结果给前端
-		_ = emit(constant.SSEEventToolResult, map[string]any{
-			"name":   tc.Function.Name,
-			"result": out,
-		})
+		_ = emit(constant.SSEEventToolResult, toolResultEvent(tc.Function.Name, res))
 
-		// 工具结果落历史
-		hist = append(hist, ai_provider.Message{
+		// 工具结果落历史，Ollama 的 tool 消息可以直接携带 base64 图片
+		msg := ai_provider.Message{
 			Role:     "tool",
 			ToolName: tc.Function.Name,
-			Content:  out,
-		})
+			Content:  res.String(),
+		}
+		for _, img := range res.Images {
+			msg.Images = append(msg.Images, img.Data)
+		}
+		hist = append(hist, msg)
 	}
 
 	// 6) 二次流式：带工具结果，让模型给最终回答
@@ -0,0 +1,182 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/openai/openai-go/v2"
+)
+
+// ChatOptions 对话生成参数。请求中显式指定的字段随对话保存，后续轮次沿用；
+// 未指定的字段使用 ai_provider 的全局配置，model/system_prompt 传空字符串恢复默认
+type ChatOptions struct {
+	Model        *string  `json:"model,omitempty"`
+	Temperature  *float64 `json:"temperature,omitempty"`
+	TopP         *float64 `json:"top_p,omitempty"`
+	TopK         *int64   `json:"top_k,omitempty"`
+	MaxTokens    *int64   `json:"max_tokens,omitempty"`
+	SystemPrompt *string  `json:"system_prompt,omitempty"`
+}
+
+func (o *ChatOptions) empty() bool {
+	return *o == ChatOptions{}
+}
+
+func chatModelAllowed(m string) bool {
+	return m == config.AiProvider.Model || slices.Contains(config.AiProvider.ChatOptions.Models, m)
+}
+
+// validate 按服务端允许列表与取值范围校验请求中的参数
+func (o *ChatOptions) validate() error {
+	if o.Model != nil && *o.Model != "" && !chatModelAllowed(*o.Model) {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("不支持的模型 %q", *o.Model))
+	}
+	if o.Temperature != nil && (*o.Temperature < 0 || *o.Temperature > constant.ChatTemperatureMax) {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("temperature 取值范围为 0 ~ %g", constant.ChatTemperatureMax))
+	}
+	if o.TopP != nil && (*o.TopP <= 0 || *o.TopP > 1) {
+		return errno.NewErrNo(errno.ParamValueCode, "top_p 取值范围为 (0, 1]")
+	}
+	if o.TopK != nil && (*o.TopK < 1 || *o.TopK > constant.ChatTopKMax) {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("top_k 取值范围为 1 ~ %d", constant.ChatTopKMax))
+	}
+	maxTokens := int64(config.AiProvider.ChatOptions.MaxTokens)
+	if maxTokens <= 0 {
+		maxTokens = constant.ChatMaxTokensLimit
+	}
+	if o.MaxTokens != nil && (*o.MaxTokens < 1 || *o.MaxTokens > maxTokens) {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("max_tokens 取值范围为 1 ~ %d", maxTokens))
+	}
+	promptMax := config.AiProvider.ChatOptions.SystemPromptMaxChars
+	if promptMax <= 0 {
+		promptMax = constant.ChatSystemPromptMaxChars
+	}
+	if o.SystemPrompt != nil && utf8.RuneCountInString(*o.SystemPrompt) > promptMax {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("system_prompt 不能超过 %d 个字符", promptMax))
+	}
+	return nil
+}
+
+// merge 用 override 中显式指定的字段覆盖 o，空字符串表示恢复默认
+func (o *ChatOptions) merge(override ChatOptions) {
+	if override.Model != nil {
+		o.Model = override.Model
+	}
+	if override.Temperature != nil {
+		o.Temperature = override.Temperature
+	}
+	if override.TopP != nil {
+		o.TopP = override.TopP
+	}
+	if override.TopK != nil {
+		o.TopK = override.TopK
+	}
+	if override.MaxTokens != nil {
+		o.MaxTokens = override.MaxTokens
+	}
+	if override.SystemPrompt != nil {
+		o.SystemPrompt = override.SystemPrompt
+	}
+	if o.Model != nil && *o.Model == "" {
+		o.Model = nil
+	}
+	if o.SystemPrompt != nil && *o.SystemPrompt == "" {
+		o.SystemPrompt = nil
+	}
+}
+
+// resolveChatOptions 合并对话已保存的参数与本轮请求，返回生效参数及需要保存的 JSON（无变化时为空）
+func resolveChatOptions(conversation *model.Conversations, override ChatOptions) (*ChatOptions, string, error) {
+	if err := override.validate(); err != nil {
+		return nil, "", err
+	}
+	opts := new(ChatOptions)
+	if conversation != nil && conversation.ChatOptions != nil {
+		if err := json.Unmarshal([]byte(*conversation.ChatOptions), opts); err != nil {
+			logger.Warnf("chat options: invalid options of conversation %s: %v", conversation.ID, err)
+			opts = new(ChatOptions)
+		}
+		// 允许列表调整后，已保存的模型可能不再可用
+		if opts.Model != nil && !chatModelAllowed(*opts.Model) {
+			logger.Warnf("chat options: model %s of conversation %s is no longer allowed", *opts.Model, conversation.ID)
+			opts.Model = nil
+		}
+	}
+	if override.empty() {
+		return opts, "", nil
+	}
+	opts.merge(override)
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return nil, "", err
+	}
+	return opts, string(b), nil
+}
+
+// model 本次调用使用的模型
+func (o *ChatOptions) model() string {
+	if o.Model != nil {
+		return *o.Model
+	}
+	return config.AiProvider.Model
+}
+
+// visionModel 模型是否接受图片输入，见 ai_provider.chat_options.vision_models
+func visionModel(m string) bool {
+	for _, p := range config.AiProvider.ChatOptions.VisionModels {
+		if ok, _ := path.Match(p, m); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// params 构造一次模型调用的请求；system_prompt 只替换本次请求中开头的系统消息，不写入历史
+func (o *ChatOptions) params(hist []openai.ChatCompletionMessageParamUnion, tools []openai.ChatCompletionToolUnionParam) openai.ChatCompletionNewParams {
+	params := openai.ChatCompletionNewParams{
+		Model:    openai.ChatModel(o.model()),
+		Messages: hist,
+	}
+	if o.SystemPrompt != nil {
+		i := 0
+		for i < len(hist) && hist[i].OfSystem != nil {
+			i++
+		}
+		params.Messages = append([]openai.ChatCompletionMessageParamUnion{openai.SystemMessage(*o.SystemPrompt)}, hist[i:]...)
+	}
+	// 只有在 tools 非空时才传递 Tools 参数，避免阿里云 API 报错
+	if len(tools) > 0 {
+		params.Tools = tools
+	}
+	switch {
+	case o.MaxTokens != nil:
+		params.MaxTokens = openai.Int(*o.MaxTokens)
+	case config.AiProvider.Options.MaxTokens != nil:
+		params.MaxTokens = openai.Int(int64(*config.AiProvider.Options.MaxTokens))
+	}
+	switch {
+	case o.Temperature != nil:
+		params.Temperature = openai.Float(*o.Temperature)
+	case config.AiProvider.Options.Temperature != nil:
+		params.Temperature = openai.Float(*config.AiProvider.Options.Temperature)
+	}
+	switch {
+	case o.TopP != nil:
+		params.TopP = openai.Float(*o.TopP)
+	case config.AiProvider.Options.TopP != nil:
+		params.TopP = openai.Float(*config.AiProvider.Options.TopP)
+	}
+	// top_k 不在 OpenAI 规范内，只在请求显式指定时透传
+	if o.TopK != nil {
+		params.SetExtraFields(map[string]any{constant.ChatOptionTopKField: *o.TopK})
+	}
+	return params
+}
@@ -0,0 +1,204 @@
+package application
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	openai "github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const chatOptionsConfig = `
+server:
+  private-key: "user-secret"
+ai_provider:
+  model: "base-model"
+  options:
+    temperature: 0.7
+  chat_options:
+    models: ["large-model"]
+    max_tokens: 1000
+    system_prompt_max_chars: 5
+    vision_models: ["large-*"]
+`
+
+func ptr[T any](v T) *T { return &v }
+
+func TestChatOptionsValidate(t *testing.T) {
+	loadConfig(t, chatOptionsConfig)
+
+	cases := []struct {
+		name string
+		opts ChatOptions
+		ok   bool
+	}{
+		{"empty", ChatOptions{}, true},
+		{"default model", ChatOptions{Model: ptr("base-model")}, true},
+		{"allowed model", ChatOptions{Model: ptr("large-model")}, true},
+		{"empty model resets to default", ChatOptions{Model: ptr("")}, true},
+		{"unknown model", ChatOptions{Model: ptr("other-model")}, false},
+		{"temperature bounds", ChatOptions{Temperature: ptr(0.0)}, true},
+		{"temperature too high", ChatOptions{Temperature: ptr(2.1)}, false},
+		{"negative temperature", ChatOptions{Temperature: ptr(-0.1)}, false},
+		{"top_p of one", ChatOptions{TopP: ptr(1.0)}, true},
+		{"top_p of zero", ChatOptions{TopP: ptr(0.0)}, false},
+		{"top_k in range", ChatOptions{TopK: ptr(int64(100))}, true},
+		{"top_k of zero", ChatOptions{TopK: ptr(int64(0))}, false},
+		{"top_k too high", ChatOptions{TopK: ptr(int64(101))}, false},
+		{"max_tokens at the configured limit", ChatOptions{MaxTokens: ptr(int64(1000))}, true},
+		{"max_tokens over the configured limit", ChatOptions{MaxTokens: ptr(int64(1001))}, false},
+		{"system prompt counted in runes", ChatOptions{SystemPrompt: ptr("你好你好你")}, true},
+		{"system prompt too long", ChatOptions{SystemPrompt: ptr("你好你好你好")}, false},
+	}
+
+	Convey("ChatOptions.validate", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				err := c.opts.validate()
+				if c.ok {
+					So(err, ShouldBeNil)
+					return
+				}
+				So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamValueCode)
+			})
+		}
+	})
+}
+
+func TestChatOptionsMerge(t *testing.T) {
+	cases := []struct {
+		name     string
+		base     ChatOptions
+		override ChatOptions
+		want     ChatOptions
+	}{
+		{"keeps fields the override leaves out",
+			ChatOptions{Model: ptr("large-model"), Temperature: ptr(0.5)},
+			ChatOptions{TopK: ptr(int64(5))},
+			ChatOptions{Model: ptr("large-model"), Temperature: ptr(0.5), TopK: ptr(int64(5))}},
+		{"replaces fields the override sets",
+			ChatOptions{Temperature: ptr(0.5), MaxTokens: ptr(int64(10))},
+			ChatOptions{Temperature: ptr(1.0), TopP: ptr(0.9), MaxTokens: ptr(int64(20))},
+			ChatOptions{Temperature: ptr(1.0), TopP: ptr(0.9), MaxTokens: ptr(int64(20))}},
+		{"empty strings reset model and system prompt",
+			ChatOptions{Model: ptr("large-model"), SystemPrompt: ptr("be brief"), Temperature: ptr(0.5)},
+			ChatOptions{Model: ptr(""), SystemPrompt: ptr("")},
+			ChatOptions{Temperature: ptr(0.5)}},
+	}
+
+	Convey("ChatOptions.merge", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				c.base.merge(c.override)
+				So(c.base, ShouldResemble, c.want)
+			})
+		}
+	})
+}
+
+func TestResolveChatOptions(t *testing.T) {
+	loadConfig(t, chatOptionsConfig)
+	conversation := func(saved string) *model.Conversations {
+		return &model.Conversations{ID: "c1", ChatOptions: &saved}
+	}
+
+	cases := []struct {
+		name         string
+		conversation *model.Conversations
+		override     ChatOptions
+		want         ChatOptions
+		saved        string
+	}{
+		{"new conversation without options", nil, ChatOptions{}, ChatOptions{}, ""},
+		{"saved options are reused without saving again",
+			conversation(`{"model":"large-model","temperature":0.5}`), ChatOptions{},
+			ChatOptions{Model: ptr("large-model"), Temperature: ptr(0.5)}, ""},
+		{"override is merged and saved",
+			conversation(`{"model":"large-model","temperature":0.5}`), ChatOptions{Temperature: ptr(1.0)},
+			ChatOptions{Model: ptr("large-model"), Temperature: ptr(1.0)}, `{"model":"large-model","temperature":1}`},
+		{"a model removed from the allow list falls back to the default",
+			conversation(`{"model":"retired-model","top_k":5}`), ChatOptions{},
+			ChatOptions{TopK: ptr(int64(5))}, ""},
+		{"invalid saved options are ignored",
+			conversation(`{"temperature":"hot"}`), ChatOptions{MaxTokens: ptr(int64(10))},
+			ChatOptions{MaxTokens: ptr(int64(10))}, `{"max_tokens":10}`},
+	}
+
+	Convey("resolveChatOptions", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				opts, saved, err := resolveChatOptions(c.conversation, c.override)
+				So(err, ShouldBeNil)
+				So(*opts, ShouldResemble, c.want)
+				So(saved, ShouldEqual, c.saved)
+			})
+		}
+
+		Convey("rejects an invalid override", func() {
+			_, _, err := resolveChatOptions(conversation(`{}`), ChatOptions{Model: ptr("other-model")})
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamValueCode)
+		})
+	})
+}
+
+func TestChatOptionsParams(t *testing.T) {
+	loadConfig(t, chatOptionsConfig)
+	hist := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage("default prompt"),
+		openai.SystemMessage("resource context"),
+		openai.UserMessage("hi"),
+	}
+	// encode 序列化为请求体，便于检查实际发送的字段
+	encode := func(p openai.ChatCompletionNewParams) map[string]any {
+		data, err := json.Marshal(p)
+		So(err, ShouldBeNil)
+		var out map[string]any
+		So(json.Unmarshal(data, &out), ShouldBeNil)
+		return out
+	}
+	contents := func(body map[string]any) []string {
+		var out []string
+		for _, m := range body["messages"].([]any) {
+			out = append(out, m.(map[string]any)["content"].(string))
+		}
+		return out
+	}
+
+	Convey("ChatOptions.params", t, func() {
+		Convey("uses the provider defaults when nothing is set", func() {
+			body := encode((&ChatOptions{}).params(hist, nil))
+			So(body["model"], ShouldEqual, "base-model")
+			So(body["temperature"], ShouldEqual, 0.7)
+			So(body, ShouldNotContainKey, "tools")
+			So(body, ShouldNotContainKey, "top_k")
+			So(body, ShouldNotContainKey, "max_tokens")
+			So(contents(body), ShouldResemble, []string{"default prompt", "resource context", "hi"})
+		})
+
+		Convey("applies the conversation options", func() {
+			tools := []openai.ChatCompletionToolUnionParam{{OfFunction: &openai.ChatCompletionFunctionToolParam{
+				Function: openai.FunctionDefinitionParam{Name: "math_eval"},
+			}}}
+			opts := &ChatOptions{
+				Model:        ptr("large-model"),
+				Temperature:  ptr(0.0),
+				TopP:         ptr(0.9),
+				TopK:         ptr(int64(5)),
+				MaxTokens:    ptr(int64(100)),
+				SystemPrompt: ptr("be brief"),
+			}
+			body := encode(opts.params(hist, tools))
+			So(body["model"], ShouldEqual, "large-model")
+			So(body["temperature"], ShouldEqual, 0.0)
+			So(body["top_p"], ShouldEqual, 0.9)
+			So(body["top_k"], ShouldEqual, 5)
+			So(body["max_tokens"], ShouldEqual, 100)
+			So(body["tools"], ShouldHaveLength, 1)
+			// 只替换开头的系统消息，历史本身不变
+			So(contents(body), ShouldResemble, []string{"be brief", "hi"})
+			So(hist, ShouldHaveLength, 3)
+		})
+	})
+}
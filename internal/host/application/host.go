@@ -21,6 +21,7 @@ var historyOpenAI = make(map[int64][]openai.ChatCompletionMessageParamUnion)
 type Host struct {
 	ctx           context.Context
 	mcpCli        mcp_client.ToolClient
+	userMCPPool   *mcp_client.UserClientPool
 	aiProviderCli *ai_provider.Client
 	// 添加需要的连接
 	templateRepository repository.TemplateRepository
@@ -30,6 +31,7 @@ func NewHost(ctx context.Context, clientSet *base.ClientSet) *Host {
 	return &Host{
 		ctx:                ctx,
 		mcpCli:             clientSet.MCPCli,
+		userMCPPool:        clientSet.UserMCPPool,
 		aiProviderCli:      clientSet.AiProviderCli,
 		templateRepository: infra.NewTemplateRepository(db.NewDBWithQuery(clientSet.ActualDB, query.Use), clientSet.Cache),
 	}
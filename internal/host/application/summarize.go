@@ -8,19 +8,15 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
-	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/config"
-	"github.com/FantasyRL/go-mcp-demo/internal/host/infra"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 	openai "github.com/openai/openai-go/v2"
 )
 
-const (
-	summarizePromptName = "summarize"
-)
-
 type SummarizeResult struct {
 	SumID         string
 	Summary       string
@@ -119,11 +115,6 @@ func (p *conversationSummaryPayload) UnmarshalJSON(data []byte) error {
 }
 
 func (h *Host) buildSummarizePrompt(ctx context.Context, conversationID string, userID string) (string, error) {
-	tpl, err := infra.LoadPrompt(summarizePromptName)
-	if err != nil {
-		return "", fmt.Errorf("load summarize prompt: %w", err)
-	}
-
 	history, err := h.selectConversationHistory(ctx, conversationID)
 	if err != nil {
 		return "", fmt.Errorf("get conversation history: %w", err)
@@ -141,16 +132,13 @@ func (h *Host) buildSummarizePrompt(ctx context.Context, conversationID string,
 	logger.Infof("buildSummarizePrompt: conversationID=%s, has existing summary=%v",
 		conversationID, existingSummary != nil)
 
-	templateData := map[string]any{
-		"conversation_id":      conversationID,
+	// 提示词模板由 MCP server 提供
+	rendered, err := h.mcpCli.GetPrompt(ctx, constant.MCPPromptSummarize, map[string]string{
 		"conversation_history": strings.Join(history, "\n"),
 		"existing_summary":     summaryInfo,
-		"generated_at":         time.Now().Format(time.RFC3339),
-	}
-
-	rendered, err := infra.RenderPrompt(tpl, templateData)
+	})
 	if err != nil {
-		return "", fmt.Errorf("render summarize prompt: %w", err)
+		return "", fmt.Errorf("get summarize prompt: %w", err)
 	}
 	return rendered, nil
 }
@@ -330,6 +318,7 @@ func (h *Host) persistConversationSummary(ctx context.Context, conversationID st
 		if err := h.templateRepository.UpdateSummary(ctx, existingSummary); err != nil {
 			return "", err
 		}
+		h.publishResourceUpdated(utils.SummaryResourceURI(conversationID))
 		return existingSummary.ID, nil
 	}
 
@@ -346,6 +335,7 @@ func (h *Host) persistConversationSummary(ctx context.Context, conversationID st
 	if err := h.templateRepository.CreateSummary(ctx, summary); err != nil {
 		return "", err
 	}
+	h.publishResourceUpdated(utils.SummaryResourceURI(conversationID))
 
 	return summary.ID, nil
 }
@@ -355,12 +345,16 @@ func (h *Host) persistConversationSummary(ctx context.Context, conversationID st
 func (h *Host) summarizeConversation(conversationID string, userID string) (*SummarizeResult, error) {
 	logger.Infof("SummarizeConversation start conversation_id=%s user_id=%s", conversationID, userID)
 
+	if err := h.checkTokenQuota(userID); err != nil {
+		return nil, err
+	}
+
 	prompt, err := h.buildSummarizePrompt(h.ctx, conversationID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	raw, err := h.invokeSummarizeModel(h.ctx, prompt)
+	raw, err := h.invokeSummarizeModel(h.withTokenUsage(h.ctx, userID, conversationID), prompt)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/api/model/api"
 	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 )
 
 func (h *Host) TemplateLogic(req *api.TemplateRequest) (*model.Users, error) {
@@ -51,6 +52,7 @@ func (h *Host) CreateTodoLogic(req *api.CreateTodoRequest, userID string) (strin
 	if err != nil {
 		return "", err
 	}
+	h.publishResourceUpdated(utils.TodoResourceURI(userID, todo.ID))
 
 	return todo.ID, nil
 }
@@ -142,12 +144,20 @@ func (h *Host) UpdateTodoLogic(req *api.UpdateTodoRequest, userID string) error
 	}
 
 	// 更新待办事项
-	return h.templateRepository.UpdateTodo(h.ctx, todo)
+	if err := h.templateRepository.UpdateTodo(h.ctx, todo); err != nil {
+		return err
+	}
+	h.publishResourceUpdated(utils.TodoResourceURI(userID, todo.ID))
+	return nil
 }
 
 // DeleteTodoLogic 删除待办事项
 func (h *Host) DeleteTodoLogic(id string, userID string) error {
-	return h.templateRepository.DeleteTodo(h.ctx, id, userID)
+	if err := h.templateRepository.DeleteTodo(h.ctx, id, userID); err != nil {
+		return err
+	}
+	h.publishResourceUpdated(utils.TodoResourceURI(userID, id))
+	return nil
 }
 
 // ==================== Summarize 相关业务逻辑 ====================
@@ -211,7 +221,11 @@ func (h *Host) UpdateSummaryLogic(req *api.UpdateSummaryRequest) error {
 	}
 
 	// 更新摘要
-	return h.templateRepository.UpdateSummary(h.ctx, summary)
+	if err := h.templateRepository.UpdateSummary(h.ctx, summary); err != nil {
+		return err
+	}
+	h.publishResourceUpdated(utils.SummaryResourceURI(summary.ConversationID))
+	return nil
 }
 
 // DeleteSummaryLogic 删除摘要
@@ -225,17 +239,21 @@ func (h *Host) DeleteSummaryLogic(id string) error {
 		return errno.NewErrNo(errno.BizNotExist, "摘要不存在")
 	}
 
-	return h.templateRepository.DeleteSummary(h.ctx, id)
+	if err := h.templateRepository.DeleteSummary(h.ctx, id); err != nil {
+		return err
+	}
+	h.publishResourceUpdated(utils.SummaryResourceURI(summary.ConversationID))
+	return nil
 }
 
 // DeleteConversationLogic 删除会话
 func (h *Host) DeleteConversationLogic(id string) error {
-    conversation, err := h.templateRepository.GetConversationByID(h.ctx, id)
-    if err != nil {
-        return err
-    }
-    if conversation == nil {
-        return errno.NewErrNo(errno.BizNotExist, "会话不存在")
-    }
-    return h.templateRepository.DeleteConversation(h.ctx, id)
-}
\ No newline at end of file
+	conversation, err := h.templateRepository.GetConversationByID(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if conversation == nil {
+		return errno.NewErrNo(errno.BizNotExist, "会话不存在")
+	}
+	return h.templateRepository.DeleteConversation(h.ctx, id)
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/openai/openai-go/v2"
+)
+
+// userToolSetting users.setting_json 中 tools 段，缺省时全部工具可用
+type userToolSetting struct {
+	WebSearch       *bool    `json:"web_search,omitempty"`       // false 时禁用 web_search/web_fetch
+	Disabled        []string `json:"disabled,omitempty"`         // 禁用的工具名，支持 path.Match 风格的 glob
+	DisabledServers []string `json:"disabled_servers,omitempty"` // 禁用的用户自定义 MCP 服务名，其工具不会被加载
+}
+
+// ToolOverride 单次对话请求对工具偏好的覆盖
+type ToolOverride struct {
+	Enable  []string // 覆盖用户设置中禁用的工具
+	Disable []string // 本轮额外禁用的工具，优先于 Enable
+}
+
+// parseUserToolSetting 解析并校验 setting_json 中的 tools 段，其余字段不做约束
+func parseUserToolSetting(settingJSON string) (*userToolSetting, error) {
+	var root struct {
+		Tools json.RawMessage `json:"tools"`
+	}
+	if err := json.Unmarshal([]byte(settingJSON), &root); err != nil {
+		return nil, errno.NewErrNo(errno.ParamFormatCode, "设置必须是 JSON 对象")
+	}
+	setting := new(userToolSetting)
+	if len(root.Tools) == 0 || string(root.Tools) == "null" {
+		return setting, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(root.Tools))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(setting); err != nil {
+		return nil, errno.NewErrNo(errno.ParamFormatCode, fmt.Sprintf("tools 设置格式错误: %v", err))
+	}
+	if err := validateToolPatterns("tools.disabled", setting.Disabled); err != nil {
+		return nil, err
+	}
+	if len(setting.DisabledServers) > constant.UserSettingToolsMaxEntries {
+		return nil, errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("tools.disabled_servers 最多 %d 项", constant.UserSettingToolsMaxEntries))
+	}
+	for _, name := range setting.DisabledServers {
+		if !userMCPServerNamePattern.MatchString(name) {
+			return nil, errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("tools.disabled_servers 中的服务名 %q 不合法", name))
+		}
+	}
+	return setting, nil
+}
+
+func validateToolPatterns(field string, patterns []string) error {
+	if len(patterns) > constant.UserSettingToolsMaxEntries {
+		return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("%s 最多 %d 项", field, constant.UserSettingToolsMaxEntries))
+	}
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); p == "" || err != nil {
+			return errno.NewErrNo(errno.ParamValueCode, fmt.Sprintf("%s 中的工具名 %q 不合法", field, p))
+		}
+	}
+	return nil
+}
+
+// loadUserToolSetting 读取用户的工具偏好；读取失败或历史数据不合法时按缺省处理
+func (h *Host) loadUserToolSetting(userID string) *userToolSetting {
+	if userID == "" {
+		return new(userToolSetting)
+	}
+	u, err := h.templateRepository.GetUserByID(h.ctx, userID)
+	if err != nil {
+		logger.Errorf("tool preference: get user %s failed: %v", userID, err)
+		return new(userToolSetting)
+	}
+	if u == nil || u.SettingJSON == nil {
+		return new(userToolSetting)
+	}
+	setting, err := parseUserToolSetting(*u.SettingJSON)
+	if err != nil {
+		logger.Warnf("tool preference: invalid setting of user %s: %v", userID, err)
+		return new(userToolSetting)
+	}
+	return setting
+}
+
+// toolFilter 本轮对话可用工具的判定
+type toolFilter struct {
+	disabled []string
+	enabled  []string
+	forced   []string
+}
+
+// newToolFilter 合并用户设置与请求覆盖，请求中的工具名不合法时返回参数错误
+func newToolFilter(setting *userToolSetting, override ToolOverride) (*toolFilter, error) {
+	if err := validateToolPatterns("enable_tools", override.Enable); err != nil {
+		return nil, err
+	}
+	if err := validateToolPatterns("disable_tools", override.Disable); err != nil {
+		return nil, err
+	}
+	f := &toolFilter{
+		disabled: slices.Clone(setting.Disabled),
+		enabled:  override.Enable,
+		forced:   override.Disable,
+	}
+	if setting.WebSearch != nil && !*setting.WebSearch {
+		f.disabled = append(f.disabled, constant.WebSearchTools...)
+	}
+	return f, nil
+}
+
+func matchAnyTool(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// allow 内部工具始终不可用；disable_tools 优先，其次 enable_tools 覆盖用户设置
+func (f *toolFilter) allow(name string) bool {
+	if slices.Contains(constant.ChatInternalTools, name) || matchAnyTool(f.forced, name) {
+		return false
+	}
+	return !matchAnyTool(f.disabled, name) || matchAnyTool(f.enabled, name)
+}
+
+// apply 过滤 ConvertToolsToOpenAI 的结果
+func (f *toolFilter) apply(tools []openai.ChatCompletionToolUnionParam) []openai.ChatCompletionToolUnionParam {
+	out := make([]openai.ChatCompletionToolUnionParam, 0, len(tools))
+	for _, tool := range tools {
+		if tool.OfFunction != nil && !f.allow(tool.OfFunction.Function.Name) {
+			continue
+		}
+		out = append(out, tool)
+	}
+	return out
+}
@@ -0,0 +1,196 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	openai "github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseUserToolSetting(t *testing.T) {
+	f := false
+	tooMany := `["` + strings.Repeat(`a","`, 100) + `a"]`
+	cases := []struct {
+		name    string
+		setting string
+		want    *userToolSetting
+		code    int64
+	}{
+		{"no tools section", `{"theme":"dark"}`, &userToolSetting{}, 0},
+		{"null tools section", `{"tools":null}`, &userToolSetting{}, 0},
+		{"full tools section", `{"tools":{"web_search":false,"disabled":["fs_*"],"disabled_servers":["my-srv"]}}`,
+			&userToolSetting{WebSearch: &f, Disabled: []string{"fs_*"}, DisabledServers: []string{"my-srv"}}, 0},
+		{"not an object", `[1]`, nil, errno.ParamFormatCode},
+		{"unknown field", `{"tools":{"disable":["fs_*"]}}`, nil, errno.ParamFormatCode},
+		{"wrong type", `{"tools":{"web_search":"no"}}`, nil, errno.ParamFormatCode},
+		{"empty pattern", `{"tools":{"disabled":[""]}}`, nil, errno.ParamValueCode},
+		{"malformed pattern", `{"tools":{"disabled":["fs_["]}}`, nil, errno.ParamValueCode},
+		{"too many patterns", `{"tools":{"disabled":` + tooMany + `}}`, nil, errno.ParamValueCode},
+		{"invalid server name", `{"tools":{"disabled_servers":["my srv"]}}`, nil, errno.ParamValueCode},
+		{"too many servers", `{"tools":{"disabled_servers":` + tooMany + `}}`, nil, errno.ParamValueCode},
+	}
+
+	Convey("parseUserToolSetting", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				got, err := parseUserToolSetting(c.setting)
+				if c.code != 0 {
+					So(err.(errno.ErrNo).ErrorCode, ShouldEqual, c.code)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, c.want)
+			})
+		}
+	})
+}
+
+func TestToolFilter(t *testing.T) {
+	f := false
+	cases := []struct {
+		name     string
+		setting  userToolSetting
+		override ToolOverride
+		allowed  []string
+		denied   []string
+	}{
+		{"everything is allowed by default", userToolSetting{}, ToolOverride{},
+			[]string{"fs_write", "web_search", "math_eval"}, nil},
+		{"internal tools are never exposed", userToolSetting{}, ToolOverride{Enable: []string{"get_todos", "*"}},
+			nil, []string{"get_todos", "get_course"}},
+		{"user setting disables by glob", userToolSetting{Disabled: []string{"fs_*"}}, ToolOverride{},
+			[]string{"math_eval"}, []string{"fs_write", "fs_undo"}},
+		{"web_search=false disables the web tools", userToolSetting{WebSearch: &f}, ToolOverride{},
+			[]string{"math_eval"}, []string{"web_search", "web_fetch"}},
+		{"enable_tools overrides the user setting", userToolSetting{Disabled: []string{"fs_*"}, WebSearch: &f},
+			ToolOverride{Enable: []string{"fs_cat", "web_*"}},
+			[]string{"fs_cat", "web_search", "web_fetch"}, []string{"fs_write"}},
+		{"disable_tools wins over enable_tools", userToolSetting{},
+			ToolOverride{Enable: []string{"fs_*"}, Disable: []string{"fs_write"}},
+			[]string{"fs_cat"}, []string{"fs_write"}},
+	}
+
+	Convey("toolFilter", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				filter, err := newToolFilter(&c.setting, c.override)
+				So(err, ShouldBeNil)
+				for _, name := range c.allowed {
+					So(filter.allow(name), ShouldBeTrue)
+				}
+				for _, name := range c.denied {
+					So(filter.allow(name), ShouldBeFalse)
+				}
+			})
+		}
+
+		Convey("rejects malformed overrides", func() {
+			_, err := newToolFilter(&userToolSetting{}, ToolOverride{Enable: []string{""}})
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamValueCode)
+			_, err = newToolFilter(&userToolSetting{}, ToolOverride{Disable: []string{"["}})
+			So(err.(errno.ErrNo).ErrorCode, ShouldEqual, errno.ParamValueCode)
+		})
+
+		Convey("does not modify the user setting", func() {
+			setting := &userToolSetting{WebSearch: &f, Disabled: make([]string, 1, 8)}
+			setting.Disabled[0] = "fs_*"
+			_, err := newToolFilter(setting, ToolOverride{})
+			So(err, ShouldBeNil)
+			So(setting.Disabled, ShouldResemble, []string{"fs_*"})
+		})
+
+		Convey("apply drops disallowed tools", func() {
+			filter, _ := newToolFilter(&userToolSetting{Disabled: []string{"fs_*"}}, ToolOverride{})
+			tools := (&mcp_client.MCPClient{Tools: []mcp.Tool{
+				mcp.NewTool("fs_write"), mcp.NewTool("math_eval"), mcp.NewTool("get_todos"),
+			}}).ConvertToolsToOpenAI()
+			So(toolNames(filter.apply(tools)), ShouldResemble, []string{"math_eval"})
+		})
+	})
+}
+
+// userServersRepo 只实现 ListUserMCPServers 的 TemplateRepository
+type userServersRepo struct {
+	repository.TemplateRepository
+	servers []*model.UserMcpServers
+}
+
+func (r userServersRepo) ListUserMCPServers(context.Context, string) ([]*model.UserMcpServers, error) {
+	return r.servers, nil
+}
+
+func TestToolClientFor(t *testing.T) {
+	loadConfig(t, "server:\n  private-key: \"user-secret\"\n  encryption-key: \"encryption-secret\"\nmcp:\n  user:\n    allow_private: true\n")
+
+	newServer := func(tool string) *httptest.Server {
+		s := server.NewMCPServer("user", "0.0.1")
+		s.AddTool(mcp.NewTool(tool), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText(tool), nil
+		})
+		return httptest.NewServer(server.NewStreamableHTTPServer(s))
+	}
+	alpha, beta := newServer("alpha_tool"), newServer("beta_tool")
+	defer alpha.Close()
+	defer beta.Close()
+
+	creds, _ := json.Marshal(userMCPCredentials{Token: "t"})
+	encrypted, err := utils.EncryptSecret(creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := mcp_client.NewUserClientPool()
+	defer pool.Close()
+	h := &Host{
+		ctx:         context.Background(),
+		mcpCli:      &mcp_client.MCPClient{Tools: []mcp.Tool{mcp.NewTool("global_tool")}},
+		userMCPPool: pool,
+		templateRepository: userServersRepo{servers: []*model.UserMcpServers{
+			{ID: "1", Name: "alpha", URL: alpha.URL, Credentials: encrypted, Enabled: 1},
+			{ID: "2", Name: "beta", URL: beta.URL, Credentials: encrypted, Enabled: 1},
+			{ID: "3", Name: "off", URL: beta.URL, Credentials: encrypted, Enabled: 0},
+		}},
+	}
+	names := func(c mcp_client.ToolClient, release func()) []string {
+		defer release()
+		out := toolNames(c.ConvertToolsToOpenAI())
+		sort.Strings(out)
+		return out
+	}
+
+	Convey("toolClientFor", t, func() {
+		Convey("loads every enabled server", func() {
+			So(names(h.toolClientFor("u1", nil)), ShouldResemble, []string{"alpha_tool", "beta_tool", "global_tool"})
+		})
+
+		Convey("skips servers listed in disabled_servers", func() {
+			So(names(h.toolClientFor("u1", []string{"beta"})), ShouldResemble, []string{"alpha_tool", "global_tool"})
+			So(names(h.toolClientFor("u1", []string{"alpha", "beta"})), ShouldResemble, []string{"global_tool"})
+		})
+
+		Convey("falls back to the global client without a user", func() {
+			cli, release := h.toolClientFor("", nil)
+			defer release()
+			So(cli, ShouldEqual, h.mcpCli)
+		})
+	})
+}
+
+func toolNames(tools []openai.ChatCompletionToolUnionParam) []string {
+	names := make([]string, 0, len(tools))
+	for _, t := range tools {
+		names = append(names, t.OfFunction.Function.Name)
+	}
+	return names
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	openai "github.com/openai/openai-go/v2"
+)
+
+// toolResultEvent tool_result 事件数据：isError 单独给出，图片以 data URL 透传给前端
+func toolResultEvent(name string, res *mcp_client.ToolResult) map[string]any {
+	event := map[string]any{
+		"name":     name,
+		"result":   res.String(),
+		"is_error": res.IsError,
+	}
+	if res.Structured != nil {
+		event["structured"] = res.Structured
+	}
+	if len(res.Images) > 0 {
+		images := make([]string, 0, len(res.Images))
+		for _, img := range res.Images {
+			images = append(images, img.DataURL())
+		}
+		event["images"] = images
+	}
+	return event
+}
+
+// toolImages 收集一轮工具调用返回的图片，ToolMessage 只能承载文本。
+// 只有 vision 为 true（视觉模型）时才收集，且只收集 constant.MCPToolResultImageMIMETypes 中的格式；
+// 其余图片模型只能从工具结果文本中得知其存在
+type toolImages struct {
+	vision bool
+	parts  []openai.ChatCompletionContentPartUnionParam
+}
+
+func (t *toolImages) add(name string, res *mcp_client.ToolResult) {
+	if !t.vision {
+		return
+	}
+	for _, img := range res.Images {
+		if len(t.parts)/2 >= constant.MCPToolResultMaxImages {
+			return
+		}
+		if !slices.Contains(constant.MCPToolResultImageMIMETypes, img.MIMEType) {
+			continue
+		}
+		t.parts = append(t.parts,
+			openai.TextContentPart(fmt.Sprintf("工具 %s 返回的图片：", name)),
+			openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: img.DataURL()}),
+		)
+	}
+}
+
+// message 把图片作为一条 user 消息附在工具结果之后，供视觉模型查看；没有图片时返回 nil
+func (t *toolImages) message() *openai.ChatCompletionMessageParamUnion {
+	if len(t.parts) == 0 {
+		return nil
+	}
+	msg := openai.UserMessage(t.parts)
+	return &msg
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestToolImages(t *testing.T) {
+	loadConfig(t, chatOptionsConfig)
+
+	res := &mcp_client.ToolResult{Text: "plotted", Images: []mcp_client.ToolImage{
+		{MIMEType: constant.MathPlotImageMIME, Data: "PHN2Zz4="},
+		{MIMEType: "image/png", Data: "iVBORw0K"},
+	}}
+
+	Convey("toolImages", t, func() {
+		Convey("vision models are matched by glob", func() {
+			So(visionModel("large-model"), ShouldBeTrue)
+			So(visionModel("base-model"), ShouldBeFalse)
+			So(visionModel((&ChatOptions{}).model()), ShouldBeFalse)
+			So(visionModel((&ChatOptions{Model: ptr("large-model")}).model()), ShouldBeTrue)
+		})
+
+		Convey("vision models only receive supported image types", func() {
+			images := toolImages{vision: true}
+			images.add("math_plot", res)
+			msg := images.message()
+			So(msg, ShouldNotBeNil)
+			parts := msg.OfUser.Content.OfArrayOfContentParts
+			So(parts, ShouldHaveLength, 2)
+			So(parts[1].OfImageURL.ImageURL.URL, ShouldEqual, "data:image/png;base64,iVBORw0K")
+		})
+
+		Convey("unsupported images are not sent at all", func() {
+			images := toolImages{vision: true}
+			images.add("math_plot", &mcp_client.ToolResult{Images: res.Images[:1]})
+			So(images.message(), ShouldBeNil)
+		})
+
+		Convey("text-only models get no image parts", func() {
+			images := toolImages{}
+			images.add("math_plot", res)
+			So(images.message(), ShouldBeNil)
+		})
+
+		Convey("at most MCPToolResultMaxImages images are attached", func() {
+			images := toolImages{vision: true}
+			for i := 0; i < constant.MCPToolResultMaxImages+2; i++ {
+				images.add("screenshot", res)
+			}
+			So(images.message().OfUser.Content.OfArrayOfContentParts, ShouldHaveLength, 2*constant.MCPToolResultMaxImages)
+		})
+	})
+}
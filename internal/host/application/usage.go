@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/api"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// dailyTokenQuota 按 ai_provider.quota 计算角色的每日 token 配额，0 表示不限制
+func dailyTokenQuota(role string) int64 {
+	q := config.AiProvider.Quota
+	for _, r := range q.Roles {
+		if r.Role == role {
+			return r.DailyTokens
+		}
+	}
+	return q.DailyTokens
+}
+
+// startOfDay 服务器本地时区当天零点，配额按自然日统计
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// userRole 获取用户角色，用户不存在或未设置时为默认角色
+func (h *Host) userRole(userID string) (string, error) {
+	u, err := h.templateRepository.GetUserByID(h.ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if u == nil || u.Role == "" {
+		return constant.UserRoleDefault, nil
+	}
+	return u.Role, nil
+}
+
+// checkTokenQuota 调用模型前检查用户今日用量是否已达配额，MCP 工具回报的内部用量同样计入。
+// 只在请求开始时检查，已开始的对话（含工具调用的多轮生成）不会被中途打断
+func (h *Host) checkTokenQuota(userID string) error {
+	role, err := h.userRole(userID)
+	if err != nil {
+		return err
+	}
+	quota := dailyTokenQuota(role)
+	if quota <= 0 {
+		return nil
+	}
+	used, err := h.templateRepository.SumUserTokenUsage(h.ctx, userID, startOfDay(time.Now()))
+	if err != nil {
+		return err
+	}
+	if used >= quota {
+		return errno.NewErrNo(errno.BizLimitCode, fmt.Sprintf("今日 token 用量已达上限 %d，请明天再试", quota))
+	}
+	return nil
+}
+
+// withTokenUsage 在 ctx 上记录模型调用的 token 用量，conversationID 为空表示非对话场景；
+// 写库失败只记日志，不影响本次调用
+func (h *Host) withTokenUsage(ctx context.Context, userID, conversationID string) context.Context {
+	return ai_provider.WithUsageRecorder(ctx, func(u ai_provider.Usage) {
+		h.recordTokenUsage(ctx, userID, conversationID, u)
+	})
+}
+
+// recordToolTokenUsage 记录 MCP 工具内部调用模型的用量（如 stem 组的页面生成），与对话本身的用量一样计入配额
+func (h *Host) recordToolTokenUsage(ctx context.Context, userID, conversationID string, res *mcp_client.ToolResult) {
+	for _, u := range res.Usage {
+		h.recordTokenUsage(ctx, userID, conversationID, u)
+	}
+}
+
+func (h *Host) recordTokenUsage(ctx context.Context, userID, conversationID string, u ai_provider.Usage) {
+	usage := &model.TokenUsages{
+		UserID:           userID,
+		Provider:         u.Provider,
+		Model:            u.Model,
+		PromptTokens:     int32(u.PromptTokens),
+		CompletionTokens: int32(u.CompletionTokens),
+		TotalTokens:      int32(u.TotalTokens),
+	}
+	if conversationID != "" {
+		usage.ConversationID = &conversationID
+	}
+	if err := h.templateRepository.CreateTokenUsage(context.WithoutCancel(ctx), usage); err != nil {
+		logger.Errorf("failed to record token usage, userID=%s, conversationID=%s, usage=%+v, err=%v", userID, conversationID, u, err)
+	}
+}
+
+// GetUserUsageLogic 获取用户今日用量、配额以及最近 days 天按日、模型、对话的聚合
+func (h *Host) GetUserUsageLogic(userID string, days *int64) (*api.GetUserUsageResponse, error) {
+	n := int64(constant.UsageDefaultDays)
+	if days != nil {
+		n = *days
+	}
+	if n < 1 || n > constant.UsageMaxDays {
+		return nil, errno.NewErrNo(errno.ParamInvalidCode, fmt.Sprintf("days 需在 1 到 %d 之间", constant.UsageMaxDays))
+	}
+
+	role, err := h.userRole(userID)
+	if err != nil {
+		return nil, err
+	}
+	today := startOfDay(time.Now())
+	since := today.AddDate(0, 0, -int(n-1))
+
+	todayTokens, err := h.templateRepository.SumUserTokenUsage(h.ctx, userID, today)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := h.templateRepository.ListUserTokenUsageByDay(h.ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	models, err := h.templateRepository.ListUserTokenUsageByModel(h.ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	conversations, err := h.templateRepository.ListUserTokenUsageByConversation(h.ctx, userID, since, constant.UsageTopConversationNum)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := dailyTokenQuota(role)
+	remaining := int64(-1)
+	if quota > 0 {
+		remaining = max(quota-todayTokens, 0)
+	}
+	resp := &api.GetUserUsageResponse{
+		Role:            role,
+		DailyQuota:      quota,
+		TodayTokens:     todayTokens,
+		RemainingTokens: remaining,
+		Daily:           usageItems(daily, func(s *repository.TokenUsageSum) string { return s.Day.Format(time.DateOnly) }),
+		Models:          usageItems(models, func(s *repository.TokenUsageSum) string { return s.Model }),
+		Conversations:   usageItems(conversations, func(s *repository.TokenUsageSum) string { return s.ConversationID }),
+	}
+	for _, d := range daily {
+		resp.TotalTokens += d.TotalTokens
+	}
+	return resp, nil
+}
+
+func usageItems(sums []*repository.TokenUsageSum, key func(*repository.TokenUsageSum) string) []*api.UsageItem {
+	items := make([]*api.UsageItem, 0, len(sums))
+	for _, s := range sums {
+		items = append(items, &api.UsageItem{
+			Key:              key(s),
+			PromptTokens:     s.PromptTokens,
+			CompletionTokens: s.CompletionTokens,
+			TotalTokens:      s.TotalTokens,
+		})
+	}
+	return items
+}
@@ -70,11 +70,14 @@ func (h *Host) UpdateUserSetting(userID string, settingJSON string) error {
 	if userID == "" {
 		return errno.ParamError
 	}
-	// 验证 JSON 格式
+	// 验证 JSON 格式，tools 段需符合工具偏好的结构
 	var temp map[string]interface{}
 	if err := json.Unmarshal([]byte(settingJSON), &temp); err != nil {
 		return errno.NewErrNo(50001, "invalid JSON format")
 	}
+	if _, err := parseUserToolSetting(settingJSON); err != nil {
+		return err
+	}
 
 	return h.templateRepository.UpdateUserSetting(h.ctx, userID, settingJSON)
 }
@@ -0,0 +1,192 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/api"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// userMCPServerNamePattern 服务名会作为工具名前缀，需满足 OpenAI function name 的字符限制
+var userMCPServerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// userMCPCredentials 用户 MCP 服务的凭据，整体加密后存入 user_mcp_servers.credentials
+type userMCPCredentials struct {
+	Token   string            `json:"token,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// requestHeaders 合并为实际请求头，token 以 Authorization: Bearer 形式附带并覆盖同名请求头
+func (c *userMCPCredentials) requestHeaders() map[string]string {
+	headers := make(map[string]string, len(c.Headers)+1)
+	for k, v := range c.Headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	if c.Token != "" {
+		headers["Authorization"] = "Bearer " + c.Token
+	}
+	return headers
+}
+
+func isPrivateHost(host string) bool {
+	host = strings.ToLower(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && utils.IsPrivateIP(ip)
+}
+
+// CreateUserMCPServerLogic 添加用户自定义 MCP 服务
+func (h *Host) CreateUserMCPServerLogic(req *api.CreateUserMCPServerRequest, userID string) (string, error) {
+	if !userMCPServerNamePattern.MatchString(req.Name) {
+		return "", errno.NewErrNo(errno.ParamInvalidCode, "服务名称只能包含字母、数字、下划线和中划线，且不超过32个字符")
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errno.NewErrNo(errno.ParamFormatCode, "服务地址必须是合法的 http/https URL")
+	}
+	// 提前拒绝明显指向内网的地址；域名解析到内网的情况由连接池在建立连接时拦截
+	if !config.MCP.User.AllowPrivate && isPrivateHost(u.Hostname()) {
+		return "", errno.NewErrNo(errno.ParamInvalidCode, "服务地址不能指向内网或本机")
+	}
+
+	limit := constant.UserMCPMaxServersPerUser
+	if h.userMCPPool != nil {
+		limit = h.userMCPPool.MaxServersPerUser()
+	}
+	count, err := h.templateRepository.CountUserMCPServers(h.ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if count >= int64(limit) {
+		return "", errno.NewErrNo(errno.BizLimitCode, "MCP服务数量已达上限")
+	}
+
+	creds, err := json.Marshal(&userMCPCredentials{Token: req.GetToken(), Headers: req.GetHeaders()})
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := utils.EncryptSecret(creds)
+	if err != nil {
+		return "", err
+	}
+
+	server := &model.UserMcpServers{
+		UserID:      userID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Credentials: encrypted,
+		Enabled:     1,
+	}
+	if err := h.templateRepository.CreateUserMCPServer(h.ctx, server); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return "", errno.NewErrNo(errno.BizLogicCode, "服务名称已存在")
+		}
+		return "", err
+	}
+	return server.ID, nil
+}
+
+// ListUserMCPServerLogic 获取用户的 MCP 服务列表，凭据只返回是否配置，不回传明文
+func (h *Host) ListUserMCPServerLogic(userID string) ([]*api.UserMCPServerItem, error) {
+	servers, err := h.templateRepository.ListUserMCPServers(h.ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*api.UserMCPServerItem, 0, len(servers))
+	for _, s := range servers {
+		item := &api.UserMCPServerItem{
+			ID:         s.ID,
+			Name:       s.Name,
+			URL:        s.URL,
+			HeaderKeys: []string{},
+			Enabled:    s.Enabled == 1,
+			CreatedAt:  s.CreatedAt.UnixMilli(),
+		}
+		if creds, err := decryptUserMCPCredentials(s.Credentials); err == nil {
+			item.HasToken = creds.Token != ""
+			for k := range creds.Headers {
+				item.HeaderKeys = append(item.HeaderKeys, k)
+			}
+			sort.Strings(item.HeaderKeys)
+		} else {
+			logger.Errorf("user mcp: decrypt credentials of %s failed: %v", s.ID, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// DeleteUserMCPServerLogic 删除用户 MCP 服务，并断开该用户已建立的连接
+func (h *Host) DeleteUserMCPServerLogic(id string, userID string) error {
+	if err := h.templateRepository.DeleteUserMCPServer(h.ctx, id, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.NewErrNo(errno.BizNotExist, "MCP服务不存在")
+		}
+		return err
+	}
+	if h.userMCPPool != nil {
+		h.userMCPPool.Evict(userID)
+	}
+	return nil
+}
+
+// toolClientFor 返回用户可用的工具集合：全局 MCP 工具 + 用户自定义 MCP 服务的工具，
+// disabledServers 为用户在设置中禁用的服务名。
+// 用户服务读取或连接失败时退化为仅全局工具，不影响对话。对话结束后调用 release 归还用户连接
+func (h *Host) toolClientFor(userID string, disabledServers []string) (cli mcp_client.ToolClient, release func()) {
+	if h.userMCPPool == nil || userID == "" {
+		return h.mcpCli, func() {}
+	}
+	servers, err := h.templateRepository.ListUserMCPServers(h.ctx, userID)
+	if err != nil {
+		logger.Errorf("user mcp: list servers of user %s failed: %v", userID, err)
+		return h.mcpCli, func() {}
+	}
+	list := make([]mcp_client.UserServer, 0, len(servers))
+	for _, s := range servers {
+		if s.Enabled != 1 || slices.Contains(disabledServers, s.Name) {
+			continue
+		}
+		creds, err := decryptUserMCPCredentials(s.Credentials)
+		if err != nil {
+			logger.Errorf("user mcp: decrypt credentials of %s failed: %v", s.ID, err)
+			continue
+		}
+		list = append(list, mcp_client.UserServer{
+			ID:      s.ID,
+			Name:    s.Name,
+			URL:     s.URL,
+			Headers: creds.requestHeaders(),
+		})
+	}
+	return h.userMCPPool.Acquire(h.mcpCli, userID, list)
+}
+
+func decryptUserMCPCredentials(encrypted string) (*userMCPCredentials, error) {
+	plaintext, err := utils.DecryptSecret(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	var creds userMCPCredentials
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
@@ -1,205 +0,0 @@
-package infra
-
-import (
-	"bytes"
-	"context"
-	"fmt"
-	"os"
-	"path/filepath"
-	"text/template"
-
-	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
-)
-
-const (
-	// SummarizePrompt summarize 提示词模板
-	SummarizePrompt = `你是一个专业的对话总结助手。请仔细分析以下对话历史，生成一个结构化的总结。
-
-## 本对话的现有总结
-
-{{.existing_summary}}
-
-## 任务要求
-
-1. **更新现有总结**：
-   - 如果上面显示本对话已有总结，你需要基于新的对话内容更新它
-   - 摘要应该综合考虑之前的总结和本次新增的对话内容
-   - 如果本对话暂无总结，则创建一个新的完整总结
-
-2. **文本摘要**：用简洁的中文总结对话的核心内容和主要讨论点（200-300字）
-   - 如果是更新，摘要应该综合之前的内容和新增内容
-   - 如果是新建，摘要应该完整覆盖对话的核心主题
-   
-3. **标签提取**：根据对话主题，提取2-5个标签，如：["技术讨论", "问题解决", "代码审查", "文件操作", "API设计"]等
-
-4. **工具调用信息**：如果对话中涉及工具调用（MCP工具），提取所有工具调用的详细信息，包括：
-   - 工具名称（tool name）
-   - 调用参数（arguments）
-   - 调用目的或结果（简要说明）
-   
-5. **关键文件路径**：如果对话涉及文件操作（读取、写入、创建、删除文件等），提取所有相关的文件路径
-
-## 输出格式
-
-严格输出 JSON，字段：summary、tags、tool_calls、notes。
-特别说明：
-- notes 必须是一个 JSON 对象（例如 {} 或 {"key":"value"}），不能返回字符串、数组或其它 stringified JSON
-
-{
-  "summary": "对话的核心摘要内容，200-300字",
-  "tags": ["标签1", "标签2", "标签3"],
-  "tool_calls": [
-    {
-      "tool": "工具名称",
-      "args": {
-        "参数名": "参数值"
-      },
-      "description": "工具调用的简要说明"
-    }
-  ],
-  "notes": {
-    "todo": "后续需要接入 DB",
-    "file": "internal/host/infra/prompts/summarize.txt"
-  }
-}
-
-示例：
-{
-  "summary": "用户询问如何实现对话总结功能，讨论了数据模型设计、API接口实现等内容...",
-  "tags": ["AI", "总结", "知识库"],
-  "tool_calls": [],
-  "notes": {}
-}
-
-如果没有笔记，请返回空对象 "notes": {}。
-
-## 注意事项
-
-- 如果对话中没有工具调用，` + "`tool_calls`" + ` 应为空数组 ` + "`[]`" + `
-- 如果对话中没有涉及文件操作，` + "`file_paths`" + ` 应为空数组 ` + "`[]`" + `
-- 标签应该准确反映对话的主题和类型
-- 文件路径应该包含完整的相对路径或绝对路径
-- 工具调用的 ` + "`args`" + ` 应该包含完整的参数信息（JSON对象格式）
-- 确保输出的JSON格式完全正确，可以被直接解析
-
-## 对话历史
-
-{{.conversation_history}}
-
----
-
-请开始分析并生成总结（只输出JSON，不要其他内容）：`
-)
-
-// PromptRepository 负责加载和渲染提示词模板（如 summarize.txt）
-type PromptRepository struct {
-	baseDir string // 模板根目录，例如 "internal/host/infra"
-}
-
-// NewPromptRepository 创建提示词仓库
-func NewPromptRepository(baseDir string) *PromptRepository {
-	return &PromptRepository{baseDir: baseDir}
-}
-
-// Render 渲染指定模板文件（相对 baseDir），使用 data 作为模板变量
-func (r *PromptRepository) Render(ctx context.Context, tmplPath string, data any) (string, error) {
-	if tmplPath == "" {
-		return "", fmt.Errorf("template path is required")
-	}
-
-	// 支持 ctx 预留扩展（例如后面做超时/日志等）
-	select {
-	case <-ctx.Done():
-		return "", ctx.Err()
-	default:
-	}
-
-	path := tmplPath
-	if r.baseDir != "" && !filepath.IsAbs(tmplPath) {
-		path = filepath.Join(r.baseDir, tmplPath)
-	}
-
-	content, err := os.ReadFile(path)
-	if err != nil {
-		return "", fmt.Errorf("read template %s: %w", path, err)
-	}
-
-	tmpl, err := template.New(filepath.Base(path)).
-		Option("missingkey=error").
-		Parse(string(content))
-	if err != nil {
-		return "", fmt.Errorf("parse template %s: %w", path, err)
-	}
-
-	if data == nil {
-		data = struct{}{}
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("execute template %s: %w", path, err)
-	}
-
-	return buf.String(), nil
-}
-
-// 也可以加一个针对 summarize 的封装，方便上层调用
-const SummarizePromptPath = "prompts/summarize.txt"
-
-func (r *PromptRepository) RenderSummarizePrompt(ctx context.Context, data any) (string, error) {
-	return r.Render(ctx, SummarizePromptPath, data)
-}
-
-// LoadPrompt 根据名称返回对应的 prompt 模板
-// name 参数对应模板名称，如 "summarize"
-func LoadPrompt(name string) (string, error) {
-	switch name {
-	case "summarize":
-		return SummarizePrompt, nil
-	default:
-		return "", fmt.Errorf("unknown prompt name: %s", name)
-	}
-}
-
-// RenderPrompt 使用 text/template 渲染模板，替换变量占位符
-// tpl 是模板文本，data 是用于填充模板的数据（可以是 map、struct 等）
-func RenderPrompt(tpl string, data any) (string, error) {
-	if tpl == "" {
-		return "", fmt.Errorf("template is empty")
-	}
-
-	// 创建模板并设置选项：缺失变量时报错
-	tmpl, err := template.New("prompt").
-		Option("missingkey=error").
-		Parse(tpl)
-	if err != nil {
-		logger.Errorf("failed to parse prompt template: %v", err)
-		return "", fmt.Errorf("parse template: %w", err)
-	}
-
-	// 如果 data 为 nil，使用空结构体
-	if data == nil {
-		data = struct{}{}
-	}
-
-	// 执行模板渲染
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		logger.Errorf("failed to render prompt template: %v", err)
-		return "", fmt.Errorf("render template: %w", err)
-	}
-
-	result := buf.String()
-	logger.Infof("rendered prompt template successfully, length: %d", len(result))
-	return result, nil
-}
-
-// LoadAndRenderPrompt 组合操作：加载并渲染提示词模板
-// 这是一个便捷函数，将 LoadPrompt 和 RenderPrompt 组合在一起
-func LoadAndRenderPrompt(name string, data any) (string, error) {
-	tpl, err := LoadPrompt(name)
-	if err != nil {
-		return "", err
-	}
-	return RenderPrompt(tpl, data)
-}
@@ -2,9 +2,11 @@ package infra
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
@@ -96,6 +98,93 @@ func (r *TemplateRepository) SetDailyScheduleCache(ctx context.Context, key stri
 	return nil
 }
 
+func (r *TemplateRepository) PublishResourceUpdated(ctx context.Context, uris ...string) error {
+	for _, uri := range uris {
+		if err := r.cache.Publish(ctx, constant.MCPResourceUpdatedChannel, uri).Err(); err != nil {
+			return fmt.Errorf("dal.PublishResourceUpdated: publish %s failed: %w", uri, err)
+		}
+	}
+	return nil
+}
+
+func (r *TemplateRepository) CreateToolApproval(ctx context.Context, approval *repository.ToolApproval) error {
+	data, err := sonic.Marshal(approval)
+	if err != nil {
+		return fmt.Errorf("dal.CreateToolApproval: Marshal failed: %w", err)
+	}
+	if err := r.cache.Set(ctx, constant.ToolApprovalKeyPrefix+approval.ID, data, time.Until(approval.ExpiresAt)).Err(); err != nil {
+		return fmt.Errorf("dal.CreateToolApproval: Set key failed: %w", err)
+	}
+	return nil
+}
+
+func (r *TemplateRepository) GetToolApproval(ctx context.Context, id string) (*repository.ToolApproval, error) {
+	data, err := r.cache.Get(ctx, constant.ToolApprovalKeyPrefix+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dal.GetToolApproval: cache failed: %w", err)
+	}
+	approval := new(repository.ToolApproval)
+	if err := sonic.Unmarshal(data, approval); err != nil {
+		return nil, fmt.Errorf("dal.GetToolApproval: Unmarshal failed: %w", err)
+	}
+	return approval, nil
+}
+
+func (r *TemplateRepository) DecideToolApproval(ctx context.Context, id string, decision *repository.ToolApprovalDecision) (bool, error) {
+	data, err := sonic.Marshal(decision)
+	if err != nil {
+		return false, fmt.Errorf("dal.DecideToolApproval: Marshal failed: %w", err)
+	}
+	// 结果 key 与审批本身同时过期；SetNX 保证用户确认与超时只有一方生效
+	ttl := r.cache.PTTL(ctx, constant.ToolApprovalKeyPrefix+id).Val()
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	ok, err := r.cache.SetNX(ctx, constant.ToolApprovalKeyPrefix+id+constant.ToolApprovalDecisionSuffix, data, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("dal.DecideToolApproval: SetNX failed: %w", err)
+	}
+	if !ok {
+		return false, nil
+	}
+	if err := r.cache.Publish(ctx, constant.ToolApprovalChannelPrefix+id, data).Err(); err != nil {
+		return true, fmt.Errorf("dal.DecideToolApproval: publish failed: %w", err)
+	}
+	return true, nil
+}
+
+func (r *TemplateRepository) WaitToolApprovalDecision(ctx context.Context, id string) (*repository.ToolApprovalDecision, error) {
+	// 先订阅再读结果 key，避免错过订阅前写入的结果
+	sub := r.cache.Subscribe(ctx, constant.ToolApprovalChannelPrefix+id)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return nil, fmt.Errorf("dal.WaitToolApprovalDecision: subscribe failed: %w", err)
+	}
+	data, err := r.cache.Get(ctx, constant.ToolApprovalKeyPrefix+id+constant.ToolApprovalDecisionSuffix).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("dal.WaitToolApprovalDecision: cache failed: %w", err)
+	}
+	if err != nil {
+		select {
+		case msg, ok := <-sub.Channel():
+			if !ok {
+				return nil, errors.New("dal.WaitToolApprovalDecision: subscription closed")
+			}
+			data = []byte(msg.Payload)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	decision := new(repository.ToolApprovalDecision)
+	if err := sonic.Unmarshal(data, decision); err != nil {
+		return nil, fmt.Errorf("dal.WaitToolApprovalDecision: Unmarshal failed: %w", err)
+	}
+	return decision, nil
+}
+
 func NewTemplateRepository(db *db.DB[*query.Query], cache *redis.Client) *TemplateRepository {
 	return &TemplateRepository{db: db, cache: cache}
 }
@@ -107,6 +196,7 @@ func (r *TemplateRepository) CreateUserByIDAndName(ctx context.Context, id strin
 		ID:          id,
 		Name:        name,
 		SettingJSON: &defaultSetting,
+		Role:        constant.UserRoleDefault,
 	}
 	// 由于user是指针类型，Create方法会自动填充user的其他字段（如时间戳）
 	err := d.WithContext(ctx).Users.Create(user)
@@ -138,6 +228,7 @@ func (r *TemplateRepository) UpsertConversation(
 	userID string,
 	conversationID string,
 	openaiMessages []openai.ChatCompletionMessageParamUnion,
+	chatOptions string,
 ) error {
 	d := r.db.Get(ctx)
 
@@ -163,12 +254,19 @@ func (r *TemplateRepository) UpsertConversation(
 				Messages:     string(newBytes), // 直接保存为 JSON 数组
 				IsSummarized: 0,
 			}
+			if chatOptions != "" {
+				newConv.ChatOptions = &chatOptions
+			}
 			return q.Conversations.Create(newConv)
 		}
 		// 其他错误直接返回
 		return err
 	}
 
+	if chatOptions != "" {
+		conv.ChatOptions = &chatOptions
+	}
+
 	// 已存在 => 需要把 messages 做 append
 	// 假设 messages 字段里始终是 JSON 数组
 	var existingMsgs []json.RawMessage
@@ -472,3 +570,108 @@ func (r *TemplateRepository) DeleteConversation(ctx context.Context, id string)
 		Delete()
 	return err
 }
+
+// CreateUserMCPServer 创建用户自定义MCP服务
+func (r *TemplateRepository) CreateUserMCPServer(ctx context.Context, server *model.UserMcpServers) error {
+	d := r.db.Get(ctx)
+	return d.WithContext(ctx).UserMcpServers.Create(server)
+}
+
+// ListUserMCPServers 获取用户的所有自定义MCP服务
+func (r *TemplateRepository) ListUserMCPServers(ctx context.Context, userID string) ([]*model.UserMcpServers, error) {
+	d := r.db.Get(ctx)
+	return d.WithContext(ctx).UserMcpServers.
+		Where(d.UserMcpServers.UserID.Eq(userID)).
+		Order(d.UserMcpServers.CreatedAt).
+		Find()
+}
+
+// CountUserMCPServers 统计用户的自定义MCP服务数量
+func (r *TemplateRepository) CountUserMCPServers(ctx context.Context, userID string) (int64, error) {
+	d := r.db.Get(ctx)
+	return d.WithContext(ctx).UserMcpServers.
+		Where(d.UserMcpServers.UserID.Eq(userID)).
+		Count()
+}
+
+// DeleteUserMCPServer 删除用户自定义MCP服务
+func (r *TemplateRepository) DeleteUserMCPServer(ctx context.Context, id string, userID string) error {
+	d := r.db.Get(ctx)
+	info, err := d.WithContext(ctx).UserMcpServers.
+		Where(d.UserMcpServers.ID.Eq(id)).
+		Where(d.UserMcpServers.UserID.Eq(userID)).
+		Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CreateTokenUsage 记录一次模型调用的 token 用量
+func (r *TemplateRepository) CreateTokenUsage(ctx context.Context, usage *model.TokenUsages) error {
+	d := r.db.Get(ctx)
+	return d.WithContext(ctx).TokenUsages.Create(usage)
+}
+
+// SumUserTokenUsage 统计用户自 since 起的 token 总用量
+func (r *TemplateRepository) SumUserTokenUsage(ctx context.Context, userID string, since time.Time) (int64, error) {
+	d := r.db.Get(ctx)
+	var total sql.NullInt64
+	err := d.WithContext(ctx).TokenUsages.
+		Select(d.TokenUsages.TotalTokens.Sum()).
+		Where(d.TokenUsages.UserID.Eq(userID), d.TokenUsages.CreatedAt.Gte(since)).
+		Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+	return total.Int64, nil
+}
+
+// ListUserTokenUsageByDay 按日聚合用户自 since 起的 token 用量，按日期升序
+func (r *TemplateRepository) ListUserTokenUsageByDay(ctx context.Context, userID string, since time.Time) ([]*repository.TokenUsageSum, error) {
+	d := r.db.Get(ctx)
+	day := d.TokenUsages.CreatedAt.Date()
+	var sums []*repository.TokenUsageSum
+	err := d.WithContext(ctx).TokenUsages.
+		Select(day.As("day"), d.TokenUsages.PromptTokens.Sum().As("prompt_tokens"),
+			d.TokenUsages.CompletionTokens.Sum().As("completion_tokens"), d.TokenUsages.TotalTokens.Sum().As("total_tokens")).
+		Where(d.TokenUsages.UserID.Eq(userID), d.TokenUsages.CreatedAt.Gte(since)).
+		Group(day).
+		Order(day).
+		Scan(&sums)
+	return sums, err
+}
+
+// ListUserTokenUsageByModel 按模型聚合用户自 since 起的 token 用量，按总用量降序
+func (r *TemplateRepository) ListUserTokenUsageByModel(ctx context.Context, userID string, since time.Time) ([]*repository.TokenUsageSum, error) {
+	d := r.db.Get(ctx)
+	total := d.TokenUsages.TotalTokens.Sum()
+	var sums []*repository.TokenUsageSum
+	err := d.WithContext(ctx).TokenUsages.
+		Select(d.TokenUsages.Model, d.TokenUsages.PromptTokens.Sum().As("prompt_tokens"),
+			d.TokenUsages.CompletionTokens.Sum().As("completion_tokens"), total.As("total_tokens")).
+		Where(d.TokenUsages.UserID.Eq(userID), d.TokenUsages.CreatedAt.Gte(since)).
+		Group(d.TokenUsages.Model).
+		Order(total.Desc()).
+		Scan(&sums)
+	return sums, err
+}
+
+// ListUserTokenUsageByConversation 按对话聚合用户自 since 起的 token 用量，按总用量降序取前 limit 个，不含非对话场景的用量
+func (r *TemplateRepository) ListUserTokenUsageByConversation(ctx context.Context, userID string, since time.Time, limit int) ([]*repository.TokenUsageSum, error) {
+	d := r.db.Get(ctx)
+	total := d.TokenUsages.TotalTokens.Sum()
+	var sums []*repository.TokenUsageSum
+	err := d.WithContext(ctx).TokenUsages.
+		Select(d.TokenUsages.ConversationID, d.TokenUsages.PromptTokens.Sum().As("prompt_tokens"),
+			d.TokenUsages.CompletionTokens.Sum().As("completion_tokens"), total.As("total_tokens")).
+		Where(d.TokenUsages.UserID.Eq(userID), d.TokenUsages.CreatedAt.Gte(since), d.TokenUsages.ConversationID.IsNotNull()).
+		Group(d.TokenUsages.ConversationID).
+		Order(total.Desc()).
+		Limit(limit).
+		Scan(&sums)
+	return sums, err
+}
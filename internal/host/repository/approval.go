@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToolApproval 等待用户确认的高风险工具调用，存于 Redis，过期后不可再审批
+type ToolApproval struct {
+	ID             string               `json:"id"`
+	UserID         string               `json:"user_id"`
+	ConversationID string               `json:"conversation_id"`
+	ToolCallID     string               `json:"tool_call_id"`
+	Name           string               `json:"name"`
+	Args           map[string]any       `json:"args"`
+	Schema         *mcp.ToolInputSchema `json:"schema,omitempty"` // 工具参数 schema，用于校验用户修改后的参数
+	Risk           string               `json:"risk"`
+	ExpiresAt      time.Time            `json:"expires_at"`
+}
+
+// ToolApprovalDecision 审批结果，Decision 取值见 constant.ToolApproval*
+type ToolApprovalDecision struct {
+	Decision string         `json:"decision"`
+	Args     map[string]any `json:"args,omitempty"`   // edit 时修改后的参数
+	Reason   string         `json:"reason,omitempty"` // deny 时告知模型的原因
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/west2-online/jwch"
 
@@ -21,8 +22,8 @@ type TemplateRepository interface {
 	GetUserByID(ctx context.Context, id string) (*model.Users, error)
 	// UpdateUserSetting 更新用户设置JSON
 	UpdateUserSetting(ctx context.Context, userID string, settingJSON string) error
-	// UpsertConversation 插入或更新对话记录
-	UpsertConversation(ctx context.Context, userID string, conversationID string, openaiMessages []openai.ChatCompletionMessageParamUnion) error
+	// UpsertConversation 插入或更新对话记录，chatOptions 非空时一并更新对话生成参数
+	UpsertConversation(ctx context.Context, userID string, conversationID string, openaiMessages []openai.ChatCompletionMessageParamUnion, chatOptions string) error
 	// GetConversationByID 通过ID获取对话记录
 	GetConversationByID(ctx context.Context, id string) (*model.Conversations, error)
 	// ListConversationsByUserID 获取用户的所有对话列表
@@ -62,6 +63,26 @@ type TemplateRepository interface {
 	// DeleteSummary 删除摘要
 	DeleteSummary(ctx context.Context, id string) error
 
+	// CreateUserMCPServer 创建用户自定义MCP服务
+	CreateUserMCPServer(ctx context.Context, server *model.UserMcpServers) error
+	// ListUserMCPServers 获取用户的所有自定义MCP服务
+	ListUserMCPServers(ctx context.Context, userID string) ([]*model.UserMcpServers, error)
+	// CountUserMCPServers 统计用户的自定义MCP服务数量
+	CountUserMCPServers(ctx context.Context, userID string) (int64, error)
+	// DeleteUserMCPServer 删除用户自定义MCP服务
+	DeleteUserMCPServer(ctx context.Context, id string, userID string) error
+
+	// CreateTokenUsage 记录一次模型调用的 token 用量
+	CreateTokenUsage(ctx context.Context, usage *model.TokenUsages) error
+	// SumUserTokenUsage 统计用户自 since 起的 token 总用量
+	SumUserTokenUsage(ctx context.Context, userID string, since time.Time) (int64, error)
+	// ListUserTokenUsageByDay 按日聚合用户自 since 起的 token 用量，按日期升序
+	ListUserTokenUsageByDay(ctx context.Context, userID string, since time.Time) ([]*TokenUsageSum, error)
+	// ListUserTokenUsageByModel 按模型聚合用户自 since 起的 token 用量，按总用量降序
+	ListUserTokenUsageByModel(ctx context.Context, userID string, since time.Time) ([]*TokenUsageSum, error)
+	// ListUserTokenUsageByConversation 按对话聚合用户自 since 起的 token 用量，按总用量降序取前 limit 个
+	ListUserTokenUsageByConversation(ctx context.Context, userID string, since time.Time, limit int) ([]*TokenUsageSum, error)
+
 	/*
 		redis related methods
 	*/
@@ -79,4 +100,14 @@ type TemplateRepository interface {
 	GetDailyScheduleCache(ctx context.Context, key string) (string, error)
 	// SetDailyScheduleCache 设置每日日程缓存
 	SetDailyScheduleCache(ctx context.Context, key string, schedule string) error
+	// PublishResourceUpdated 广播 MCP 资源变更，MCP server 据此通知订阅了这些资源的会话
+	PublishResourceUpdated(ctx context.Context, uris ...string) error
+	// CreateToolApproval 保存待确认的工具调用，ExpiresAt 后自动过期
+	CreateToolApproval(ctx context.Context, approval *ToolApproval) error
+	// GetToolApproval 获取待确认的工具调用，不存在或已过期时返回 nil
+	GetToolApproval(ctx context.Context, id string) (*ToolApproval, error)
+	// DecideToolApproval 写入审批结果并通知等待方，已有结果时返回 false
+	DecideToolApproval(ctx context.Context, id string, decision *ToolApprovalDecision) (bool, error)
+	// WaitToolApprovalDecision 阻塞等待审批结果，直到 ctx 结束
+	WaitToolApprovalDecision(ctx context.Context, id string) (*ToolApprovalDecision, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import "time"
+
+// TokenUsageSum 按日、模型或对话聚合的 token 用量，Day/Model/ConversationID 只有分组所用的一项有值
+type TokenUsageSum struct {
+	Day              time.Time
+	Model            string
+	ConversationID   string
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
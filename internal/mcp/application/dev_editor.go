@@ -0,0 +1,475 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/workspace"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// 写文件的模式
+const (
+	fsWriteOverwrite = "overwrite"
+	fsWriteAppend    = "append"
+	fsWriteCreate    = "create"
+)
+
+// searchSkipDirs fs_search 不进入的目录
+var searchSkipDirs = map[string]struct{}{".git": {}, "node_modules": {}, "vendor": {}, ".idea": {}, ".venv": {}}
+
+// registerEditorTools 注册文件编辑相关工具：fs_write、fs_apply_patch、fs_search、fs_stat、fs_undo。
+// 与 fs_tree/fs_cat 共享同一个 jail，修改按对话记录在撤销日志中
+func (d *devRunner) registerEditorTools(toolSet *tool_set.ToolSet) {
+	toolWrite := mcp.NewTool("fs_write",
+		mcp.WithDescription("Write a text file inside the workspace. Returns the resulting unified diff; "+
+			"with dry_run=true nothing is written. Every write can be reverted with fs_undo."),
+		mcp.WithString("path", mcp.Required(), mcp.Description("File path, absolute or relative to the first workspace root")),
+		mcp.WithString("content", mcp.Required(), mcp.Description("Full text content to write")),
+		mcp.WithString("mode", mcp.Enum(fsWriteOverwrite, fsWriteAppend, fsWriteCreate),
+			mcp.Description("overwrite (default) | append | create (fail if the file exists)")),
+		mcp.WithBoolean("dry_run", mcp.Description("Only return the diff without writing")),
+	)
+	toolSet.Tools = append(toolSet.Tools, &toolWrite)
+	toolSet.HandlerFunc[toolWrite.Name] = d.HandleFsWrite
+
+	toolPatch := mcp.NewTool("fs_apply_patch",
+		mcp.WithDescription("Apply a unified diff (as produced by `diff -u` or `git diff`) to files inside the workspace. "+
+			"All files are patched or none. Use /dev/null as the old/new path to create/delete a file."),
+		mcp.WithString("patch", mcp.Required(), mcp.Description("Unified diff text with ---/+++ headers and @@ hunks")),
+		mcp.WithBoolean("dry_run", mcp.Description("Only check that the patch applies and return the resulting diff")),
+	)
+	toolSet.Tools = append(toolSet.Tools, &toolPatch)
+	toolSet.HandlerFunc[toolPatch.Name] = d.HandleFsApplyPatch
+
+	toolSearch := mcp.NewTool("fs_search",
+		mcp.WithDescription("Search text files in the workspace with a regular expression (RE2 syntax), grep style output with context lines."),
+		mcp.WithString("pattern", mcp.Required(), mcp.Description("Regular expression to search for")),
+		mcp.WithString("path", mcp.Description("Directory or file to search (default: first workspace root)")),
+		mcp.WithString("glob", mcp.Description("Only search files whose name matches this glob, e.g. *.go")),
+		mcp.WithNumber("context", mcp.Description("Context lines before and after each match (default 2, max 10)")),
+		mcp.WithNumber("max_results", mcp.Description("Max matches to return (default 100)")),
+		mcp.WithBoolean("ignore_case", mcp.Description("Case insensitive search")),
+	)
+	toolSet.Tools = append(toolSet.Tools, &toolSearch)
+	toolSet.HandlerFunc[toolSearch.Name] = d.HandleFsSearch
+
+	toolStat := mcp.NewTool("fs_stat",
+		mcp.WithDescription("Show metadata of a file or directory in the workspace: type, size, mode, modification time, line count."),
+		mcp.WithString("path", mcp.Required(), mcp.Description("File or directory path")),
+	)
+	toolSet.Tools = append(toolSet.Tools, &toolStat)
+	toolSet.HandlerFunc[toolStat.Name] = d.HandleFsStat
+
+	toolUndo := mcp.NewTool("fs_undo",
+		mcp.WithDescription("Revert the most recent file edits (fs_write / fs_apply_patch) made in this conversation, or list them."),
+		mcp.WithNumber("steps", mcp.Description("Number of edits to revert (default 1)")),
+		mcp.WithBoolean("list", mcp.Description("Only list revertible edits")),
+	)
+	toolSet.Tools = append(toolSet.Tools, &toolUndo)
+	toolSet.HandlerFunc[toolUndo.Name] = d.HandleFsUndo
+}
+
+func (d *devRunner) HandleFsWrite(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p := req.GetString("path", "")
+	content, err := req.RequireString("content")
+	if p == "" || err != nil {
+		return mcp.NewToolResultError("missing required arg: path/content"), nil
+	}
+	mode := req.GetString("mode", fsWriteOverwrite)
+	dryRun := req.GetBool("dry_run", false)
+
+	abs, err := d.jail.ResolveForWrite(p)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	snap, err := workspace.TakeSnapshot(abs)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if snap.Existed {
+		if mode == fsWriteCreate {
+			return mcp.NewToolResultError("file already exists: " + abs), nil
+		}
+		if bytes.IndexByte(snap.Content, 0) >= 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%v: %s", workspace.ErrBinaryFile, abs)), nil
+		}
+	}
+	after := content
+	if mode == fsWriteAppend {
+		after = string(snap.Content) + content
+	}
+	diff := workspace.UnifiedDiff(d.jail.Rel(abs), string(snap.Content), after, snap.Existed)
+
+	if dryRun {
+		return mcp.NewToolResultText(editReport("fs_write (dry run)", 0, diff)), nil
+	}
+	if err := workspace.WriteFileAtomic(abs, []byte(after), snap.Mode); err != nil {
+		return mcp.NewToolResultError("write file: " + err.Error()), nil
+	}
+	id := d.undo.Record(conversationOf(ctx, req), "fs_write", []workspace.Snapshot{snap})
+	return mcp.NewToolResultText(editReport("fs_write", id, diff)), nil
+}
+
+// patchedFile fs_apply_patch 中单个文件的预计算结果
+type patchedFile struct {
+	abs   string
+	snap  workspace.Snapshot
+	after string
+	del   bool
+}
+
+func (d *devRunner) HandleFsApplyPatch(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	text, err := req.RequireString("patch")
+	if err != nil {
+		return mcp.NewToolResultError("missing required arg: patch"), nil
+	}
+	dryRun := req.GetBool("dry_run", false)
+	patches, err := workspace.ParsePatch(text)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// 先全部计算，任一文件失败则不写入任何文件
+	var (
+		files []patchedFile
+		diffs strings.Builder
+		seen  = make(map[string]struct{})
+	)
+	for _, fp := range patches {
+		var abs string
+		if fp.IsCreate() {
+			abs, err = d.jail.ResolveForWrite(fp.Path())
+		} else {
+			abs, err = d.jail.Resolve(fp.Path())
+		}
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if _, dup := seen[abs]; dup {
+			return mcp.NewToolResultError("patch touches the same file twice: " + fp.Path()), nil
+		}
+		seen[abs] = struct{}{}
+
+		snap, err := workspace.TakeSnapshot(abs)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if fp.IsCreate() && snap.Existed {
+			return mcp.NewToolResultError("patch creates a file that already exists: " + fp.Path()), nil
+		}
+		after, err := fp.Apply(string(snap.Content))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		files = append(files, patchedFile{abs: abs, snap: snap, after: after, del: fp.IsDelete()})
+		if fp.IsDelete() {
+			diffs.WriteString("deleted: " + d.jail.Rel(abs) + "\n")
+		} else {
+			diffs.WriteString(workspace.UnifiedDiff(d.jail.Rel(abs), string(snap.Content), after, snap.Existed))
+		}
+	}
+
+	if dryRun {
+		return mcp.NewToolResultText(editReport("fs_apply_patch (dry run)", 0, diffs.String())), nil
+	}
+	snaps := make([]workspace.Snapshot, 0, len(files))
+	for _, f := range files {
+		if f.del {
+			err = os.Remove(f.abs)
+		} else {
+			err = workspace.WriteFileAtomic(f.abs, []byte(f.after), f.snap.Mode)
+		}
+		if err != nil {
+			// 回滚已写入的文件
+			for i := len(snaps) - 1; i >= 0; i-- {
+				_ = snaps[i].Restore()
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("apply patch to %s: %v (no file was changed)", d.jail.Rel(f.abs), err)), nil
+		}
+		snaps = append(snaps, f.snap)
+	}
+	id := d.undo.Record(conversationOf(ctx, req), "fs_apply_patch", snaps)
+	return mcp.NewToolResultText(editReport("fs_apply_patch", id, diffs.String())), nil
+}
+
+func (d *devRunner) HandleFsSearch(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	pattern, err := req.RequireString("pattern")
+	if err != nil || pattern == "" {
+		return mcp.NewToolResultError("missing required arg: pattern"), nil
+	}
+	if req.GetBool("ignore_case", false) {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return mcp.NewToolResultError("invalid pattern: " + err.Error()), nil
+	}
+	glob := req.GetString("glob", "")
+	ctxLines := min(max(req.GetInt("context", 2), 0), 10)
+	maxResults := req.GetInt("max_results", constant.WorkspaceSearchMaxMatches)
+	if maxResults <= 0 {
+		maxResults = constant.WorkspaceSearchMaxMatches
+	}
+
+	start := req.GetString("path", "")
+	if start == "" {
+		if len(d.jail.Roots()) == 0 {
+			return mcp.NewToolResultError(workspace.ErrNoRoots.Error()), nil
+		}
+		start = d.jail.Roots()[0]
+	}
+	start, err = d.jail.Resolve(start)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var (
+		out     strings.Builder
+		matches int
+		files   int
+	)
+	errLimit := errors.New("limit reached")
+	walkErr := filepath.WalkDir(start, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if path != start && d.jail.Denied(path) {
+			if e.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if e.IsDir() {
+			if _, skip := searchSkipDirs[e.Name()]; skip && path != start {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// 不跟随符号链接，避免通过链接访问工作区外的文件
+		if !e.Type().IsRegular() {
+			return nil
+		}
+		if glob != "" {
+			if ok, _ := filepath.Match(glob, e.Name()); !ok {
+				return nil
+			}
+		}
+		n, err := searchFile(&out, path, d.jail.Rel(path), re, ctxLines, maxResults-matches)
+		if err != nil || n == 0 {
+			return nil
+		}
+		matches += n
+		files++
+		if matches >= maxResults {
+			return errLimit
+		}
+		return nil
+	})
+	if walkErr != nil && !errors.Is(walkErr, errLimit) {
+		return mcp.NewToolResultError(walkErr.Error()), nil
+	}
+
+	header := fmt.Sprintf("### fs_search: /%s/ in %s (%d matches in %d files", pattern, start, matches, files)
+	if errors.Is(walkErr, errLimit) {
+		header += ", truncated at max_results"
+	}
+	header += ")\n\n"
+	if matches == 0 {
+		return mcp.NewToolResultText(header + "(no matches)"), nil
+	}
+	return mcp.NewToolResultText(header + out.String()), nil
+}
+
+// searchFile 在单个文件中搜索，输出 grep -n 风格（匹配行用 ':'，上下文行用 '-'），返回匹配数
+func searchFile(out *strings.Builder, path, display string, re *regexp.Regexp, ctxLines, limit int) (int, error) {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() > constant.WorkspaceSearchMaxFileBytes {
+		return 0, err
+	}
+	if binary, err := workspace.IsBinary(path); err != nil || binary {
+		return 0, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+
+	matches, lastPrinted := 0, -1
+	for i, line := range lines {
+		if matches >= limit {
+			break
+		}
+		if !re.MatchString(line) {
+			continue
+		}
+		matches++
+		from := max(i-ctxLines, lastPrinted+1)
+		if lastPrinted >= 0 && from > lastPrinted+1 {
+			out.WriteString("--\n")
+		}
+		to := min(i+ctxLines, len(lines)-1)
+		for j := from; j <= to; j++ {
+			sep := "-"
+			if re.MatchString(lines[j]) {
+				sep = ":"
+			}
+			fmt.Fprintf(out, "%s%s%d%s %s\n", display, sep, j+1, sep, lines[j])
+		}
+		lastPrinted = to
+	}
+	if matches > 0 {
+		out.WriteString("\n")
+	}
+	return matches, nil
+}
+
+func (d *devRunner) HandleFsStat(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, err := req.RequireString("path")
+	if err != nil || p == "" {
+		return mcp.NewToolResultError("missing required arg: path"), nil
+	}
+	abs, err := d.jail.Resolve(p)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var buf strings.Builder
+	buf.WriteString("### fs_stat: " + abs + "\n\n")
+	literal := p
+	if !filepath.IsAbs(literal) {
+		literal = filepath.Join(d.jail.Roots()[0], literal)
+	}
+	if li, err := os.Lstat(literal); err == nil && li.Mode()&os.ModeSymlink != 0 {
+		buf.WriteString("symlink_to: " + abs + "\n")
+	}
+	fmt.Fprintf(&buf, "type: %s\n", fileType(info))
+	fmt.Fprintf(&buf, "size: %d\n", info.Size())
+	fmt.Fprintf(&buf, "mode: %s\n", info.Mode().Perm())
+	fmt.Fprintf(&buf, "mod_time: %s\n", info.ModTime().Format("2006-01-02 15:04:05 -0700"))
+	if info.IsDir() {
+		if entries, err := os.ReadDir(abs); err == nil {
+			fmt.Fprintf(&buf, "entries: %d\n", len(entries))
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+	binary, err := workspace.IsBinary(abs)
+	if err == nil {
+		fmt.Fprintf(&buf, "binary: %v\n", binary)
+	}
+	if err == nil && !binary && info.Size() <= constant.WorkspaceSearchMaxFileBytes {
+		if data, err := os.ReadFile(abs); err == nil {
+			fmt.Fprintf(&buf, "lines: %d\n", bytes.Count(data, []byte("\n")))
+		}
+	}
+	return mcp.NewToolResultText(buf.String()), nil
+}
+
+func (d *devRunner) HandleFsUndo(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	conversation := conversationOf(ctx, req)
+	if req.GetBool("list", false) {
+		edits := d.undo.List(conversation)
+		if len(edits) == 0 {
+			return mcp.NewToolResultText("no revertible edits in this conversation"), nil
+		}
+		var buf strings.Builder
+		buf.WriteString("### fs_undo: revertible edits (newest first)\n\n")
+		for _, e := range edits {
+			fmt.Fprintf(&buf, "- #%d %s at %s: %s\n", e.ID, e.Tool, e.At.Format("15:04:05"), d.editFiles(e))
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+
+	steps := req.GetInt("steps", 1)
+	edits := d.undo.Pop(conversation, steps)
+	if len(edits) == 0 {
+		return mcp.NewToolResultError("no revertible edits in this conversation"), nil
+	}
+	var (
+		buf  strings.Builder
+		errs []error
+	)
+	buf.WriteString("### fs_undo\n\n")
+	for _, e := range edits {
+		for i := len(e.Files) - 1; i >= 0; i-- {
+			if err := e.Files[i].Restore(); err != nil {
+				errs = append(errs, fmt.Errorf("restore %s: %w", d.jail.Rel(e.Files[i].Path), err))
+			}
+		}
+		fmt.Fprintf(&buf, "- reverted #%d %s: %s\n", e.ID, e.Tool, d.editFiles(e))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return mcp.NewToolResultError(buf.String() + "\nerrors:\n" + err.Error()), nil
+	}
+	return mcp.NewToolResultText(buf.String()), nil
+}
+
+func (d *devRunner) editFiles(e workspace.Edit) string {
+	names := make([]string, 0, len(e.Files))
+	for _, f := range e.Files {
+		names = append(names, d.jail.Rel(f.Path))
+	}
+	return strings.Join(names, ", ")
+}
+
+// conversationOf 撤销日志的 key：优先使用 host 在 _meta 中携带的对话 ID，其次是 MCP 会话 ID
+func conversationOf(ctx context.Context, req mcp.CallToolRequest) string {
+	if req.Params.Meta != nil {
+		if id, ok := req.Params.Meta.AdditionalFields[constant.MCPMetaConversationID].(string); ok && id != "" {
+			return id
+		}
+	}
+	if session := server.ClientSessionFromContext(ctx); session != nil && session.SessionID() != "" {
+		return "session:" + session.SessionID()
+	}
+	return constant.WorkspaceDefaultConversation
+}
+
+func editReport(title string, id int, diff string) string {
+	var buf strings.Builder
+	buf.WriteString("### " + title + "\n\n")
+	if id > 0 {
+		fmt.Fprintf(&buf, "**edit:** #%d (revert with fs_undo)\n\n", id)
+	}
+	if diff == "" {
+		buf.WriteString("(no changes)\n")
+		return buf.String()
+	}
+	buf.WriteString("```diff\n" + diff + "```\n")
+	return buf.String()
+}
+
+func fileType(info os.FileInfo) string {
+	switch {
+	case info.IsDir():
+		return "directory"
+	case info.Mode().IsRegular():
+		return "file"
+	default:
+		return info.Mode().Type().String()
+	}
+}
@@ -1,82 +1,105 @@
 package application
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/sandbox"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/workspace"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
-	"os/exec"
 	"strings"
-	"syscall"
 	"time"
 )
 
 // WithDevRunnerTools 本地开发辅助工具
-// 这组工具让 AI 能像本地助手一样：查看项目目录树(fs_tree)、读取文件(fs_cat)、运行项目/脚本(code_run)。
+// 这组工具让 AI 能像本地助手一样：查看项目目录树(fs_tree)、读取文件(fs_cat)、运行项目/脚本(code_run)，
+// 以及修改文件(fs_write/fs_apply_patch)、搜索(fs_search)、查看元信息(fs_stat)与撤销修改(fs_undo)，见 dev_editor.go。
 // - fs_tree：列出指定目录的树形结构（可控制深度/忽略模式），帮助 AI 感知项目布局。
 // - fs_cat ：读取指定文件的内容（可限制最大字节），帮助 AI 查看未直接提供的代码。
-// - code_run：在给定根目录下自动/按命令运行项目或单文件，返回 stdout/stderr/exit code，并给出基于错误输出的建议。
+// - code_run：在沙箱中按命令运行项目或单文件，返回 stdout/stderr/exit code，并给出基于错误输出的建议。
+//
+// 所有路径都被限制在 mcp.dev_runner.roots 工作区内，且不可访问 .env、*.pem、config*.yaml 等敏感文件；
+// 沙箱限制（命令白名单、资源上限、网络）见 mcp.dev_runner.sandbox 配置。
 func WithDevRunnerTools() tool_set.Option {
 	return func(toolSet *tool_set.ToolSet) {
+		jail := workspace.NewJail()
+		runner := &devRunner{jail: jail, sandbox: sandbox.NewRunner(jail), undo: workspace.NewUndoLog()}
 
 		// fs_tree 目录树查看，让AI感知在哪个目录下运行代码
 		toolTree := mcp.NewTool("fs_tree",
-			mcp.WithDescription("List a directory as a plain text tree to understand project layout."),
-			mcp.WithString("path", mcp.Required(), mcp.Description("Directory path to list")),
+			mcp.WithDescription("List a directory as a plain text tree to understand project layout. "+
+				"Only paths inside the workspace roots are accessible: "+strings.Join(jail.Roots(), ", ")),
+			mcp.WithString("path", mcp.Required(), mcp.Description("Directory path to list, absolute or relative to the first workspace root")),
 			// depth 最大遍历深度
 			mcp.WithNumber("depth", mcp.Description("Max depth to traverse (default 4)")),
 			// ignore 如 node_modules, *.log
 			mcp.WithString("ignore", mcp.Description("Comma-separated glob patterns to ignore (optional)")),
 		)
 		toolSet.Tools = append(toolSet.Tools, &toolTree)
-		toolSet.HandlerFunc[toolTree.Name] = HandleFsTree
+		toolSet.HandlerFunc[toolTree.Name] = tool_set.Typed(runner.HandleFsTree)
 
 		// fs_cat 读取文件里的内容
 		toolCat := mcp.NewTool("fs_cat",
-			mcp.WithDescription("Read a file content to inspect code that was not provided in the prompt."),
+			mcp.WithDescription("Read a text file content to inspect code that was not provided in the prompt. "+
+				"Binary files and secrets (.env, keys, config files) are not readable."),
 			// 文件路径
-			mcp.WithString("path", mcp.Required(), mcp.Description("File path to read")),
+			mcp.WithString("path", mcp.Required(), mcp.Description("File path to read, absolute or relative to the first workspace root")),
 			// 最大读取字节数
 			mcp.WithNumber("max_bytes", mcp.Description("Max bytes to read (default 65536)")),
 		)
 		toolSet.Tools = append(toolSet.Tools, &toolCat)
-		toolSet.HandlerFunc[toolCat.Name] = HandleFsCat
+		toolSet.HandlerFunc[toolCat.Name] = tool_set.Typed(runner.HandleFsCat)
 
-		// code_run 运行命令行
+		// code_run 在沙箱中运行命令
 		toolRun := mcp.NewTool("code_run",
-			// 工具用途：在本地命令行运行项目/脚本，返回 stdout/stderr/exit code，并基于错误输出给建议
-			mcp.WithDescription("Run a code file/project locally in the given root directory with the EXACT command provided by the AI,return stdout"),
-			// required ：工作目录（项目根目录）
-			mcp.WithString("root", mcp.Required(), mcp.Description("Working directory of the project")),
-			// 可选参数：运行前将 content 写入到 root 下的 file（相对路径）
-			//mcp.WithString("file", mcp.Description("Optional file path relative to root to write/update before running")),
-			//mcp.WithString("content", mcp.Description("Optional content to write to `file` before running")),
+			// 工具用途：在沙箱中运行项目/脚本，返回 stdout/stderr/exit code，并基于错误输出给建议
+			mcp.WithDescription("Run a code file/project in a sandbox with the EXACT command provided by the AI, return stdout. "+
+				"The command is executed directly without a shell (no pipes, redirects or &&), must be one of: "+
+				strings.Join(runner.sandbox.AllowedCommands(), ", ")+". Only the working directory is writable and files outside it (other than system directories) are not accessible. Network access is disabled by default."),
+			// optional ：工作目录（项目根目录），必须位于配置的工作区内；留空时使用临时工作区
+			mcp.WithString("root", mcp.Description("Working directory of the project, must be inside one of the workspace roots; omit to use an empty temporary workspace. Use fs_write to create files before running")),
 			// required ：显式运行命令
-			mcp.WithString("command", mcp.Description("Explicit shell command to run under the root directory(eg `python main.py`,`go run cmd/host`,`npm run dev`)")),
-			// optional ：超时（秒），默认 120s
-			mcp.WithNumber("timeout_sec", mcp.Description("Timeout in seconds (default 120)")),
+			mcp.WithString("command", mcp.Required(), mcp.Description("Explicit command to run under the root directory(eg `python3 main.py`,`go run ./cmd/host`,`npm run dev`)")),
+			// optional ：超时（秒），不超过沙箱配置的上限
+			mcp.WithNumber("timeout_sec", mcp.Description("Timeout in seconds (default and max 120 unless configured otherwise)")),
 			// optional ：传给程序的标准输入
 			mcp.WithString("stdin", mcp.Description("Optional STDIN to pass to the program")),
 		)
 		toolSet.Tools = append(toolSet.Tools, &toolRun)
-		toolSet.HandlerFunc[toolRun.Name] = HandleCodeRun
+		toolSet.HandlerFunc[toolRun.Name] = tool_set.Typed(runner.HandleCodeRun)
+
+		// fs_write / fs_apply_patch / fs_search / fs_stat / fs_undo
+		runner.registerEditorTools(toolSet)
 	}
 }
 
-func HandleFsCat(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	p, _ := args["path"].(string)
-	if p == "" {
-		return mcp.NewToolResultError("missing required arg: path"), nil
+// devRunner 开发工具的执行器：文件访问受 jail 约束，命令在沙箱中运行
+type devRunner struct {
+	jail    *workspace.Jail
+	sandbox *sandbox.Runner
+	undo    *workspace.UndoLog // 按对话记录 fs_write/fs_apply_patch 的修改
+}
+
+type fsCatArgs struct {
+	Path     string `json:"path"`
+	MaxBytes int    `json:"max_bytes"`
+}
+
+func (d *devRunner) HandleFsCat(ctx context.Context, req mcp.CallToolRequest, args fsCatArgs) (*mcp.CallToolResult, error) {
+	p, err := d.jail.Resolve(args.Path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if binary, err := workspace.IsBinary(p); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	} else if binary {
+		return mcp.NewToolResultError(fmt.Sprintf("%v: %s", workspace.ErrBinaryFile, p)), nil
 	}
-	maxF, _ := args["max_bytes"].(float64)
 	maxBytes := 64 * 1024
-	if maxF > 0 {
-		maxBytes = int(maxF)
+	if args.MaxBytes > 0 {
+		maxBytes = args.MaxBytes
 	}
 	content, truncated, err := utils.ReadFileMax(p, maxBytes)
 	if err != nil {
@@ -86,88 +109,94 @@ func HandleFsCat(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 	return mcp.NewToolResultText(header + content), nil
 }
 
-func HandleFsTree(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	root, _ := args["path"].(string)
-	if root == "" {
-		return mcp.NewToolResultError("missing required arg: path"), nil
+type fsTreeArgs struct {
+	Path   string `json:"path"`
+	Depth  int    `json:"depth"`
+	Ignore string `json:"ignore"`
+}
+
+func (d *devRunner) HandleFsTree(ctx context.Context, req mcp.CallToolRequest, args fsTreeArgs) (*mcp.CallToolResult, error) {
+	root, err := d.jail.ResolveDir(args.Path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
-	depthF, _ := args["depth"].(float64)
 	depth := 4
-	if depthF > 0 {
-		depth = int(depthF)
+	if args.Depth > 0 {
+		depth = args.Depth
 	}
-	ignoreStr, _ := args["ignore"].(string)
 	var ignores []string
-	if ignoreStr != "" {
-		for _, s := range strings.Split(ignoreStr, ",") {
+	if args.Ignore != "" {
+		for _, s := range strings.Split(args.Ignore, ",") {
 			s = strings.TrimSpace(s)
 			if s != "" {
 				ignores = append(ignores, s)
 			}
 		}
 	}
-	out, err := buildTreeText(root, depth, ignores)
+	// 敏感文件不出现在目录树中
+	out, err := buildTreeText(root, depth, ignores, d.jail.Denied)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	return mcp.NewToolResultText(out), nil
 }
 
-func HandleCodeRun(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// 获取参数
-	args := req.GetArguments()
-	root, _ := args["root"].(string)
-	if root == "" {
-		return mcp.NewToolResultError("missing required arg: root"), nil
-	}
-	//fileRel, _ := args["file"].(string)
-	//content, _ := args["content"].(string)
-	cmdStr, _ := args["command"].(string)
+type codeRunArgs struct {
+	Root       string  `json:"root"`
+	Command    string  `json:"command"`
+	TimeoutSec float64 `json:"timeout_sec"`
+	Stdin      string  `json:"stdin"`
+}
+
+func (d *devRunner) HandleCodeRun(ctx context.Context, req mcp.CallToolRequest, args codeRunArgs) (*mcp.CallToolResult, error) {
+	cmdStr := args.Command
 	if strings.TrimSpace(cmdStr) == "" {
 		return mcp.NewToolResultError("missing required arg: command"), nil
 	}
-	stdin, _ := args["stdin"].(string)
-	timeoutF, _ := args["timeout_sec"].(float64)
-	timeout := 120 * time.Second
-	if timeoutF > 0 {
-		timeout = time.Duration(timeoutF) * time.Second
-	}
 
-	// 可选：写入文件
-	//if fileRel != "" && content != "" {
-	//	abs := filepath.Join(root, filepath.Clean(fileRel))
-	//	if err := os.MkdirAll(filepath.Dir(abs), 0o755); err != nil {
-	//		return mcp.NewToolResultError("mkdir: " + err.Error()), nil
-	//	}
-	//	if err := os.WriteFile(abs, []byte(content), 0o644); err != nil {
-	//		return mcp.NewToolResultError("write file: " + err.Error()), nil
-	//	}
-	//}
-
-	// 运行命令
-	stdout, stderr, exitCode, runErr := runShell(ctx, root, cmdStr, stdin, timeout)
+	// 构建、测试类命令可能持续数十秒，期间定期汇报已运行时间
+	stop := tool_set.NewProgress(ctx, req).Heartbeat(func(elapsed time.Duration) string {
+		return fmt.Sprintf("running `%s` (%s)", cmdStr, elapsed.Round(time.Second))
+	})
+	// 运行命令：命令/目录不满足沙箱策略时以工具错误返回，便于模型调整
+	res, err := d.sandbox.Run(ctx, sandbox.Request{
+		Root:    args.Root,
+		Command: cmdStr,
+		Stdin:   args.Stdin,
+		Timeout: time.Duration(args.TimeoutSec) * time.Second,
+	})
+	stop()
+	if err != nil {
+		logger.Warnf("code_run: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	var buf strings.Builder
 	buf.WriteString("### code_run\n\n")
-	buf.WriteString("**dir:** " + root + "\n\n")
+	if args.Root == "" {
+		buf.WriteString("**dir:** (temporary workspace)\n\n")
+	} else {
+		buf.WriteString("**dir:** " + res.Dir + "\n\n")
+	}
 	buf.WriteString("**cmd:**\n```sh\n" + cmdStr + "\n```\n\n")
-	buf.WriteString(fmt.Sprintf("**exit_code:** %d\n\n", exitCode))
+	buf.WriteString(fmt.Sprintf("**exit_code:** %d\n\n", res.ExitCode))
+	if res.TimedOut {
+		buf.WriteString("**timed_out:** true (the whole process group was killed)\n\n")
+	}
+	if res.Truncated {
+		buf.WriteString("**truncated:** true (output exceeded the sandbox limit)\n\n")
+	}
 
-	if s := strings.TrimSpace(stdout); s != "" {
+	if s := strings.TrimSpace(res.Stdout); s != "" {
 		buf.WriteString("**stdout:**\n```\n" + tail(s, 10000) + "\n```\n\n")
 	} else {
 		buf.WriteString("**stdout:** (empty)\n\n")
 	}
-	if s := strings.TrimSpace(stderr); s != "" {
+	if s := strings.TrimSpace(res.Stderr); s != "" {
 		buf.WriteString("**stderr:**\n```\n" + tail(s, 10000) + "\n```\n\n")
 	} else {
 		buf.WriteString("**stderr:** (empty)\n\n")
 	}
-
-	if runErr != nil && !errors.Is(runErr, context.DeadlineExceeded) {
-		logger.Warnf("code_run: %v", runErr)
-	}
 	return mcp.NewToolResultText(buf.String()), nil
 }
 
@@ -177,37 +206,3 @@ func tail(s string, max int) string {
 	}
 	return s[len(s)-max:]
 }
-
-// ===== 辅助：运行命令 =====
-func runShell(ctx context.Context, dir, cmdStr, stdin string, timeout time.Duration) (string, string, int, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, "bash", "-lc", cmdStr)
-	cmd.Dir = dir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-	if stdin != "" {
-		cmd.Stdin = strings.NewReader(stdin)
-	}
-
-	err := cmd.Run()
-	exitCode := 0
-	if err != nil {
-		var ee *exec.ExitError
-		if errors.As(err, &ee) {
-			if status, ok := ee.Sys().(syscall.WaitStatus); ok {
-				exitCode = status.ExitStatus()
-			} else {
-				exitCode = 1
-			}
-		} else if errors.Is(err, context.DeadlineExceeded) {
-			exitCode = 124
-		} else {
-			exitCode = 1
-		}
-	}
-	return stdout.String(), stderr.String(), exitCode, err
-}
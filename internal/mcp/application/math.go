@@ -0,0 +1,334 @@
+package application
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/calc"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WithMathTools 本地计算的数学工具：求值、求导、定积分、求根与作图全部由 pkg/base/calc 确定性完成，
+// 不经过大模型，结果和图像都是算出来的而不是"写"出来的
+func WithMathTools() tool_set.Option {
+	return func(toolSet *tool_set.ToolSet) {
+		funcs := strings.Join(calc.FunctionNames(), ", ")
+		syntax := "Syntax: + - * / ^ (or **), parentheses, implicit multiplication (2x, 3(x+1)), constants pi and e, functions: " + funcs + ". "
+		variables := mcp.WithObject("variables", mcp.Description("Values of other variables/parameters, e.g. {\"a\": 2}"))
+
+		toolEval := mcp.NewTool("math_eval",
+			mcp.WithDescription("Evaluate a math expression exactly with a local engine instead of mental arithmetic. "+syntax),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to evaluate, e.g. sqrt(2)*sin(pi/4)")),
+			variables,
+		)
+		toolDerivative := mcp.NewTool("math_derivative",
+			mcp.WithDescription("Symbolic derivative of an expression, optionally evaluated at a point. "+syntax),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to differentiate")),
+			mcp.WithString("variable", mcp.Description("Variable to differentiate with respect to (default x)")),
+			mcp.WithNumber("order", mcp.Description(fmt.Sprintf("Derivative order 1-%d (default 1)", constant.MathMaxDerivativeOrder))),
+			mcp.WithNumber("at", mcp.Description("Evaluate the derivative at this value of the variable (optional)")),
+			variables,
+		)
+		toolIntegrate := mcp.NewTool("math_integrate",
+			mcp.WithDescription("Numeric definite integral over [from, to] using adaptive Simpson's rule. "+syntax),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Integrand")),
+			mcp.WithNumber("from", mcp.Required(), mcp.Description("Lower bound (finite)")),
+			mcp.WithNumber("to", mcp.Required(), mcp.Description("Upper bound (finite)")),
+			mcp.WithString("variable", mcp.Description("Integration variable (default x)")),
+			variables,
+		)
+		toolRoots := mcp.NewTool("math_roots",
+			mcp.WithDescription("Find real roots of an expression or equation (lhs = rhs) in an interval. "+
+				"Roots where the function touches zero without changing sign may be missed. "+syntax),
+			mcp.WithString("equation", mcp.Required(), mcp.Description("Expression equal to zero, or an equation like x^3 = 2x + 1")),
+			mcp.WithNumber("from", mcp.Description(fmt.Sprintf("Interval start (default -%g)", constant.MathPlotDefaultRange))),
+			mcp.WithNumber("to", mcp.Description(fmt.Sprintf("Interval end (default %g)", constant.MathPlotDefaultRange))),
+			mcp.WithString("variable", mcp.Description("Unknown (default x)")),
+			variables,
+		)
+		toolPlot := mcp.NewTool("math_plot",
+			mcp.WithDescription("Plot one or more functions of one variable and return an SVG image rendered on the server. "+
+				"Always use this tool instead of drawing graphs by hand. "+syntax),
+			mcp.WithArray("expressions", mcp.Required(), mcp.WithStringItems(),
+				mcp.Description(fmt.Sprintf("Functions to plot (max %d), e.g. [\"sin(x)\", \"x^2/10\"]", constant.MathPlotMaxSeries))),
+			mcp.WithNumber("x_min", mcp.Description(fmt.Sprintf("Left end of the x range (default -%g)", constant.MathPlotDefaultRange))),
+			mcp.WithNumber("x_max", mcp.Description(fmt.Sprintf("Right end of the x range (default %g)", constant.MathPlotDefaultRange))),
+			mcp.WithNumber("y_min", mcp.Description("Bottom of the y range (default automatic)")),
+			mcp.WithNumber("y_max", mcp.Description("Top of the y range (default automatic)")),
+			mcp.WithNumber("samples", mcp.Description(fmt.Sprintf("Sample points per curve (default %d, max %d)", constant.MathPlotDefaultSamples, constant.MathPlotMaxSamples))),
+			mcp.WithString("title", mcp.Description("Plot title (optional)")),
+			mcp.WithString("variable", mcp.Description("Horizontal axis variable (default x)")),
+			variables,
+		)
+
+		toolSet.Tools = append(toolSet.Tools, &toolEval, &toolDerivative, &toolIntegrate, &toolRoots, &toolPlot)
+		toolSet.HandlerFunc[toolEval.Name] = HandleMathEval
+		toolSet.HandlerFunc[toolDerivative.Name] = HandleMathDerivative
+		toolSet.HandlerFunc[toolIntegrate.Name] = HandleMathIntegrate
+		toolSet.HandlerFunc[toolRoots.Name] = HandleMathRoots
+		toolSet.HandlerFunc[toolPlot.Name] = HandleMathPlot
+	}
+}
+
+func HandleMathEval(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expr, env, errResult := mathInput(req, "expression")
+	if errResult != nil {
+		return errResult, nil
+	}
+	v, err := expr.Eval(env)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mathResult(map[string]any{
+		"expression": expr.String(),
+		"value":      calc.FormatNumber(v),
+	}), nil
+}
+
+func HandleMathDerivative(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expr, env, errResult := mathInput(req, "expression")
+	if errResult != nil {
+		return errResult, nil
+	}
+	variable := mathVariable(req)
+	order := req.GetInt("order", 1)
+	if order < 1 || order > constant.MathMaxDerivativeOrder {
+		return mcp.NewToolResultError(fmt.Sprintf("order must be between 1 and %d", constant.MathMaxDerivativeOrder)), nil
+	}
+	d := expr
+	for i := 0; i < order; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var err error
+		if d, err = calc.Derivative(ctx, d, variable); err != nil {
+			if errors.Is(err, calc.ErrTooLarge) {
+				return mcp.NewToolResultError(fmt.Sprintf("the order-%d derivative is too large to compute, try a lower order", i+1)), nil
+			}
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+	out := map[string]any{
+		"expression": expr.String(),
+		"variable":   variable,
+		"order":      order,
+		"derivative": d.String(),
+	}
+	if at := optionalNumber(req, "at"); at != nil {
+		env[variable] = *at
+		v, err := d.Eval(env)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		out["at"] = calc.FormatNumber(*at)
+		out["value"] = calc.FormatNumber(v)
+	}
+	return mathResult(out), nil
+}
+
+func HandleMathIntegrate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expr, env, errResult := mathInput(req, "expression")
+	if errResult != nil {
+		return errResult, nil
+	}
+	from, to := optionalNumber(req, "from"), optionalNumber(req, "to")
+	if from == nil || to == nil {
+		return mcp.NewToolResultError("missing required args: from, to"), nil
+	}
+	variable := mathVariable(req)
+	v, errEst, err := calc.Integrate(ctx, expr, variable, *from, *to, env)
+	if err != nil {
+		if errors.Is(err, calc.ErrTooManyEvaluations) {
+			return mcp.NewToolResultError(fmt.Sprintf("the integral did not converge within %d evaluations; the integrand may oscillate too fast, try a smaller interval", constant.MathIntegrateMaxEvals)), nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mathResult(map[string]any{
+		"expression":     expr.String(),
+		"variable":       variable,
+		"from":           calc.FormatNumber(*from),
+		"to":             calc.FormatNumber(*to),
+		"value":          calc.FormatNumber(v),
+		"error_estimate": fmt.Sprintf("%.1e", errEst),
+	}), nil
+}
+
+func HandleMathRoots(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	raw := strings.TrimSpace(req.GetString("equation", ""))
+	if raw == "" {
+		return mcp.NewToolResultError("missing required arg: equation"), nil
+	}
+	expr, err := calc.ParseEquation(raw)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	env, err := mathEnv(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	from, to := -constant.MathPlotDefaultRange, constant.MathPlotDefaultRange
+	if v := optionalNumber(req, "from"); v != nil {
+		from = *v
+	}
+	if v := optionalNumber(req, "to"); v != nil {
+		to = *v
+	}
+	variable := mathVariable(req)
+	roots, err := calc.Roots(ctx, expr, variable, from, to, env)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	formatted := make([]string, len(roots))
+	for i, r := range roots {
+		formatted[i] = calc.FormatNumber(r)
+	}
+	return mathResult(map[string]any{
+		"equation": expr.String() + " = 0",
+		"variable": variable,
+		"interval": []string{calc.FormatNumber(from), calc.FormatNumber(to)},
+		"roots":    formatted,
+	}), nil
+}
+
+func HandleMathPlot(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	sources := plotExpressions(req.GetArguments()["expressions"])
+	if len(sources) == 0 {
+		return mcp.NewToolResultError("missing required arg: expressions"), nil
+	}
+	env, err := mathEnv(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	series := make([]calc.Series, 0, len(sources))
+	for _, s := range sources {
+		n, err := calc.Parse(s)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: %v", s, err)), nil
+		}
+		series = append(series, calc.Series{Label: s, Expr: n})
+	}
+	svg, info, err := calc.Plot(ctx, series, calc.PlotOptions{
+		XMin:    optionalNumber(req, "x_min"),
+		XMax:    optionalNumber(req, "x_max"),
+		YMin:    optionalNumber(req, "y_min"),
+		YMax:    optionalNumber(req, "y_max"),
+		Samples: req.GetInt("samples", 0),
+		Title:   req.GetString("title", ""),
+		Var:     mathVariable(req),
+	}, env)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	summary := fmt.Sprintf("plotted %s for %s in [%s, %s], y in [%s, %s], %d samples per curve",
+		strings.Join(sources, ", "), mathVariable(req),
+		calc.FormatNumber(info.XMin), calc.FormatNumber(info.XMax),
+		calc.FormatNumber(info.YMin), calc.FormatNumber(info.YMax), info.Samples)
+	return &mcp.CallToolResult{Content: []mcp.Content{
+		mcp.NewTextContent(summary),
+		mcp.NewImageContent(base64.StdEncoding.EncodeToString([]byte(svg)), constant.MathPlotImageMIME),
+	}}, nil
+}
+
+// mathInput 解析 key 对应的表达式与 variables
+func mathInput(req mcp.CallToolRequest, key string) (calc.Node, calc.Env, *mcp.CallToolResult) {
+	raw := strings.TrimSpace(req.GetString(key, ""))
+	if raw == "" {
+		return nil, nil, mcp.NewToolResultError("missing required arg: " + key)
+	}
+	expr, err := calc.Parse(raw)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(err.Error())
+	}
+	env, err := mathEnv(req)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(err.Error())
+	}
+	return expr, env, nil
+}
+
+func mathEnv(req mcp.CallToolRequest) (calc.Env, error) {
+	env := calc.Env{}
+	raw, ok := req.GetArguments()["variables"].(map[string]any)
+	if !ok {
+		return env, nil
+	}
+	for k, v := range raw {
+		switch x := v.(type) {
+		case float64:
+			env[k] = x
+		case string:
+			// 允许以表达式给出取值，如 "pi/3"
+			n, err := calc.Parse(x)
+			if err != nil {
+				return nil, fmt.Errorf("variable %s: %w", k, err)
+			}
+			if env[k], err = n.Eval(nil); err != nil {
+				return nil, fmt.Errorf("variable %s: %w", k, err)
+			}
+		default:
+			return nil, fmt.Errorf("variable %s must be a number", k)
+		}
+	}
+	return env, nil
+}
+
+func mathVariable(req mcp.CallToolRequest) string {
+	if v := strings.TrimSpace(req.GetString("variable", "")); v != "" {
+		return v
+	}
+	return constant.MathDefaultVariable
+}
+
+// optionalNumber 区分未传与传 0
+func optionalNumber(req mcp.CallToolRequest, key string) *float64 {
+	if _, ok := req.GetArguments()[key]; !ok {
+		return nil
+	}
+	v := req.GetFloat(key, 0)
+	return &v
+}
+
+// plotExpressions 兼容数组与以 ; 分隔的字符串（参数校验会把单个字符串包成数组）
+func plotExpressions(raw any) []string {
+	var parts []string
+	switch x := raw.(type) {
+	case []any:
+		for _, v := range x {
+			if s, ok := v.(string); ok {
+				parts = append(parts, strings.Split(s, ";")...)
+			}
+		}
+	case string:
+		parts = strings.Split(x, ";")
+	}
+	out := parts[:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func mathResult(v map[string]any) *mcp.CallToolResult {
+	out, _ := json.Marshal(v)
+	return mcp.NewToolResultText(string(out))
+}
@@ -1,10 +0,0 @@
-package application
-
-type ChatOptions struct {
-	EnableWebSearch bool
-	Model           string
-	Temperature     *float64
-	TopP            *float64
-	TopK            *int
-	MaxTokens       *int
-}
\ No newline at end of file
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	pathpkg "path"
+	"regexp"
+	"text/template"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+//go:embed prompts/*.txt
+var promptFS embed.FS
+
+var termPattern = regexp.MustCompile(constant.MCPTermPattern)
+
+// WithChatPrompts 注册 host 使用的提示词：对话系统提示词、每日日程与对话总结。
+// 提示词随 MCP server 发布，host 通过 prompts/get 获取渲染结果
+func WithChatPrompts() prompt_set.Option {
+	return func(ps *prompt_set.PromptSet) {
+		chat := mcp.NewPrompt(constant.MCPPromptChatSystem,
+			mcp.WithPromptDescription("对话系统提示词：教务处工具使用规则、学期与节次换算、课表输出格式"),
+			mcp.WithArgument("date", mcp.ArgumentDescription("当前日期，格式 YYYY-MM-DD，默认今天")),
+			mcp.WithArgument("term", mcp.ArgumentDescription("当前学期代码 YYYYSS，默认由 date 推算")),
+		)
+		ps.Prompts = append(ps.Prompts, &chat)
+		ps.HandlerFunc[chat.Name] = promptHandler(chat, "prompts/chat_system.txt", dateAndTerm)
+
+		daily := mcp.NewPrompt(constant.MCPPromptDailySchedule,
+			mcp.WithPromptDescription("每日日程提示词：结合课表与待办生成今日安排"),
+			mcp.WithArgument("date", mcp.RequiredArgument(), mcp.ArgumentDescription("日程日期，格式 YYYY-MM-DD")),
+			mcp.WithArgument("term", mcp.ArgumentDescription("当前学期代码 YYYYSS，默认由 date 推算")),
+		)
+		ps.Prompts = append(ps.Prompts, &daily)
+		ps.HandlerFunc[daily.Name] = promptHandler(daily, "prompts/daily_schedule.txt", func(args map[string]string) (map[string]string, error) {
+			if args["date"] == "" {
+				return nil, fmt.Errorf("missing required argument: date")
+			}
+			return dateAndTerm(args)
+		})
+
+		summarize := mcp.NewPrompt(constant.MCPPromptSummarize,
+			mcp.WithPromptDescription("对话总结提示词：输出 summary/tags/tool_calls/notes 结构化 JSON"),
+			mcp.WithArgument("conversation_history", mcp.RequiredArgument(), mcp.ArgumentDescription("按时间顺序排列的对话历史文本")),
+			mcp.WithArgument("existing_summary", mcp.ArgumentDescription("本对话已有的总结，没有时留空")),
+		)
+		ps.Prompts = append(ps.Prompts, &summarize)
+		ps.HandlerFunc[summarize.Name] = promptHandler(summarize, "prompts/summarize.txt", func(args map[string]string) (map[string]string, error) {
+			if args["conversation_history"] == "" {
+				return nil, fmt.Errorf("missing required argument: conversation_history")
+			}
+			existing := args["existing_summary"]
+			if existing == "" {
+				existing = "本对话暂无已有总结"
+			}
+			return map[string]string{
+				"conversation_history": args["conversation_history"],
+				"existing_summary":     existing,
+			}, nil
+		})
+	}
+}
+
+// promptHandler 校验参数后用 text/template 渲染 path 处的模板，结果作为一条 user 消息返回
+func promptHandler(p mcp.Prompt, path string, prepare func(args map[string]string) (map[string]string, error)) server.PromptHandlerFunc {
+	tpl := template.Must(template.New(pathpkg.Base(path)).Option("missingkey=error").ParseFS(promptFS, path))
+	return func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		data, err := prepare(req.Params.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("render prompt %s: %w", p.Name, err)
+		}
+		return mcp.NewGetPromptResult(p.Description, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(buf.String())),
+		}), nil
+	}
+}
+
+// dateAndTerm 校验 date/term 的格式，缺省时取今天与其所在学期
+func dateAndTerm(args map[string]string) (map[string]string, error) {
+	date := time.Now()
+	if s := args["date"]; s != "" {
+		d, err := time.ParseInLocation(constant.MCPPromptDateLayout, s, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("date must be in YYYY-MM-DD format, got %q", s)
+		}
+		date = d
+	}
+	term := args["term"]
+	if term == "" {
+		term = utils.TermOf(date)
+	} else if !termPattern.MatchString(term) {
+		return nil, fmt.Errorf("term must be a 6-digit code like 202501, got %q", term)
+	}
+	return map[string]string{
+		"date": date.Format(constant.MCPPromptDateLayout),
+		"term": term,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDateAndTerm(t *testing.T) {
+	cases := []struct {
+		name string
+		args map[string]string
+		want map[string]string
+		err  string
+	}{
+		{"last day of the autumn term", map[string]string{"date": "2025-01-31"}, map[string]string{"date": "2025-01-31", "term": "202401"}, ""},
+		{"first day of the spring term", map[string]string{"date": "2025-02-01"}, map[string]string{"date": "2025-02-01", "term": "202402"}, ""},
+		{"last day of the spring term", map[string]string{"date": "2025-07-31"}, map[string]string{"date": "2025-07-31", "term": "202402"}, ""},
+		{"first day of the autumn term", map[string]string{"date": "2025-08-01"}, map[string]string{"date": "2025-08-01", "term": "202501"}, ""},
+		{"explicit term wins", map[string]string{"date": "2025-08-01", "term": "202402"}, map[string]string{"date": "2025-08-01", "term": "202402"}, ""},
+		{"invalid date", map[string]string{"date": "2025/08/01"}, nil, "date must be in YYYY-MM-DD format"},
+		{"impossible date", map[string]string{"date": "2025-02-30"}, nil, "date must be in YYYY-MM-DD format"},
+		{"invalid term", map[string]string{"date": "2025-08-01", "term": "202503"}, nil, "term must be a 6-digit code"},
+		{"short term", map[string]string{"term": "2025"}, nil, "term must be a 6-digit code"},
+	}
+
+	Convey("dateAndTerm", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				got, err := dateAndTerm(c.args)
+				if c.err != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, c.err)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, c.want)
+			})
+		}
+
+		Convey("defaults to today and its term", func() {
+			got, err := dateAndTerm(nil)
+			So(err, ShouldBeNil)
+			now := time.Now()
+			So(got["date"], ShouldEqual, now.Format(constant.MCPPromptDateLayout))
+			So(got["term"], ShouldEqual, utils.TermOf(now))
+		})
+	})
+}
+
+func TestChatPrompts(t *testing.T) {
+	ps := prompt_set.NewPromptSet(WithChatPrompts())
+	get := func(name string, args map[string]string) (string, error) {
+		req := mcp.GetPromptRequest{}
+		req.Params.Name = name
+		req.Params.Arguments = args
+		res, err := ps.HandlerFunc[name](context.Background(), req)
+		if err != nil {
+			return "", err
+		}
+		return res.Messages[0].Content.(mcp.TextContent).Text, nil
+	}
+
+	cases := []struct {
+		name   string
+		prompt string
+		args   map[string]string
+		want   []string
+		err    string
+	}{
+		{"chat system prompt renders date and term", constant.MCPPromptChatSystem,
+			map[string]string{"date": "2025-08-01"}, []string{"2025-08-01", "202501"}, ""},
+		{"chat system prompt rejects an invalid term", constant.MCPPromptChatSystem,
+			map[string]string{"term": "bogus"}, nil, "term must be a 6-digit code"},
+		{"daily schedule renders the given date", constant.MCPPromptDailySchedule,
+			map[string]string{"date": "2025-02-01"}, []string{"2025-02-01", "202402"}, ""},
+		{"daily schedule requires a date", constant.MCPPromptDailySchedule,
+			map[string]string{"term": "202501"}, nil, "missing required argument: date"},
+		{"summarize renders the history and a placeholder summary", constant.MCPPromptSummarize,
+			map[string]string{"conversation_history": "[User] hi"}, []string{"[User] hi", "本对话暂无已有总结"}, ""},
+		{"summarize requires the history", constant.MCPPromptSummarize,
+			map[string]string{"existing_summary": "old"}, nil, "missing required argument: conversation_history"},
+	}
+
+	Convey("chat prompts", t, func() {
+		for _, c := range cases {
+			Convey(c.name, func() {
+				text, err := get(c.prompt, c.args)
+				if c.err != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, c.err)
+					return
+				}
+				So(err, ShouldBeNil)
+				for _, w := range c.want {
+					So(text, ShouldContainSubstring, w)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/redis/go-redis/v9"
+	"github.com/west2-online/jwch"
+)
+
+const resourceMIMEType = "application/json"
+
+// WithUserDataResources 以资源模板的形式暴露用户数据：
+// todo://{user_id}、todo://{user_id}/{id}、course://{user_id}/{term}、summary://{conversation_id}。
+// 读取前校验资源所属用户与 ctx 中的调用方一致，调用方由传输层从 host 填写的请求头中取得
+func WithUserDataResources(repo repository.MCPRepository, cache *redis.Client) resource_set.Option {
+	return func(rs *resource_set.ResourceSet) {
+		rs.Owner = resourceOwner(repo)
+
+		todoList := mcp.NewResourceTemplate("todo://{user_id}", "todos",
+			mcp.WithTemplateDescription("用户的全部待办事项"),
+			mcp.WithTemplateMIMEType(resourceMIMEType),
+		)
+		rs.Templates = append(rs.Templates, &todoList)
+		rs.HandlerFunc[todoList.URITemplate.Raw()] = func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			userID, err := templateArg(req, "user_id")
+			if err != nil {
+				return nil, err
+			}
+			if err := requireCaller(ctx, req.Params.URI, userID); err != nil {
+				return nil, err
+			}
+			todos, err := repo.ListTodosByUserID(ctx, userID)
+			if err != nil {
+				return nil, fmt.Errorf("query todos: %w", err)
+			}
+			items := make([]todoItem, 0, len(todos))
+			for _, todo := range todos {
+				items = append(items, newTodoItem(todo))
+			}
+			return jsonResource(req.Params.URI, items)
+		}
+
+		todo := mcp.NewResourceTemplate("todo://{user_id}/{id}", "todo",
+			mcp.WithTemplateDescription("用户的单条待办事项"),
+			mcp.WithTemplateMIMEType(resourceMIMEType),
+		)
+		rs.Templates = append(rs.Templates, &todo)
+		rs.HandlerFunc[todo.URITemplate.Raw()] = func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			userID, err := templateArg(req, "user_id")
+			if err != nil {
+				return nil, err
+			}
+			id, err := templateArg(req, "id")
+			if err != nil {
+				return nil, err
+			}
+			if err := requireCaller(ctx, req.Params.URI, userID); err != nil {
+				return nil, err
+			}
+			item, err := repo.GetTodoByID(ctx, userID, id)
+			if err != nil {
+				return nil, fmt.Errorf("query todo: %w", err)
+			}
+			if item == nil {
+				return nil, fmt.Errorf("resource not found: %s", req.Params.URI)
+			}
+			return jsonResource(req.Params.URI, newTodoItem(item))
+		}
+
+		course := mcp.NewResourceTemplate("course://{user_id}/{term}", "course",
+			mcp.WithTemplateDescription("用户某学期的课表（来自 host 的课表缓存）"),
+			mcp.WithTemplateMIMEType(resourceMIMEType),
+		)
+		rs.Templates = append(rs.Templates, &course)
+		rs.HandlerFunc[course.URITemplate.Raw()] = func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			userID, err := templateArg(req, "user_id")
+			if err != nil {
+				return nil, err
+			}
+			term, err := templateArg(req, "term")
+			if err != nil {
+				return nil, err
+			}
+			if err := requireCaller(ctx, req.Params.URI, userID); err != nil {
+				return nil, err
+			}
+			if cache == nil {
+				return nil, errors.New("redis client not initialized")
+			}
+			data, err := cache.Get(ctx, fmt.Sprintf("course:%s:%s", userID, term)).Bytes()
+			if errors.Is(err, redis.Nil) {
+				return nil, fmt.Errorf("resource not found: %s", req.Params.URI)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("get course from cache: %w", err)
+			}
+			var courses []*jwch.Course
+			if err := json.Unmarshal(data, &courses); err != nil {
+				return nil, fmt.Errorf("unmarshal course data: %w", err)
+			}
+			return jsonResource(req.Params.URI, newCourseItems(courses))
+		}
+
+		summary := mcp.NewResourceTemplate("summary://{conversation_id}", "summary",
+			mcp.WithTemplateDescription("对话的总结、标签与笔记"),
+			mcp.WithTemplateMIMEType(resourceMIMEType),
+		)
+		rs.Templates = append(rs.Templates, &summary)
+		rs.HandlerFunc[summary.URITemplate.Raw()] = func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			conversationID, err := templateArg(req, "conversation_id")
+			if err != nil {
+				return nil, err
+			}
+			owner, err := repo.GetConversationUserID(ctx, conversationID)
+			if err != nil {
+				return nil, fmt.Errorf("query conversation: %w", err)
+			}
+			if err := requireCaller(ctx, req.Params.URI, owner); err != nil {
+				return nil, err
+			}
+			s, err := repo.GetSummaryByConversationID(ctx, conversationID)
+			if err != nil {
+				return nil, fmt.Errorf("query summary: %w", err)
+			}
+			if s == nil {
+				return nil, fmt.Errorf("resource not found: %s", req.Params.URI)
+			}
+			return jsonResource(req.Params.URI, newSummaryItem(s))
+		}
+
+		rs.Listers = append(rs.Listers, func(ctx context.Context, userID string) ([]mcp.Resource, error) {
+			todos, err := repo.ListTodosByUserID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			out := []mcp.Resource{mcp.NewResource(utils.TodoListResourceURI(userID), "todos",
+				mcp.WithResourceDescription(fmt.Sprintf("全部待办事项（%d 条）", len(todos))),
+				mcp.WithMIMEType(resourceMIMEType),
+			)}
+			for _, t := range todos {
+				out = append(out, mcp.NewResource(utils.TodoResourceURI(userID, t.ID), t.Title,
+					mcp.WithResourceDescription("待办事项"),
+					mcp.WithMIMEType(resourceMIMEType),
+				))
+			}
+			return out, nil
+		}, func(ctx context.Context, userID string) ([]mcp.Resource, error) {
+			if cache == nil {
+				return nil, nil
+			}
+			prefix := fmt.Sprintf("course:%s:", userID)
+			var out []mcp.Resource
+			iter := cache.Scan(ctx, 0, prefix+"*", 100).Iterator()
+			for iter.Next(ctx) {
+				term := strings.TrimPrefix(iter.Val(), prefix)
+				out = append(out, mcp.NewResource(utils.CourseResourceURI(userID, term), "course "+term,
+					mcp.WithResourceDescription("学期课表"),
+					mcp.WithMIMEType(resourceMIMEType),
+				))
+			}
+			return out, iter.Err()
+		}, func(ctx context.Context, userID string) ([]mcp.Resource, error) {
+			summaries, err := repo.ListSummariesByUserID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]mcp.Resource, 0, len(summaries))
+			for _, s := range summaries {
+				out = append(out, mcp.NewResource(utils.SummaryResourceURI(s.ConversationID), "summary "+s.ConversationID,
+					mcp.WithResourceDescription(truncateRunes(s.SummaryText, 80)),
+					mcp.WithMIMEType(resourceMIMEType),
+				))
+			}
+			return out, nil
+		})
+	}
+}
+
+// ErrResourceForbidden 资源不存在或不属于调用方，两种情况不做区分，避免泄露资源是否存在
+var ErrResourceForbidden = errors.New("resource not found or access denied")
+
+// requireCaller 资源所属用户必须与 ctx 中的调用方一致；stdio 等不携带调用方身份的传输一律拒绝
+func requireCaller(ctx context.Context, uri, owner string) error {
+	caller, ok := utils.ExtractUserID(ctx)
+	if !ok || owner == "" || caller != owner {
+		return fmt.Errorf("%w: %s", ErrResourceForbidden, uri)
+	}
+	return nil
+}
+
+// resourceOwner 解析资源 URI 的所属用户，供订阅时校验：todo/course 的首段即用户 ID，summary 需要查对话归属
+func resourceOwner(repo repository.MCPRepository) resource_set.Owner {
+	return func(ctx context.Context, uri string) (string, error) {
+		scheme, segments, err := utils.ParseResourceURI(uri)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case scheme == constant.MCPResourceSchemeTodo && len(segments) <= 2,
+			scheme == constant.MCPResourceSchemeCourse && len(segments) == 2:
+			return segments[0], nil
+		case scheme == constant.MCPResourceSchemeSummary && len(segments) == 1:
+			return repo.GetConversationUserID(ctx, segments[0])
+		}
+		return "", fmt.Errorf("unsupported resource uri %q", uri)
+	}
+}
+
+// summaryItem summary:// 资源返回的结构，tags/notes 本身就是 JSON
+type summaryItem struct {
+	ConversationID string          `json:"conversation_id"`
+	Summary        string          `json:"summary"`
+	Tags           json.RawMessage `json:"tags,omitempty"`
+	Notes          json.RawMessage `json:"notes,omitempty"`
+	UpdatedAt      string          `json:"updated_at"`
+}
+
+func newSummaryItem(s *model.Summaries) summaryItem {
+	item := summaryItem{
+		ConversationID: s.ConversationID,
+		Summary:        s.SummaryText,
+		UpdatedAt:      s.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if json.Valid([]byte(s.Tags)) {
+		item.Tags = json.RawMessage(s.Tags)
+	}
+	if json.Valid([]byte(s.Notes)) {
+		item.Notes = json.RawMessage(s.Notes)
+	}
+	return item
+}
+
+// templateArg 取出 URI 模板中匹配到的变量
+func templateArg(req mcp.ReadResourceRequest, name string) (string, error) {
+	var v string
+	switch x := req.Params.Arguments[name].(type) {
+	case []string:
+		if len(x) > 0 {
+			v = x[0]
+		}
+	case string:
+		v = x
+	}
+	if v == "" {
+		return "", fmt.Errorf("invalid resource uri %q: missing %s", req.Params.URI, name)
+	}
+	return v, nil
+}
+
+func jsonResource(uri string, v any) ([]mcp.ResourceContents, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal resource: %w", err)
+	}
+	return []mcp.ResourceContents{mcp.TextResourceContents{
+		URI:      uri,
+		MIMEType: resourceMIMEType,
+		Text:     string(data),
+	}}, nil
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
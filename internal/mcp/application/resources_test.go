@@ -0,0 +1,140 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeRepo 内存中的 MCPRepository：todo 按 user_id 归属，对话 c1 属于 u1
+type fakeRepo struct{}
+
+func (fakeRepo) ListTodosByUserID(_ context.Context, userID string) ([]*model.Todolists, error) {
+	return []*model.Todolists{{ID: "t1", UserID: userID, Title: "todo of " + userID}}, nil
+}
+
+func (fakeRepo) GetTodoByID(_ context.Context, userID, id string) (*model.Todolists, error) {
+	if id != "t1" {
+		return nil, nil
+	}
+	return &model.Todolists{ID: id, UserID: userID, Title: "todo of " + userID}, nil
+}
+
+func (fakeRepo) GetSummaryByConversationID(_ context.Context, conversationID string) (*model.Summaries, error) {
+	return &model.Summaries{ConversationID: conversationID, SummaryText: "summary of " + conversationID}, nil
+}
+
+func (fakeRepo) GetConversationUserID(_ context.Context, conversationID string) (string, error) {
+	if conversationID == "c1" {
+		return "u1", nil
+	}
+	return "", nil
+}
+
+func (fakeRepo) ListSummariesByUserID(context.Context, string) ([]*model.Summaries, error) {
+	return nil, nil
+}
+
+func TestUserDataResources(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer cache.Close()
+	mr.Set("course:u1:2024-2025-1", `[{"name":"高等数学"}]`)
+
+	rs := resource_set.NewResourceSet(WithUserDataResources(fakeRepo{}, cache))
+	core := server.NewMCPServer("test", "0.0.1", server.WithResourceCapabilities(true, false))
+	for _, tpl := range rs.Templates {
+		core.AddResourceTemplate(*tpl, rs.HandlerFunc[tpl.URITemplate.Raw()])
+	}
+	cli, err := client.NewInProcessClient(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if _, err := cli.Initialize(context.Background(), mcp.InitializeRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	// 进程内传输直接把 ctx 交给 server，这里用 ctx 模拟传输层写入的调用方身份
+	read := func(caller, uri string) (string, error) {
+		ctx := context.Background()
+		if caller != "" {
+			ctx = utils.WithUserID(ctx, caller)
+		}
+		res, err := cli.ReadResource(ctx, mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: uri}})
+		if err != nil {
+			return "", err
+		}
+		return res.Contents[0].(mcp.TextResourceContents).Text, nil
+	}
+	uris := []string{
+		utils.TodoListResourceURI("u1"),
+		utils.TodoResourceURI("u1", "t1"),
+		utils.CourseResourceURI("u1", "2024-2025-1"),
+		utils.SummaryResourceURI("c1"),
+	}
+
+	Convey("user data resources", t, func() {
+		Convey("templates match the uris built by utils", func() {
+			want := []string{"todo of u1", "todo of u1", "高等数学", "summary of c1"}
+			for i, uri := range uris {
+				text, err := read("u1", uri)
+				So(err, ShouldBeNil)
+				So(text, ShouldContainSubstring, want[i])
+			}
+		})
+
+		Convey("refuses resources of another user", func() {
+			for _, uri := range uris {
+				_, err := read("u2", uri)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, ErrResourceForbidden.Error())
+			}
+		})
+
+		Convey("refuses callers without an identity", func() {
+			for _, uri := range uris {
+				_, err := read("", uri)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, ErrResourceForbidden.Error())
+			}
+		})
+
+		Convey("does not reveal whether another user's conversation exists", func() {
+			_, missing := read("u1", utils.SummaryResourceURI("nope"))
+			So(missing, ShouldNotBeNil)
+			So(missing.Error(), ShouldContainSubstring, ErrResourceForbidden.Error())
+		})
+
+		Convey("owner resolves every template and rejects anything else", func() {
+			ctx := context.Background()
+			for _, uri := range uris {
+				owner, err := rs.Owner(ctx, uri)
+				So(err, ShouldBeNil)
+				So(owner, ShouldEqual, "u1")
+			}
+			owner, err := rs.Owner(ctx, utils.SummaryResourceURI("nope"))
+			So(err, ShouldBeNil)
+			So(owner, ShouldBeEmpty)
+			for _, uri := range []string{"todo://u1/t1/extra", "course://u1", "summary://c1/x", "file:///etc/passwd", "todo:/u1", "todo://"} {
+				_, err := rs.Owner(ctx, uri)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("requireCaller wraps ErrResourceForbidden", func() {
+			err := requireCaller(utils.WithUserID(context.Background(), "u1"), "todo://u2", "u2")
+			So(errors.Is(err, ErrResourceForbidden), ShouldBeTrue)
+			So(requireCaller(utils.WithUserID(context.Background(), "u1"), "todo://u1", "u1"), ShouldBeNil)
+		})
+	})
+}
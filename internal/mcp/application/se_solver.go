@@ -2,56 +2,167 @@ package application
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/htmath"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/openai/openai-go/v2"
 )
 
-var instance *AISESolver
-
 type AISESolver struct {
 	aiProviderCli *ai_provider.Client
 }
 
 func NewAISESolver(aiProviderCli *ai_provider.Client) *AISESolver {
-	instance = &AISESolver{
+	return &AISESolver{
 		aiProviderCli: aiProviderCli,
 	}
-	return instance
 }
 
-func WithAIScienceAndEngineeringBuildHtmlTool() tool_set.Option {
+func WithAIScienceAndEngineeringBuildHtmlTool(solver *AISESolver) tool_set.Option {
 	return func(toolSet *tool_set.ToolSet) {
 		newTool := mcp.NewTool(
 			"build_html_to_solve_science_and_engineering_problem",
 			mcp.WithDescription("当用户遇到学习问题上的困难时，通过 <htmath> 特殊标签的使用规范来帮助用户理解数学概念和绘制图像, 可以在图像后面加上对问题的辅助解析"),
 			mcp.WithString("question", mcp.Required(), mcp.Description("用户提出的科学或工程相关的问题")),
+			mcp.WithBoolean("as_resource", mcp.Description("是否额外以 text/html 嵌入资源返回生成的页面，默认 false")),
 		)
 		toolSet.Tools = append(toolSet.Tools, &newTool)
-		toolSet.HandlerFunc[newTool.Name] = AIScienceAndEngineeringBuildHtml
+		toolSet.HandlerFunc[newTool.Name] = solver.BuildHtml
+	}
+}
+
+// BuildHtml 生成页面；内部模型调用的 token 用量放在结果的 _meta 中回报，由 host 计入调用方的配额
+func (s *AISESolver) BuildHtml(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var usage []ai_provider.Usage
+	ctx = ai_provider.WithUsageRecorder(ctx, func(u ai_provider.Usage) {
+		usage = append(usage, u)
+	})
+	res, err := s.buildHtml(ctx, req)
+	if res != nil && len(usage) > 0 {
+		res.Meta = mcp.NewMetaFromMap(map[string]any{constant.MCPMetaTokenUsage: usage})
 	}
+	return res, err
 }
 
-func AIScienceAndEngineeringBuildHtml(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	question := args["question"].(string)
+func (s *AISESolver) buildHtml(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	question := strings.TrimSpace(req.GetString("question", ""))
 	if question == "" {
 		return mcp.NewToolResultError("missing required arg: question"), nil
 	}
-	resp, err := instance.aiProviderCli.ChatOpenAI(ctx, openai.ChatCompletionNewParams{
-		Model: openai.ChatModel(config.AiProvider.Model),
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage(systemPromptHTMLPrinter),
-			openai.UserMessage(question),
-		},
-	})
+	progress := tool_set.NewProgress(ctx, req)
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(systemPromptHTMLPrinter),
+		openai.UserMessage(question),
+	}
+
+	// 校验不通过时把问题反馈给模型重新生成；次数用尽仍有 htmath 片段时返回清洗后的结果
+	var (
+		output   string
+		problems []string
+	)
+	for attempt := 1; attempt <= constant.HtmathMaxAttempts; attempt++ {
+		var err error
+		output, err = s.generateHtml(ctx, messages, progress, attempt)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		problems = validateHtmath(output)
+		if len(problems) == 0 {
+			break
+		}
+		logger.Warnf("htmath: attempt %d/%d failed validation: %s", attempt, constant.HtmathMaxAttempts, strings.Join(problems, "; "))
+		messages = append(messages,
+			openai.AssistantMessage(output),
+			openai.UserMessage("上面的输出未通过校验，请修正以下问题后完整地重新输出：\n- "+strings.Join(problems, "\n- ")),
+		)
+	}
+
+	text, pages, err := sanitizeHtmath(output)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return mcp.NewToolResultError(fmt.Sprintf("htmath validation failed: %v; problems: %s", err, strings.Join(problems, "; "))), nil
+	}
+	result := mcp.NewToolResultText(text)
+	if req.GetBool("as_resource", false) {
+		for _, page := range pages {
+			result.Content = append(result.Content, mcp.NewEmbeddedResource(mcp.TextResourceContents{
+				URI:      htmath.ResourceURI(page),
+				MIMEType: constant.HtmathResourceMIME,
+				Text:     htmath.Document(page),
+			}))
+		}
+	}
+	return result, nil
+}
+
+// generateHtml 流式生成一次，按已输出字数汇报进度
+func (s *AISESolver) generateHtml(ctx context.Context, messages []openai.ChatCompletionMessageParamUnion, progress *tool_set.Progress, attempt int) (string, error) {
+	var (
+		content strings.Builder
+		n       int
+	)
+	err := s.aiProviderCli.ChatStreamOpenAI(ctx, openai.ChatCompletionNewParams{
+		Model:    openai.ChatModel(config.AiProvider.Model),
+		Messages: messages,
+	}, func(chunk *openai.ChatCompletionChunk) error {
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			return nil
+		}
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		n += utf8.RuneCountInString(delta)
+		progress.Throttled(float64(n), 0, fmt.Sprintf("attempt %d: generated %d characters", attempt, n))
+		return nil
+	})
+	return content.String(), err
+}
+
+// validateHtmath 输出必须至少包含一个 <htmath> 片段，且每个片段都通过校验
+func validateHtmath(output string) []string {
+	blocks := htmath.Extract(output)
+	if len(blocks) == 0 {
+		return []string{"输出中没有 <htmath> 片段"}
+	}
+	var problems []string
+	for i, b := range blocks {
+		for _, p := range htmath.Validate(b.HTML) {
+			problems = append(problems, fmt.Sprintf("htmath #%d: %s", i+1, p))
+		}
+	}
+	return problems
+}
+
+// sanitizeHtmath 清洗每个 <htmath> 片段并按前端约定重新包装，返回替换后的文本与清洗后的 HTML
+func sanitizeHtmath(output string) (string, []string, error) {
+	blocks := htmath.Extract(output)
+	if len(blocks) == 0 {
+		return "", nil, errors.New("no <htmath> block in model output")
+	}
+	var (
+		b     strings.Builder
+		pages = make([]string, 0, len(blocks))
+		last  int
+	)
+	for _, block := range blocks {
+		clean, err := htmath.Sanitize(block.HTML)
+		if err != nil {
+			return "", nil, err
+		}
+		b.WriteString(output[last:block.Start])
+		b.WriteString(htmath.Wrap(clean))
+		last = block.End
+		pages = append(pages, clean)
 	}
-	return mcp.NewToolResultText(resp.Choices[0].Message.Content), nil
+	b.WriteString(output[last:])
+	return b.String(), pages, nil
 }
 
 const systemPromptHTMLPrinter = `
@@ -60,6 +171,8 @@ const systemPromptHTMLPrinter = `
 2. 使用<htmath>标签渲染HTML内容，特别适合数学图形和函数可视化，格式为<htmath>HTML代码</htmath>
 3. 你可以正常使用Markdown格式化文本，也可以使用MathJax展示数学公式。在html图像中尽量使用中文进行展示
 4. 在讲解数学知识时，你会充分利用你的<htmath>能力来帮助用户更好地理解各种概念。
+5. <htmath> 中的 HTML 会被校验：标签必须正确闭合；外部脚本只能通过 https 从 cdn.plot.ly、cdn.jsdelivr.net、cdnjs.cloudflare.com、unpkg.com 加载；
+   不要使用 onclick 等事件属性（改用 addEventListener），脚本中不要发起网络请求、访问 cookie/localStorage、使用 eval 或跳转页面。
 
 示例:
 - 当用户想要可视化sin(x)曲线，可以回复：<htmath><html>&lt;div id="plot"&gt;&lt;/div&gt;
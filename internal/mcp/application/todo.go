@@ -5,41 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/FantasyRL/go-mcp-demo/internal/mcp/infra"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/repository"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
 // WithTodoTools 注册待办事项相关的 MCP 工具
-func WithTodoTools() tool_set.Option {
+func WithTodoTools(repo repository.MCPRepository) tool_set.Option {
 	return func(ts *tool_set.ToolSet) {
-		// 初始化 repository
-		repo := infra.NewMCPRepository()
-
 		// 定义 get_todos 工具
 		tool := mcp.NewTool(
 			"get_todos",
 			mcp.WithDescription("获取指定用户的待办事项列表"),
-			mcp.WithString("user_id", mcp.Required(), mcp.Description("用户ID")),
+			mcp.WithString("user_id", mcp.Required(), mcp.MinLength(1), mcp.Description("用户ID")),
 		)
 
 		// 注册工具
 		ts.Tools = append(ts.Tools, &tool)
-		ts.HandlerFunc[tool.Name] = func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			// 解析参数
-			args := req.GetArguments()
-			rawUserID, ok := args["user_id"]
-			if !ok {
-				return mcp.NewToolResultError("missing required arg: user_id"), nil
-			}
-
-			userID, ok := rawUserID.(string)
-			if !ok || userID == "" {
-				return mcp.NewToolResultError("user_id must be a non-empty string"), nil
-			}
-
+		ts.HandlerFunc[tool.Name] = tool_set.Typed(func(ctx context.Context, req mcp.CallToolRequest, args getTodosArgs) (*mcp.CallToolResult, error) {
 			// 查询数据库
-			todos, err := repo.ListTodosByUserID(ctx, userID)
+			todos, err := repo.ListTodosByUserID(ctx, args.UserID)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Error querying todos: %v", err)), nil
 			}
@@ -49,37 +35,9 @@ func WithTodoTools() tool_set.Option {
 				return mcp.NewToolResultText("[]"), nil
 			}
 
-			// 构造返回数据结构
-			type TodoItem struct {
-				ID        string `json:"id"`
-				Title     string `json:"title"`
-				Content   string `json:"content"`
-				StartTime string `json:"start_time"`
-				EndTime   string `json:"end_time"`
-				IsAllDay  int16  `json:"is_all_day"`
-				Status    int16  `json:"status"`
-				Priority  int16  `json:"priority"`
-				Category  string `json:"category"`
-			}
-
-			var items []TodoItem
+			items := make([]todoItem, 0, len(todos))
 			for _, todo := range todos {
-				category := ""
-				if todo.Category != nil {
-					category = *todo.Category
-				}
-
-				items = append(items, TodoItem{
-					ID:        todo.ID,
-					Title:     todo.Title,
-					Content:   todo.Content,
-					StartTime: todo.StartTime.Format("2006-01-02 15:04:05"),
-					EndTime:   todo.EndTime.Format("2006-01-02 15:04:05"),
-					IsAllDay:  todo.IsAllDay,
-					Status:    todo.Status,
-					Priority:  todo.Priority,
-					Category:  category,
-				})
+				items = append(items, newTodoItem(todo))
 			}
 
 			// 序列化为 JSON
@@ -89,6 +47,41 @@ func WithTodoTools() tool_set.Option {
 			}
 
 			return mcp.NewToolResultText(string(jsonData)), nil
-		}
+		})
+	}
+}
+
+type getTodosArgs struct {
+	UserID string `json:"user_id"`
+}
+
+// todoItem get_todos 与 todo:// 资源返回的待办结构
+type todoItem struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	IsAllDay  int16  `json:"is_all_day"`
+	Status    int16  `json:"status"`
+	Priority  int16  `json:"priority"`
+	Category  string `json:"category"`
+}
+
+func newTodoItem(todo *model.Todolists) todoItem {
+	category := ""
+	if todo.Category != nil {
+		category = *todo.Category
+	}
+	return todoItem{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		Content:   todo.Content,
+		StartTime: todo.StartTime.Format("2006-01-02 15:04:05"),
+		EndTime:   todo.EndTime.Format("2006-01-02 15:04:05"),
+		IsAllDay:  todo.IsAllDay,
+		Status:    todo.Status,
+		Priority:  todo.Priority,
+		Category:  category,
 	}
 }
@@ -8,7 +8,8 @@ import (
 )
 
 // ===== 辅助：构造目录树（纯 Go, depth/ignore 简化） =====
-func buildTreeText(root string, maxDepth int, ignores []string) (string, error) {
+// hidden 用于隐藏敏感条目（传入绝对路径），可为 nil
+func buildTreeText(root string, maxDepth int, ignores []string, hidden func(string) bool) (string, error) {
 	root = filepath.Clean(root)
 	info, err := os.Stat(root)
 	if err != nil {
@@ -40,7 +41,7 @@ func buildTreeText(root string, maxDepth int, ignores []string) (string, error)
 		}
 		for i, e := range entries {
 			name := e.Name()
-			if filter(name) {
+			if filter(name) || (hidden != nil && hidden(filepath.Join(dir, name))) {
 				continue
 			}
 			isLast := i == len(entries)-1
@@ -4,105 +4,96 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"net/url"
 	"strings"
-	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/websearch"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/redis/go-redis/v9"
 )
 
-// DuckDuckGo Instant Answer API 结果部分结构
-type ddgInstant struct {
-	Heading       string `json:"Heading"`
-	AbstractURL   string `json:"AbstractURL"`
-	AbstractText  string `json:"AbstractText"`
-	RelatedTopics []struct {
-		Text     string `json:"Text"`
-		FirstURL string `json:"FirstURL"`
-	} `json:"RelatedTopics"`
-}
-
-// Option：注册 web.search 工具
-func WithWebSearchTool() tool_set.Option {
+// Option：注册 web_search 与配套的 web_fetch 工具，搜索后端由 mcp.web_search.provider 决定；
+// cache 为 nil 时不缓存抓取结果
+func WithWebSearchTool(cache *redis.Client) tool_set.Option {
 	return func(ts *tool_set.ToolSet) {
-		tool := mcp.NewTool(
-			"web.search",
-			mcp.WithDescription("Use DuckDuckGo Instant Answer API to fetch public information. Required arg: query"),
+		provider, err := websearch.NewProvider()
+		if err != nil {
+			logger.Errorf("web_search disabled: %v", err)
+			return
+		}
+		w := &webTools{provider: provider, fetcher: websearch.NewFetcher(cache)}
+
+		searchTool := mcp.NewTool(
+			"web_search",
+			mcp.WithDescription("Search the web and return titles, URLs and snippets. Use web_fetch to read a result page. Required arg: query"),
 			mcp.WithString("query", mcp.Required(), mcp.Description("Search query keywords")),
+			mcp.WithNumber("max_results", mcp.Description(fmt.Sprintf("Number of results (1-%d)", constant.WebSearchMaxResultsLimit))),
 		)
-		ts.Tools = append(ts.Tools, &tool)
-		ts.HandlerFunc[tool.Name] = WebSearchHandler
+		fetchTool := mcp.NewTool(
+			"web_fetch",
+			mcp.WithDescription("Download a web page and return its readable text (navigation, scripts and ads removed). Required arg: url"),
+			mcp.WithString("url", mcp.Required(), mcp.Description("http(s) URL to fetch")),
+			mcp.WithNumber("max_chars", mcp.Description("Maximum characters of text to return")),
+		)
+		ts.Tools = append(ts.Tools, &searchTool, &fetchTool)
+		ts.HandlerFunc[searchTool.Name] = w.HandleSearch
+		ts.HandlerFunc[fetchTool.Name] = w.HandleFetch
 	}
 }
 
-// 处理函数
-func WebSearchHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	rawQuery, ok := args["query"]
-	if !ok {
-		return mcp.NewToolResultError("missing required arg: query"), nil
-	}
-	query, _ := rawQuery.(string)
-	query = strings.TrimSpace(query)
+type webTools struct {
+	provider websearch.SearchProvider
+	fetcher  *websearch.Fetcher
+}
+
+func (w *webTools) HandleSearch(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query := strings.TrimSpace(req.GetString("query", ""))
 	if query == "" {
-		return mcp.NewToolResultError("query is empty"), nil
+		return mcp.NewToolResultError("missing required arg: query"), nil
 	}
 
-	// 请求 DuckDuckGo
-	api := "https://api.duckduckgo.com/"
-	params := url.Values{}
-	params.Set("q", query)
-	params.Set("format", "json")
-	params.Set("no_html", "1")
-	params.Set("skip_disambig", "1")
-
-	client := &http.Client{Timeout: 8 * time.Second}
-	resp, err := client.Get(api + "?" + params.Encode())
+	progress := tool_set.NewProgress(ctx, req)
+	progress.Report(0, 1, fmt.Sprintf("searching %s", w.provider.Name()))
+	results, err := w.provider.Search(ctx, query, req.GetInt("max_results", 0))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("request error: %v", err)), nil
-	}
-	defer resp.Body.Close()
-	body, _ := io.ReadAll(resp.Body)
-
-	var data ddgInstant
-	if err := json.Unmarshal(body, &data); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("decode error: %v", err)), nil
-	}
-
-	type item struct {
-		Title   string `json:"title"`
-		URL     string `json:"url"`
-		Snippet string `json:"snippet"`
-	}
-
-	results := make([]item, 0, 5)
-	for _, rt := range data.RelatedTopics {
-		if len(results) >= 5 {
-			break
-		}
-		results = append(results, item{
-			Title:   rt.Text,
-			URL:     rt.FirstURL,
-			Snippet: rt.Text,
-		})
-	}
-	if len(results) == 0 && (data.AbstractURL != "" || data.AbstractText != "") {
-		results = append(results, item{
-			Title:   data.Heading,
-			URL:     data.AbstractURL,
-			Snippet: data.AbstractText,
-		})
+		return mcp.NewToolResultError(fmt.Sprintf("search error: %v", err)), nil
 	}
 	if len(results) == 0 {
 		return mcp.NewToolResultText("no results"), nil
 	}
 
 	out, _ := json.Marshal(map[string]any{
-		"query":   query,
-		"results": results,
+		"query":    query,
+		"provider": w.provider.Name(),
+		"results":  results,
 	})
 	return mcp.NewToolResultText(string(out)), nil
 }
+
+func (w *webTools) HandleFetch(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	rawURL := strings.TrimSpace(req.GetString("url", ""))
+	if rawURL == "" {
+		return mcp.NewToolResultError("missing required arg: url"), nil
+	}
+
+	progress := tool_set.NewProgress(ctx, req)
+	progress.Report(0, 1, "fetching "+rawURL)
+	page, err := w.fetcher.Fetch(ctx, rawURL)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("fetch error: %v", err)), nil
+	}
+
+	text, truncated := page.Text, page.Truncated
+	if maxChars := req.GetInt("max_chars", 0); maxChars > 0 && maxChars < w.fetcher.MaxChars() {
+		if runes := []rune(text); len(runes) > maxChars {
+			text, truncated = string(runes[:maxChars]), true
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "### web_fetch: %s\n\n", page.Title)
+	fmt.Fprintf(&b, "url: %s\ncontent_type: %s\ntruncated: %v\ncached: %v\n\n", page.URL, page.ContentType, truncated, page.Cached)
+	b.WriteString(text)
+	return mcp.NewToolResultText(b.String()), nil
+}
@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/application"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// need 工具组依赖的外部资源，只为启用的工具组建立连接
+type need uint8
+
+const (
+	needDB need = 1 << iota
+	needCache
+	needAI
+)
+
+type toolGroup struct {
+	needs     need
+	tools     func(d *Dependencies) []tool_set.Option
+	resources func(d *Dependencies) []resource_set.Option
+}
+
+// toolGroups 工具组名 -> 依赖与注册的工具/资源，组名见 constant.MCPToolGroup*
+var toolGroups = map[string]toolGroup{
+	constant.MCPToolGroupTodo: {
+		needs: needDB | needCache,
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithTodoTools(d.Repository)}
+		},
+		resources: func(d *Dependencies) []resource_set.Option {
+			return []resource_set.Option{application.WithUserDataResources(d.Repository, d.ClientSet.Cache)}
+		},
+	},
+	constant.MCPToolGroupCourse: {
+		needs: needCache,
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithCourseTools(d.ClientSet.Cache)}
+		},
+	},
+	constant.MCPToolGroupWeb: {
+		needs: needCache,
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithWebSearchTool(d.ClientSet.Cache)}
+		},
+	},
+	constant.MCPToolGroupDev: {
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithDevRunnerTools()}
+		},
+	},
+	constant.MCPToolGroupTime: {
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithTimeTool(), application.WithLongRunningOperationTool()}
+		},
+	},
+	constant.MCPToolGroupSTEM: {
+		needs: needAI,
+		tools: func(d *Dependencies) []tool_set.Option {
+			return []tool_set.Option{application.WithMathTools(), application.WithAIScienceAndEngineeringBuildHtmlTool(d.SESolver)}
+		},
+	},
+}
+
+// ToolGroups 全部工具组名，按字母序
+func ToolGroups() []string {
+	names := make([]string, 0, len(toolGroups))
+	for name := range toolGroups {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// resolveGroups 校验组名并去重。为空时返回除 dev 外的全部工具组：
+// dev 组的 code_run/fs_write 会在本机执行命令、改写文件，只有显式列出时才启用
+func resolveGroups(groups []string) ([]string, error) {
+	if len(groups) == 0 {
+		return slices.DeleteFunc(ToolGroups(), func(name string) bool {
+			return name == constant.MCPToolGroupDev
+		}), nil
+	}
+	out := make([]string, 0, len(groups))
+	for _, name := range groups {
+		if _, ok := toolGroups[name]; !ok {
+			return nil, fmt.Errorf("unknown mcp tool group %q, available: %v", name, ToolGroups())
+		}
+		if !slices.Contains(out, name) {
+			out = append(out, name)
+		}
+	}
+	return out, nil
+}
+
+// Sets 一个 MCP 服务的工具、提示词与资源
+type Sets struct {
+	Tools     *tool_set.ToolSet
+	Prompts   *prompt_set.PromptSet
+	Resources *resource_set.ResourceSet
+}
+
+// BuildSets 按工具组构造工具集，并挂载 mcp.tools 配置的中间件链；提示词不依赖外部资源，总是提供
+func BuildSets(d *Dependencies, groups []string) (*Sets, error) {
+	groups, err := resolveGroups(groups)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		toolOpts     []tool_set.Option
+		resourceOpts []resource_set.Option
+	)
+	for _, name := range groups {
+		g := toolGroups[name]
+		toolOpts = append(toolOpts, g.tools(d)...)
+		if g.resources != nil {
+			resourceOpts = append(resourceOpts, g.resources(d)...)
+		}
+	}
+	toolOpts = append(toolOpts, tool_set.WithConfiguredMiddlewares(d.ClientSet.Cache))
+	sets := &Sets{
+		Tools:   tool_set.NewToolSet(toolOpts...),
+		Prompts: prompt_set.NewPromptSet(application.WithChatPrompts()),
+	}
+	if len(resourceOpts) > 0 {
+		sets.Resources = resource_set.NewResourceSet(resourceOpts...)
+	}
+	return sets, nil
+}
+
+// rateLimited 配置了任一工具的限流时需要 Redis
+func rateLimited() bool {
+	if config.MCP.Tools.Default.RateLimit > 0 {
+		return true
+	}
+	for _, r := range config.MCP.Tools.Rules {
+		if r.RateLimit > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package mcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResolveGroups(t *testing.T) {
+	Convey("resolveGroups", t, func() {
+		cases := []struct {
+			name   string
+			groups []string
+			want   []string
+			err    string
+		}{
+			{"empty enables every group except dev", nil,
+				[]string{constant.MCPToolGroupCourse, constant.MCPToolGroupSTEM, constant.MCPToolGroupTime, constant.MCPToolGroupTodo, constant.MCPToolGroupWeb}, ""},
+			{"dev is enabled when listed", []string{"dev"}, []string{"dev"}, ""},
+			{"duplicates are dropped in order", []string{"time", "dev", "time"}, []string{"time", "dev"}, ""},
+			{"unknown groups are rejected", []string{"time", "shell"}, nil, `unknown mcp tool group "shell"`},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				got, err := resolveGroups(c.groups)
+				if c.err != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, c.err)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, c.want)
+			})
+		}
+	})
+}
+
+func TestBuildSets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("mcp:\n  dev_runner:\n    roots: [\""+t.TempDir()+"\"]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.Load(path, constant.ServiceNameMCPLocal)
+
+	names := func(s *Sets) []string {
+		out := make([]string, 0, len(s.Tools.Tools))
+		for _, tool := range s.Tools.Tools {
+			out = append(out, tool.Name)
+		}
+		return out
+	}
+	d := &Dependencies{ClientSet: &base.ClientSet{}}
+
+	Convey("BuildSets", t, func() {
+		Convey("registers only the listed groups", func() {
+			sets, err := BuildSets(d, []string{"time"})
+			So(err, ShouldBeNil)
+			So(names(sets), ShouldNotContain, "code_run")
+			So(sets.Prompts, ShouldNotBeNil)
+			So(sets.Resources, ShouldBeNil)
+
+			sets, err = BuildSets(d, []string{"time", "dev", "dev"})
+			So(err, ShouldBeNil)
+			So(names(sets), ShouldContain, "code_run")
+			So(names(sets), ShouldContain, "fs_write")
+		})
+
+		Convey("rejects unknown groups", func() {
+			_, err := BuildSets(d, []string{"shell"})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 
 	"github.com/FantasyRL/go-mcp-demo/internal/mcp/repository"
-	"github.com/FantasyRL/go-mcp-demo/pkg/base"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
 	"gorm.io/gorm"
 )
@@ -18,13 +17,9 @@ type MCPInfra struct {
 }
 
 // NewMCPRepository creates a new MCPRepository instance
-func NewMCPRepository() repository.MCPRepository {
-	clientSet := base.GetGlobalClientSet()
-	if clientSet == nil {
-		panic("global ClientSet not initialized")
-	}
+func NewMCPRepository(db *gorm.DB) repository.MCPRepository {
 	return &MCPInfra{
-		db: clientSet.ActualDB,
+		db: db,
 	}
 }
 
@@ -43,3 +38,63 @@ func (r *MCPInfra) ListTodosByUserID(ctx context.Context, userID string) ([]*mod
 
 	return todos, nil
 }
+
+// GetTodoByID 获取用户的单条待办事项，不存在时返回 nil
+func (r *MCPInfra) GetTodoByID(ctx context.Context, userID string, id string) (*model.Todolists, error) {
+	var todo model.Todolists
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&todo).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// GetSummaryByConversationID 获取对话的总结，不存在时返回 nil
+func (r *MCPInfra) GetSummaryByConversationID(ctx context.Context, conversationID string) (*model.Summaries, error) {
+	var summary model.Summaries
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ?", conversationID).
+		First(&summary).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// GetConversationUserID 获取对话所属的用户 ID，对话不存在时返回空串
+func (r *MCPInfra) GetConversationUserID(ctx context.Context, conversationID string) (string, error) {
+	var conversation model.Conversations
+	err := r.db.WithContext(ctx).
+		Select("user_id").
+		Where("id = ?", conversationID).
+		First(&conversation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return conversation.UserID, nil
+}
+
+// ListSummariesByUserID 获取用户所有对话的总结
+func (r *MCPInfra) ListSummariesByUserID(ctx context.Context, userID string) ([]*model.Summaries, error) {
+	var summaries []*model.Summaries
+	conversations := r.db.Model(&model.Conversations{}).Select("id").Where("user_id = ?", userID)
+	err := r.db.WithContext(ctx).
+		Where("conversation_id IN (?)", conversations).
+		Order("created_at DESC").
+		Find(&summaries).Error
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
@@ -2,20 +2,53 @@ package mcp
 
 import (
 	"github.com/FantasyRL/go-mcp-demo/internal/mcp/application"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/infra"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/repository"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 )
 
-func InjectDependencies() {
-	// Inject dependencies here
+// Dependencies MCP 服务各工具组所需的依赖，构造后显式传给 application 中的 Option，
+// 同一进程内的多个 MCP 服务可以共享同一份依赖；未启用的工具组用不到的依赖为 nil
+type Dependencies struct {
+	ClientSet  *base.ClientSet
+	Repository repository.MCPRepository
+	SESolver   *application.AISESolver
+}
 
-	AIProviderClient := ai_provider.NewAiProviderClient()
-	application.NewAISESolver(AIProviderClient)
+// InjectDependencies 只为 groups 中的工具组建立所需的连接，groups 为空时视为启用除 dev 外的全部工具组
+func InjectDependencies(groups []string) (*Dependencies, error) {
+	groups, err := resolveGroups(groups)
+	if err != nil {
+		return nil, err
+	}
+	var needs need
+	for _, name := range groups {
+		needs |= toolGroups[name].needs
+	}
+	if rateLimited() {
+		needs |= needCache
+	}
 
-	// 初始化全局 ClientSet，包含数据库连接
-	clientSet := base.NewClientSet(
-		base.WithDB(),
-		base.WithCache(),
-	)
-	base.SetGlobalClientSet(clientSet)
+	// ClientSet 包含数据库与 Redis 连接
+	var opts []base.Option
+	if needs&needDB != 0 {
+		opts = append(opts, base.WithDB())
+	}
+	if needs&needCache != 0 {
+		opts = append(opts, base.WithCache())
+	}
+	d := &Dependencies{ClientSet: base.NewClientSet(opts...)}
+	if d.ClientSet.ActualDB != nil {
+		d.Repository = infra.NewMCPRepository(d.ClientSet.ActualDB)
+	}
+	if needs&needAI != 0 {
+		cli, err := ai_provider.NewAiProviderClient()
+		if err != nil {
+			d.ClientSet.Close()
+			return nil, err
+		}
+		d.SESolver = application.NewAISESolver(cli)
+	}
+	return d, nil
 }
@@ -10,4 +10,12 @@ import (
 type MCPRepository interface {
 	// ListTodosByUserID 获取用户的所有待办事项列表
 	ListTodosByUserID(ctx context.Context, userID string) ([]*model.Todolists, error)
+	// GetTodoByID 获取用户的单条待办事项，不存在时返回 nil
+	GetTodoByID(ctx context.Context, userID string, id string) (*model.Todolists, error)
+	// GetSummaryByConversationID 获取对话的总结，不存在时返回 nil
+	GetSummaryByConversationID(ctx context.Context, conversationID string) (*model.Summaries, error)
+	// GetConversationUserID 获取对话所属的用户 ID，对话不存在时返回空串
+	GetConversationUserID(ctx context.Context, conversationID string) (string, error)
+	// ListSummariesByUserID 获取用户所有对话的总结
+	ListSummariesByUserID(ctx context.Context, userID string) ([]*model.Summaries, error)
 }
@@ -0,0 +1,83 @@
+package ai_provider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// breaker 单个模型服务的熔断器：连续失败达到阈值后熔断，到期后放行一次探测请求，
+// 探测成功恢复，失败则重新熔断。只有可重试的服务端失败计入
+type breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // 零值表示未熔断
+	probing  bool
+}
+
+func newBreaker(name string) *breaker {
+	cfg := config.AiProvider.CircuitBreaker
+	b := &breaker{name: name, threshold: cfg.FailureThreshold, openTimeout: cfg.OpenTimeout}
+	if b.threshold == 0 {
+		b.threshold = constant.AiProviderBreakerThreshold
+	}
+	if b.openTimeout <= 0 {
+		b.openTimeout = constant.AiProviderBreakerOpenTimeout
+	}
+	return b
+}
+
+// allow 熔断期间拒绝请求；到期后只放行一个探测请求
+func (b *breaker) allow() bool {
+	if b.threshold < 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openedAt.IsZero() {
+		return true
+	}
+	if b.probing || time.Since(b.openedAt) < b.openTimeout {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// release 放行的请求因与服务无关的原因（如调用方取消）结束，不影响熔断状态
+func (b *breaker) release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// done 记录一次放行请求的结果，e 为 nil 或不可重试的错误都表示服务正常响应
+func (b *breaker) done(e *Error) {
+	if b.threshold < 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if e == nil || !e.retryable() {
+		if !b.openedAt.IsZero() {
+			logger.Infof("ai provider %s: circuit closed", b.name)
+		}
+		b.failures, b.openedAt = 0, time.Time{}
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold || !b.openedAt.IsZero() {
+		if b.openedAt.IsZero() {
+			logger.Warnf("ai provider %s: circuit open after %d consecutive failures, last: %s", b.name, b.failures, e.reason())
+		}
+		b.openedAt = time.Now()
+	}
+}
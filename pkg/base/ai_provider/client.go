@@ -12,80 +12,160 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/openai/openai-go/v2"
-	"github.com/openai/openai-go/v2/option"
 	"io"
-	"net"
+	"math/rand/v2"
 	"net/http"
-	"strings"
 	"time"
 )
 
+// Client 按模型名把请求路由到配置的模型服务，主服务 5xx/超时/限流时按 fallbacks 降级
 type Client struct {
-	mode         string
-	baseURL      string
-	httpClient   *http.Client
-	openaiClient *openai.Client
+	providers []*provider // 配置顺序，第一个为未匹配模型时的默认服务
+	byName    map[string]*provider
+	retry     retryPolicy
 }
 
-type ClientOptions struct {
-	BaseURL       string
-	RequestTimout time.Duration
+// retryPolicy 单个服务内的重试策略
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
 }
 
-// NewAiProviderClient 创建一个 AiProvider 客户端
-func NewAiProviderClient() *Client {
-	to := config.AiProvider.Options.RequestTimout
-	if to <= 0 {
-		to = 60 * time.Second
-	}
-	switch config.AiProvider.Mode {
-	case constant.AiProviderModeLocal:
-		// 本地 AiProvider
-		base := strings.TrimRight(config.AiProvider.BaseURL, "/") + "/v1" // AiProvider 的 OpenAI 兼容层
-		openaiCli := openai.NewClient(
-			option.WithAPIKey("ollama"),
-			option.WithBaseURL(base),
-		)
-		return &Client{
-			mode:    constant.AiProviderModeLocal,
-			baseURL: config.AiProvider.BaseURL,
-			httpClient: &http.Client{
-				Timeout: to,
-				Transport: &http.Transport{
-					Proxy: http.ProxyFromEnvironment,
-					DialContext: (&net.Dialer{
-						Timeout:   10 * time.Second,
-						KeepAlive: 30 * time.Second,
-					}).DialContext,
-					ForceAttemptHTTP2:     true,
-					MaxIdleConns:          100,
-					IdleConnTimeout:       90 * time.Second,
-					TLSHandshakeTimeout:   10 * time.Second,
-					ExpectContinueTimeout: 1 * time.Second,
-				},
-			},
-			openaiClient: &openaiCli,
-		}
-	case constant.AiProviderModeRemote:
-		// 远程 openAI-API
-		openaiCli := openai.NewClient(
-			option.WithAPIKey(config.AiProvider.Remote.APIKey),
-			option.WithBaseURL(config.AiProvider.Remote.BaseURL))
-		return &Client{
-			mode:         constant.AiProviderModeRemote,
-			baseURL:      config.AiProvider.Remote.BaseURL,
-			openaiClient: &openaiCli,
-		}
-	default:
-		logger.Errorf("unsupported mode: %s", config.AiProvider.Mode)
-		return nil
+func newRetryPolicy() retryPolicy {
+	cfg := config.AiProvider.Retry
+	r := retryPolicy{maxAttempts: cfg.MaxAttempts, baseDelay: cfg.BaseDelay, maxDelay: cfg.MaxDelay}
+	if r.maxAttempts <= 0 {
+		r.maxAttempts = constant.AiProviderRetryMaxAttempts
 	}
+	if r.baseDelay <= 0 {
+		r.baseDelay = constant.AiProviderRetryBaseDelay
+	}
+	if r.maxDelay <= 0 {
+		r.maxDelay = constant.AiProviderRetryMaxDelay
+	}
+	return r
+}
+
+// backoff 第 n 次失败后的等待：min(maxDelay, baseDelay*2^(n-1)) 内的随机值（full jitter）
+func (r retryPolicy) backoff(n int) time.Duration {
+	d := r.maxDelay
+	if n-1 < 32 {
+		if exp := r.baseDelay << (n - 1); exp > 0 && exp < d {
+			d = exp
+		}
+	}
+	return time.Duration(rand.Int64N(int64(d))) + 1
+}
 
+// NewAiProviderClient 按 ai_provider.providers（未配置时按 mode）创建客户端
+func NewAiProviderClient() (*Client, error) {
+	entries, err := providerEntries()
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{byName: make(map[string]*provider, len(entries)), retry: newRetryPolicy()}
+	for _, e := range entries {
+		p, err := newProvider(e)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := c.byName[p.name]; dup {
+			return nil, fmt.Errorf("ai provider: duplicated name %q", p.name)
+		}
+		c.providers = append(c.providers, p)
+		c.byName[p.name] = p
+	}
+	for _, p := range c.providers {
+		for _, name := range p.fallbacks {
+			if _, ok := c.byName[name]; !ok {
+				return nil, fmt.Errorf("ai provider %s: unknown fallback %q", p.name, name)
+			}
+		}
+	}
+	return c, nil
+}
+
+// withFallback 依次尝试 route 给出的服务；已向调用方输出内容（started）后不再降级。
+// 服务端失败以 *Error 返回，调用方取消与回调返回的错误原样返回
+func (c *Client) withFallback(ctx context.Context, model string, native bool, call func(t target) (started bool, err error)) error {
+	targets := c.route(model, native)
+	if len(targets) == 0 {
+		return fmt.Errorf("ai provider: no provider available for model %q", model)
+	}
+	var err error
+	for i, t := range targets {
+		var started bool
+		if started, err = c.attempt(ctx, t, call); err == nil {
+			return nil
+		}
+		var e *Error
+		if started || i == len(targets)-1 || !errors.As(err, &e) || !e.fallbackable() {
+			return err
+		}
+		next := targets[i+1]
+		logger.Warnf("ai provider %s (model %s) failed: %s, fallback to %s (model %s): %v",
+			t.p.name, t.model, e.reason(), next.p.name, next.model, err)
+		notifyFallback(ctx, Fallback{From: t.p.name, To: next.p.name, Model: next.model, Reason: e.reason()})
+	}
+	return err
+}
+
+// attempt 在单个服务上调用，可重试的失败按指数退避（带抖动）重试；
+// 429/5xx 带 Retry-After 时按其等待，超过 retry.max_delay 则不再重试
+func (c *Client) attempt(ctx context.Context, t target, call func(t target) (started bool, err error)) (bool, error) {
+	for n := 1; ; n++ {
+		if !t.p.breaker.allow() {
+			return false, &Error{Kind: ErrorKindCircuitOpen, Provider: t.p.name}
+		}
+		started, err := call(t)
+		var e *Error
+		if err != nil {
+			e = classify(ctx, t.p.name, err)
+		}
+		if e == nil {
+			if err == nil {
+				t.p.breaker.done(nil)
+			} else {
+				t.p.breaker.release()
+			}
+			return started, err
+		}
+		t.p.breaker.done(e)
+		if started || !e.retryable() || n >= c.retry.maxAttempts {
+			return started, e
+		}
+		delay := c.retry.backoff(n)
+		if e.RetryAfter > 0 {
+			if e.RetryAfter > c.retry.maxDelay {
+				return false, e
+			}
+			delay = e.RetryAfter
+		}
+		logger.Warnf("ai provider %s (model %s) attempt %d failed: %s, retry in %s", t.p.name, t.model, n, e.reason(), delay)
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
 }
 
 // Chat 调用 /api/chat，非流式
 func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
-	endpoint := fmt.Sprintf("%s/api/chat", c.baseURL)
+	var out *ChatResponse
+	err := c.withFallback(ctx, req.Model, true, func(t target) (bool, error) {
+		r := req
+		r.Model = t.model
+		resp, err := t.p.chat(ctx, r)
+		out = resp
+		return false, err
+	})
+	return out, err
+}
+
+func (p *provider) chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	endpoint := fmt.Sprintf("%s/api/chat", p.baseURL)
 	req.Stream = false
 
 	b, _ := json.Marshal(req)
@@ -96,7 +176,7 @@ func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, erro
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := p.httpClient.Do(httpReq)
 	if err != nil {
 		logger.Errorf("ollama.Chat Do request error: %v", err)
 		return nil, err
@@ -106,39 +186,48 @@ func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, erro
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		logger.Errorf("ollama.Chat error response: %s", string(body))
-		return nil, fmt.Errorf("ollama chat failed: %s - %s", resp.Status, string(body))
+		return nil, &statusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body), Header: resp.Header}
 	}
 	var cr ChatResponse
 	if err := json.NewDecoder(resp.Body).Decode(&cr); err != nil {
 		return nil, err
 	}
+	recordUsage(ctx, ollamaUsage(p.name, req.Model, &cr))
 	return &cr, nil
 }
 
 // ChatStream api/chat，流式
 func (c *Client) ChatStream(ctx context.Context, req ChatRequest, onChunk func(*ChatResponse) error) error {
-	endpoint := fmt.Sprintf("%s/api/chat", c.baseURL)
+	return c.withFallback(ctx, req.Model, true, func(t target) (bool, error) {
+		r := req
+		r.Model = t.model
+		return t.p.chatStream(ctx, r, onChunk)
+	})
+}
+
+func (p *provider) chatStream(ctx context.Context, req ChatRequest, onChunk func(*ChatResponse) error) (started bool, err error) {
+	endpoint := fmt.Sprintf("%s/api/chat", p.baseURL)
 	req.Stream = true
 
 	b, _ := json.Marshal(req)
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
 	if err != nil {
 		logger.Errorf("ollama.ChatStream NewRequestWithContext error: %v", err)
-		return err
+		return false, err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := p.httpClient.Do(httpReq)
 	if err != nil {
 		logger.Errorf("ollama.ChatStream Do request error: %v", err)
-		return err
+		return false, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		all, _ := io.ReadAll(resp.Body)
 		logger.Errorf("ollama chat stream error response: %s", string(all))
-		return fmt.Errorf("ollama chat stream failed: %s - %s", resp.Status, string(all))
+		return false, &statusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(all), Header: resp.Header}
 	}
 
 	sc := bufio.NewScanner(resp.Body)
@@ -154,19 +243,27 @@ func (c *Client) ChatStream(ctx context.Context, req ChatRequest, onChunk func(*
 		chunk := new(ChatResponse)
 		if err := json.Unmarshal(line, chunk); err != nil {
 			logger.Errorf("ollama.ChatStream json unmarshal chunk error: %v", err)
-			return err
+			return started, err
+		}
+		started = true
+		if chunk.Done {
+			recordUsage(ctx, ollamaUsage(p.name, req.Model, chunk))
 		}
 		if err := onChunk(chunk); err != nil {
 			if errors.Is(err, errno.OllamaInternalStopStream) {
-				return nil
+				// 调用方提前结束时，仍需读到 done 响应才能拿到用量
+				if !chunk.Done && usageRecorder(ctx) != nil {
+					drainOllamaUsage(ctx, p.name, req.Model, sc)
+				}
+				return started, nil
 			}
-			return err
+			return started, err
 		}
 		if chunk.Done {
 			break
 		}
 	}
-	return sc.Err()
+	return started, sc.Err()
 }
 
 // ChatStreamOpenAI 使用 OpenAI 兼容层流式聊天
@@ -175,32 +272,68 @@ func (c *Client) ChatStreamOpenAI(
 	req openai.ChatCompletionNewParams,
 	onChunk func(*openai.ChatCompletionChunk) error,
 ) error {
-	stream := c.openaiClient.Chat.Completions.NewStreaming(ctx, req)
+	return c.withFallback(ctx, req.Model, false, func(t target) (bool, error) {
+		r := req
+		r.Model = t.model
+		return t.p.chatStreamOpenAI(ctx, r, onChunk)
+	})
+}
+
+func (p *provider) chatStreamOpenAI(
+	ctx context.Context,
+	req openai.ChatCompletionNewParams,
+	onChunk func(*openai.ChatCompletionChunk) error,
+) (started bool, err error) {
+	if usageRecorder(ctx) != nil {
+		// 用量在 choices 为空的最后一个 chunk 中给出
+		req.StreamOptions.IncludeUsage = openai.Bool(true)
+	}
+	stream := p.openaiClient.Chat.Completions.NewStreaming(ctx, req)
 	defer stream.Close()
 	for stream.Next() {
 		chunk := stream.Current()
+		started = true
+		if chunk.JSON.Usage.Valid() {
+			recordUsage(ctx, openaiUsage(p.name, req.Model, chunk.Usage))
+		}
 		if err := onChunk(&chunk); err != nil {
 			if errors.Is(err, errno.OllamaInternalStopStream) {
-				return nil
+				// 调用方提前结束（如 finish_reason=tool_calls）时，用量仍在之后的 chunk 中
+				if usageRecorder(ctx) != nil {
+					for stream.Next() {
+						if c := stream.Current(); c.JSON.Usage.Valid() {
+							recordUsage(ctx, openaiUsage(p.name, req.Model, c.Usage))
+						}
+					}
+				}
+				return started, nil
 			}
-			return err
+			return started, err
 		}
 	}
 	if err := stream.Err(); err != nil {
-		logger.Errorf("openai.ChatStreamOpenAI stream error: %v", err)
-		return err
+		logger.Errorf("openai.ChatStreamOpenAI stream error (provider %s): %v", p.name, err)
+		return started, err
 	}
-	return nil
+	return started, nil
 }
 
 func (c *Client) ChatOpenAI(
 	ctx context.Context,
 	req openai.ChatCompletionNewParams,
 ) (*openai.ChatCompletion, error) {
-	resp, err := c.openaiClient.Chat.Completions.New(ctx, req)
-	if err != nil {
-		logger.Errorf("openai.ChatOpenAI error: %v", err)
-		return nil, err
-	}
-	return resp, nil
+	var out *openai.ChatCompletion
+	err := c.withFallback(ctx, req.Model, false, func(t target) (bool, error) {
+		r := req
+		r.Model = t.model
+		resp, err := t.p.openaiClient.Chat.Completions.New(ctx, r)
+		if err != nil {
+			logger.Errorf("openai.ChatOpenAI error (provider %s): %v", t.p.name, err)
+			return false, err
+		}
+		out = resp
+		recordUsage(ctx, openaiUsage(t.p.name, t.model, resp.Usage))
+		return false, nil
+	})
+	return out, err
 }
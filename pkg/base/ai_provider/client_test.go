@@ -0,0 +1,157 @@
+package ai_provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// flakyServer 前 failures 次请求返回 status（附带 header），之后返回正常的 chat completion
+func flakyServer(failures int32, status int, header http.Header, hits *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			http.Error(w, `{"error":{"message":"model not found"}}`, status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"x","object":"chat.completion","model":"m","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"ok"}}]}`)
+	}))
+}
+
+var testParams = openai.ChatCompletionNewParams{
+	Model:    "m",
+	Messages: []openai.ChatCompletionMessageParamUnion{openai.UserMessage("hi")},
+}
+
+func TestRetry(t *testing.T) {
+	Convey("retryable errors are retried on the same provider", t, func() {
+		var hits atomic.Int32
+		srv := flakyServer(2, http.StatusBadGateway, nil, &hits)
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "p", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		_, err = c.ChatOpenAI(context.Background(), testParams)
+		So(err, ShouldBeNil)
+		So(hits.Load(), ShouldEqual, 3)
+	})
+
+	Convey("exhausted retries return a typed error mapped to errno", t, func() {
+		var hits atomic.Int32
+		srv := flakyServer(100, http.StatusServiceUnavailable, nil, &hits)
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "p", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		_, err = c.ChatOpenAI(context.Background(), testParams)
+		So(hits.Load(), ShouldEqual, constant.AiProviderRetryMaxAttempts)
+		var e *Error
+		So(errors.As(err, &e), ShouldBeTrue)
+		So(e.Kind, ShouldEqual, ErrorKindServer)
+		So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.InternalAiUnavailableCode)
+	})
+
+	Convey("client errors are not retried and keep the provider message", t, func() {
+		var hits atomic.Int32
+		srv := flakyServer(100, http.StatusNotFound, nil, &hits)
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "p", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		_, err = c.ChatOpenAI(context.Background(), testParams)
+		So(hits.Load(), ShouldEqual, 1)
+		e := errno.ConvertErr(err)
+		So(e.ErrorCode, ShouldEqual, errno.InternalAiRequestCode)
+		So(e.ErrorMsg, ShouldContainSubstring, "model not found")
+	})
+
+	Convey("Retry-After beyond max_delay skips retries and falls back", t, func() {
+		var primaryHits, backupHits atomic.Int32
+		primary := flakyServer(100, http.StatusTooManyRequests, http.Header{"Retry-After": {"120"}}, &primaryHits)
+		defer primary.Close()
+		backup := flakyServer(0, http.StatusOK, nil, &backupHits)
+		defer backup.Close()
+		c, err := newTestClient(
+			config.AiProviderEntry{Name: "primary", Type: constant.AiProviderTypeOpenAI, BaseURL: primary.URL, Models: []string{"m"}, Fallbacks: []string{"backup"}},
+			config.AiProviderEntry{Name: "backup", Type: constant.AiProviderTypeOpenAI, BaseURL: backup.URL, Models: []string{"m"}},
+		)
+		So(err, ShouldBeNil)
+
+		_, err = c.ChatOpenAI(context.Background(), testParams)
+		So(err, ShouldBeNil)
+		So(primaryHits.Load(), ShouldEqual, 1)
+		So(backupHits.Load(), ShouldEqual, 1)
+	})
+
+	Convey("backoff stays within [1ns, min(max, base*2^(n-1))]", t, func() {
+		r := retryPolicy{maxAttempts: 5, baseDelay: 10 * time.Millisecond, maxDelay: 30 * time.Millisecond}
+		for i := 0; i < 100; i++ {
+			So(r.backoff(1), ShouldBeBetweenOrEqual, time.Duration(1), 10*time.Millisecond)
+			So(r.backoff(10), ShouldBeBetweenOrEqual, time.Duration(1), 30*time.Millisecond)
+		}
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	Convey("consecutive failures open the circuit and later probes close it", t, func() {
+		var primaryHits, backupHits atomic.Int32
+		primary := flakyServer(4, http.StatusInternalServerError, nil, &primaryHits)
+		defer primary.Close()
+		backup := flakyServer(0, http.StatusOK, nil, &backupHits)
+		defer backup.Close()
+		c, err := newTestClient(
+			config.AiProviderEntry{Name: "primary", Type: constant.AiProviderTypeOpenAI, BaseURL: primary.URL, Models: []string{"m"}, Fallbacks: []string{"backup"}},
+			config.AiProviderEntry{Name: "backup", Type: constant.AiProviderTypeOpenAI, BaseURL: backup.URL, Models: []string{"m"}},
+		)
+		So(err, ShouldBeNil)
+		c.retry.maxAttempts = 1
+		b := c.byName["primary"].breaker
+		b.threshold, b.openTimeout = 2, 50*time.Millisecond
+
+		for i := 0; i < 2; i++ {
+			_, err = c.ChatOpenAI(context.Background(), testParams)
+			So(err, ShouldBeNil)
+		}
+		So(primaryHits.Load(), ShouldEqual, 2)
+
+		// 熔断期间不再请求主服务
+		var got []Fallback
+		ctx := WithFallbackNotify(context.Background(), func(f Fallback) { got = append(got, f) })
+		_, err = c.ChatOpenAI(ctx, testParams)
+		So(err, ShouldBeNil)
+		So(primaryHits.Load(), ShouldEqual, 2)
+		So(got[0].Reason, ShouldEqual, "circuit open")
+
+		// 到期后放行探测：仍失败则重新熔断
+		time.Sleep(60 * time.Millisecond)
+		_, _ = c.ChatOpenAI(context.Background(), testParams)
+		So(primaryHits.Load(), ShouldEqual, 3)
+		_, _ = c.ChatOpenAI(context.Background(), testParams)
+		So(primaryHits.Load(), ShouldEqual, 3)
+
+		// 再次到期，探测成功后恢复
+		time.Sleep(60 * time.Millisecond)
+		_, _ = c.ChatOpenAI(context.Background(), testParams) // 第 4 次失败
+		time.Sleep(60 * time.Millisecond)
+		backupBefore := backupHits.Load()
+		_, err = c.ChatOpenAI(context.Background(), testParams)
+		So(err, ShouldBeNil)
+		So(primaryHits.Load(), ShouldEqual, 5)
+		So(backupHits.Load(), ShouldEqual, backupBefore)
+		So(b.allow(), ShouldBeTrue)
+	})
+}
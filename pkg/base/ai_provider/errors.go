@@ -0,0 +1,208 @@
+package ai_provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/openai/openai-go/v2"
+)
+
+// ErrorKind 模型服务调用失败的类别
+type ErrorKind int
+
+const (
+	ErrorKindRateLimited ErrorKind = iota + 1 // 429
+	ErrorKindServer                           // 5xx
+	ErrorKindTimeout                          // 请求超时
+	ErrorKindConnection                       // 连接失败或被重置
+	ErrorKindCircuitOpen                      // 服务已熔断，未发出请求
+	ErrorKindAuth                             // 401/403
+	ErrorKindRequest                          // 其它 4xx，重试与降级都无济于事
+)
+
+// Error 模型服务调用失败。实现了向 errno.ErrNo 的 errors.As 转换，API 层据此返回可读的错误信息
+type Error struct {
+	Kind       ErrorKind
+	Provider   string
+	StatusCode int
+	Message    string        // 服务返回的错误信息
+	RetryAfter time.Duration // 服务通过 Retry-After 要求的等待时间
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("ai provider %s: %s", e.Provider, e.reason())
+	}
+	return fmt.Sprintf("ai provider %s: %s: %v", e.Provider, e.reason(), e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// As 转换为 errno.ErrNo，不向用户暴露服务名与原始 SDK 错误
+func (e *Error) As(target any) bool {
+	t, ok := target.(*errno.ErrNo)
+	if !ok {
+		return false
+	}
+	switch e.Kind {
+	case ErrorKindRateLimited:
+		*t = errno.NewErrNo(errno.InternalAiRateLimitCode, "模型服务请求过于频繁，请稍后重试")
+	case ErrorKindTimeout:
+		*t = errno.NewErrNo(errno.InternalTimeoutErrorCode, "模型服务响应超时，请稍后重试")
+	case ErrorKindAuth:
+		*t = errno.NewErrNo(errno.InternalAiAuthCode, "模型服务鉴权失败，请联系管理员")
+	case ErrorKindRequest:
+		msg := "模型服务拒绝了请求"
+		if e.Message != "" {
+			msg += ": " + truncate(e.Message, constant.AiProviderErrorMessageMax)
+		}
+		*t = errno.NewErrNo(errno.InternalAiRequestCode, msg)
+	default:
+		*t = errno.NewErrNo(errno.InternalAiUnavailableCode, "模型服务暂时不可用，请稍后重试")
+	}
+	return true
+}
+
+// retryable 可在同一服务上重试，也计入熔断
+func (e *Error) retryable() bool {
+	switch e.Kind {
+	case ErrorKindRateLimited, ErrorKindServer, ErrorKindTimeout, ErrorKindConnection:
+		return true
+	}
+	return false
+}
+
+// fallbackable 可降级到备用服务
+func (e *Error) fallbackable() bool {
+	return e.retryable() || e.Kind == ErrorKindCircuitOpen
+}
+
+// reason 写入日志与 SSE 的失败原因
+func (e *Error) reason() string {
+	switch e.Kind {
+	case ErrorKindRateLimited:
+		return "rate limited (429)"
+	case ErrorKindServer:
+		return fmt.Sprintf("server error (%d)", e.StatusCode)
+	case ErrorKindTimeout:
+		return "timeout"
+	case ErrorKindConnection:
+		return "connection failed"
+	case ErrorKindCircuitOpen:
+		return "circuit open"
+	case ErrorKindAuth:
+		return fmt.Sprintf("unauthorized (%d)", e.StatusCode)
+	default:
+		return fmt.Sprintf("bad request (%d)", e.StatusCode)
+	}
+}
+
+// statusError Ollama 原生接口返回的非 200 响应
+type statusError struct {
+	StatusCode int
+	Status     string
+	Body       string
+	Header     http.Header
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("ollama chat failed: %s - %s", e.Status, e.Body)
+}
+
+// classify 把调用错误归类为 *Error；调用方取消与非服务端原因（如回调返回的错误）返回 nil
+func classify(ctx context.Context, providerName string, err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	e = &Error{Provider: providerName, Err: err}
+
+	var (
+		apiErr *openai.Error
+		stErr  *statusError
+		header http.Header
+	)
+	switch {
+	case errors.As(err, &apiErr):
+		e.StatusCode, e.Message = apiErr.StatusCode, apiErr.Message
+		if apiErr.Response != nil {
+			header = apiErr.Response.Header
+		}
+	case errors.As(err, &stErr):
+		e.StatusCode, e.Message, header = stErr.StatusCode, stErr.Body, stErr.Header
+	}
+	switch status := e.StatusCode; {
+	case status == http.StatusTooManyRequests:
+		e.Kind = ErrorKindRateLimited
+		e.RetryAfter = retryAfter(header)
+		return e
+	case status >= http.StatusInternalServerError:
+		e.Kind = ErrorKindServer
+		e.RetryAfter = retryAfter(header)
+		return e
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		e.Kind = ErrorKindAuth
+		return e
+	case status >= http.StatusBadRequest:
+		e.Kind = ErrorKindRequest
+		return e
+	}
+
+	var (
+		netErr net.Error
+		opErr  *net.OpError
+	)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		e.Kind = ErrorKindTimeout
+	case errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || (errors.As(err, &opErr) && opErr.Op == "dial"):
+		e.Kind = ErrorKindConnection
+	default:
+		return nil
+	}
+	return e
+}
+
+// retryAfter 解析 Retry-After-Ms 与 Retry-After（秒数或 HTTP 日期）
+func retryAfter(h http.Header) time.Duration {
+	if h == nil {
+		return 0
+	}
+	if ms, err := strconv.ParseFloat(h.Get("Retry-After-Ms"), 64); err == nil && ms > 0 {
+		return time.Duration(ms * float64(time.Millisecond))
+	}
+	v := h.Get("Retry-After")
+	if sec, err := strconv.ParseFloat(v, 64); err == nil && sec > 0 {
+		return time.Duration(sec * float64(time.Second))
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "..."
+}
@@ -38,11 +38,13 @@ type ChatRequest struct {
 }
 
 type ChatResponse struct {
-	Model         string  `json:"model"`          // AiProvider 模型名 如 qwen3:4b
-	CreatedAt     string  `json:"created_at"`     // 响应时间
-	Message       Message `json:"message"`        // toolCalls不为空时则去执行工具
-	Done          bool    `json:"done"`           // 非流式时总是true，流式时表示是否结束
-	TotalDuration int64   `json:"total_duration"` // 整体耗时
+	Model           string  `json:"model"`             // AiProvider 模型名 如 qwen3:4b
+	CreatedAt       string  `json:"created_at"`        // 响应时间
+	Message         Message `json:"message"`           // toolCalls不为空时则去执行工具
+	Done            bool    `json:"done"`              // 非流式时总是true，流式时表示是否结束
+	TotalDuration   int64   `json:"total_duration"`    // 整体耗时
+	PromptEvalCount int64   `json:"prompt_eval_count"` // 输入 token 数，仅 done 时给出
+	EvalCount       int64   `json:"eval_count"`        // 输出 token 数，仅 done 时给出
 }
 
 // ParseToolArguments 解析ToolFunction
@@ -0,0 +1,213 @@
+package ai_provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+)
+
+// provider 一个模型服务：OpenAI 兼容服务，或 Ollama 实例（原生接口 + /v1 兼容层）
+type provider struct {
+	name         string
+	typ          string
+	baseURL      string // ollama 原生接口地址
+	models       []string
+	fallbacks    []string
+	httpClient   *http.Client
+	openaiClient *openai.Client
+	breaker      *breaker
+}
+
+func newProvider(e config.AiProviderEntry) (*provider, error) {
+	if e.Name == "" {
+		return nil, errors.New("ai provider: name is required")
+	}
+	to := e.Timeout
+	if to <= 0 {
+		to = constant.AiProviderDefaultTimeout
+	}
+	// 不设置 Client.Timeout：它包含读取整个响应体的时间，会截断耗时较长的流式回答。
+	// 只限制建连与首字节，流开始后由调用方的 ctx 控制
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   10 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			ResponseHeaderTimeout: to,
+		},
+	}
+	p := &provider{
+		name:       e.Name,
+		typ:        e.Type,
+		models:     e.Models,
+		fallbacks:  e.Fallbacks,
+		httpClient: httpClient,
+		breaker:    newBreaker(e.Name),
+	}
+	var openaiCli openai.Client
+	switch e.Type {
+	case constant.AiProviderTypeOllama:
+		apiKey := e.APIKey
+		if apiKey == "" {
+			apiKey = "ollama"
+		}
+		p.baseURL = strings.TrimRight(e.BaseURL, "/")
+		// AiProvider 的 OpenAI 兼容层
+		openaiCli = openai.NewClient(
+			option.WithAPIKey(apiKey),
+			option.WithBaseURL(p.baseURL+"/v1"),
+			option.WithHTTPClient(httpClient),
+			option.WithMaxRetries(0), // 重试由 Client 统一处理
+		)
+	case constant.AiProviderTypeOpenAI:
+		p.baseURL = e.BaseURL
+		openaiCli = openai.NewClient(
+			option.WithAPIKey(e.APIKey),
+			option.WithBaseURL(e.BaseURL),
+			option.WithHTTPClient(httpClient),
+			option.WithMaxRetries(0), // 重试由 Client 统一处理
+		)
+	default:
+		return nil, fmt.Errorf("ai provider %s: unsupported type %q", e.Name, e.Type)
+	}
+	p.openaiClient = &openaiCli
+	return p, nil
+}
+
+// providerEntries 未配置 providers 时按 mode 把原有的单一配置转换为一个 provider
+func providerEntries() ([]config.AiProviderEntry, error) {
+	if len(config.AiProvider.Providers) > 0 {
+		return config.AiProvider.Providers, nil
+	}
+	var models []string
+	if config.AiProvider.Model != "" {
+		models = []string{config.AiProvider.Model}
+	}
+	switch config.AiProvider.Mode {
+	case constant.AiProviderModeLocal:
+		return []config.AiProviderEntry{{
+			Name:    constant.AiProviderModeLocal,
+			Type:    constant.AiProviderTypeOllama,
+			BaseURL: config.AiProvider.BaseURL,
+			Models:  models,
+			Timeout: config.AiProvider.Options.RequestTimout,
+		}}, nil
+	case constant.AiProviderModeRemote:
+		return []config.AiProviderEntry{{
+			Name:    constant.AiProviderModeRemote,
+			Type:    constant.AiProviderTypeOpenAI,
+			BaseURL: config.AiProvider.Remote.BaseURL,
+			APIKey:  config.AiProvider.Remote.APIKey,
+			Models:  models,
+			Timeout: config.AiProvider.Options.RequestTimout,
+		}}, nil
+	default:
+		return nil, fmt.Errorf("ai provider: unsupported mode %q", config.AiProvider.Mode)
+	}
+}
+
+func (p *provider) serves(model string) bool {
+	for _, m := range p.models {
+		if ok, _ := path.Match(m, model); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// modelFor 降级到该服务时使用的模型：同名模型优先，否则为其第一个非通配模型
+func (p *provider) modelFor(model string) string {
+	if p.serves(model) {
+		return model
+	}
+	for _, m := range p.models {
+		if !strings.ContainsAny(m, "*?[") {
+			return m
+		}
+	}
+	return model
+}
+
+// target 一次尝试使用的服务与模型
+type target struct {
+	p     *provider
+	model string
+}
+
+// route 按模型名选出主服务（无匹配时为第一个服务），再按其 fallbacks 追加备用服务；
+// native 为 true 时只考虑 Ollama 实例
+func (c *Client) route(model string, native bool) []target {
+	usable := func(p *provider) bool {
+		return !native || p.typ == constant.AiProviderTypeOllama
+	}
+	var primary *provider
+	for _, p := range c.providers {
+		if usable(p) && p.serves(model) {
+			primary = p
+			break
+		}
+	}
+	if primary == nil {
+		for _, p := range c.providers {
+			if usable(p) {
+				primary = p
+				break
+			}
+		}
+	}
+	if primary == nil {
+		return nil
+	}
+	targets := []target{{p: primary, model: model}}
+	seen := map[string]struct{}{primary.name: {}}
+	for _, name := range primary.fallbacks {
+		p, ok := c.byName[name]
+		if _, dup := seen[name]; dup || !ok || !usable(p) {
+			continue
+		}
+		seen[name] = struct{}{}
+		targets = append(targets, target{p: p, model: p.modelFor(model)})
+	}
+	return targets
+}
+
+// Fallback 一次服务降级
+type Fallback struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Model  string `json:"model"`
+	Reason string `json:"reason"`
+}
+
+// FallbackFunc 接收本次调用中发生的服务降级
+type FallbackFunc func(Fallback)
+
+type fallbackKey struct{}
+
+// WithFallbackNotify 订阅 ctx 上模型调用的服务降级，用于把失败原因推给前端
+func WithFallbackNotify(ctx context.Context, fn FallbackFunc) context.Context {
+	return context.WithValue(ctx, fallbackKey{}, fn)
+}
+
+func notifyFallback(ctx context.Context, f Fallback) {
+	if fn, ok := ctx.Value(fallbackKey{}).(FallbackFunc); ok && fn != nil {
+		fn(f)
+	}
+}
@@ -0,0 +1,208 @@
+package ai_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// completionServer 返回固定状态码，或回显请求模型的 chat completion
+func completionServer(status int, hits *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if status != http.StatusOK {
+			// 避免 SDK 内置重试的退避拖慢测试
+			w.Header().Set("retry-after-ms", "1")
+			http.Error(w, `{"error":{"message":"boom"}}`, status)
+			return
+		}
+		var req struct {
+			Model string `json:"model"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"x","object":"chat.completion","model":%q,"choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"ok"}}]}`, req.Model)
+	}))
+}
+
+func newTestClient(entries ...config.AiProviderEntry) (*Client, error) {
+	config.AiProvider = &config.AiProviderConfig{
+		Providers: entries,
+		Retry:     config.AiRetryConfig{BaseDelay: time.Millisecond, MaxDelay: 50 * time.Millisecond},
+	}
+	return NewAiProviderClient()
+}
+
+func TestRoute(t *testing.T) {
+	Convey("route picks the provider by model and appends fallbacks", t, func() {
+		c, err := newTestClient(
+			config.AiProviderEntry{Name: "a", Type: constant.AiProviderTypeOpenAI, Models: []string{"gpt-*"}, Fallbacks: []string{"b", "c"}},
+			config.AiProviderEntry{Name: "b", Type: constant.AiProviderTypeOllama, Models: []string{"qwen3:*", "qwen3:1.7b"}},
+			config.AiProviderEntry{Name: "c", Type: constant.AiProviderTypeOpenAI, Models: []string{"gpt-4o"}},
+		)
+		So(err, ShouldBeNil)
+
+		ts := c.route("gpt-4o", false)
+		So(ts, ShouldHaveLength, 3)
+		So(ts[0].p.name, ShouldEqual, "a")
+		So(ts[1].p.name, ShouldEqual, "b")
+		So(ts[1].model, ShouldEqual, "qwen3:1.7b")
+		So(ts[2].model, ShouldEqual, "gpt-4o")
+
+		ts = c.route("qwen3:4b", false)
+		So(ts, ShouldHaveLength, 1)
+		So(ts[0].p.name, ShouldEqual, "b")
+
+		// 未匹配的模型交给第一个服务
+		So(c.route("unknown", false)[0].p.name, ShouldEqual, "a")
+		// 原生接口只走 Ollama 实例
+		So(c.route("gpt-4o", true)[0].p.name, ShouldEqual, "b")
+	})
+
+	Convey("invalid configuration is rejected", t, func() {
+		_, err := newTestClient(config.AiProviderEntry{Name: "a", Type: "bogus"})
+		So(err, ShouldNotBeNil)
+		_, err = newTestClient(config.AiProviderEntry{Name: "a", Type: constant.AiProviderTypeOpenAI, Fallbacks: []string{"missing"}})
+		So(err, ShouldNotBeNil)
+		_, err = newTestClient(
+			config.AiProviderEntry{Name: "a", Type: constant.AiProviderTypeOpenAI},
+			config.AiProviderEntry{Name: "a", Type: constant.AiProviderTypeOllama},
+		)
+		So(err, ShouldNotBeNil)
+
+		config.AiProvider = &config.AiProviderConfig{Mode: "bogus"}
+		_, err = NewAiProviderClient()
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestFallback(t *testing.T) {
+	Convey("ChatOpenAI falls back on retryable errors only", t, func() {
+		var primaryHits, backupHits atomic.Int32
+		backup := completionServer(http.StatusOK, &backupHits)
+		defer backup.Close()
+
+		for _, tc := range []struct {
+			status   int
+			fallback bool
+			reason   string
+		}{
+			{http.StatusServiceUnavailable, true, "server error (503)"},
+			{http.StatusTooManyRequests, true, "rate limited (429)"},
+			{http.StatusBadRequest, false, ""},
+		} {
+			primaryHits.Store(0)
+			backupHits.Store(0)
+			primary := completionServer(tc.status, &primaryHits)
+			c, err := newTestClient(
+				config.AiProviderEntry{Name: "primary", Type: constant.AiProviderTypeOpenAI, BaseURL: primary.URL, Models: []string{"big"}, Fallbacks: []string{"backup"}},
+				config.AiProviderEntry{Name: "backup", Type: constant.AiProviderTypeOpenAI, BaseURL: backup.URL, Models: []string{"small"}},
+			)
+			So(err, ShouldBeNil)
+
+			var got []Fallback
+			ctx := WithFallbackNotify(context.Background(), func(f Fallback) { got = append(got, f) })
+			resp, err := c.ChatOpenAI(ctx, openai.ChatCompletionNewParams{
+				Model:    "big",
+				Messages: []openai.ChatCompletionMessageParamUnion{openai.UserMessage("hi")},
+			})
+			primary.Close()
+
+			So(primaryHits.Load(), ShouldBeGreaterThan, 0)
+			if !tc.fallback {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeEmpty)
+				So(backupHits.Load(), ShouldEqual, 0)
+				continue
+			}
+			So(err, ShouldBeNil)
+			So(resp.Model, ShouldEqual, "small")
+			So(got, ShouldResemble, []Fallback{{From: "primary", To: "backup", Model: "small", Reason: tc.reason}})
+		}
+	})
+
+	Convey("connection failures fall back, caller cancellation does not", t, func() {
+		var hits atomic.Int32
+		backup := completionServer(http.StatusOK, &hits)
+		defer backup.Close()
+		dead := httptest.NewServer(http.NotFoundHandler())
+		dead.Close()
+
+		c, err := newTestClient(
+			config.AiProviderEntry{Name: "dead", Type: constant.AiProviderTypeOpenAI, BaseURL: dead.URL, Models: []string{"m"}, Fallbacks: []string{"backup"}},
+			config.AiProviderEntry{Name: "backup", Type: constant.AiProviderTypeOpenAI, BaseURL: backup.URL, Models: []string{"m"}},
+		)
+		So(err, ShouldBeNil)
+		params := openai.ChatCompletionNewParams{Model: "m", Messages: []openai.ChatCompletionMessageParamUnion{openai.UserMessage("hi")}}
+
+		resp, err := c.ChatOpenAI(context.Background(), params)
+		So(err, ShouldBeNil)
+		So(resp.Model, ShouldEqual, "m")
+
+		hits.Store(0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = c.ChatOpenAI(ctx, params)
+		So(err, ShouldNotBeNil)
+		So(hits.Load(), ShouldEqual, 0)
+	})
+}
+
+func TestProviderTimeout(t *testing.T) {
+	// slowStream 先等待 headerDelay 再返回响应头，之后每隔 chunkDelay 推送一个分片
+	slowStream := func(headerDelay, chunkDelay time.Duration) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(headerDelay)
+			w.Header().Set("Content-Type", "text/event-stream")
+			for i := 0; i < 4; i++ {
+				fmt.Fprintf(w, "data: {\"id\":\"x\",\"object\":\"chat.completion.chunk\",\"model\":\"m\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"%d\"}}]}\n\n", i)
+				w.(http.Flusher).Flush()
+				time.Sleep(chunkDelay)
+			}
+			fmt.Fprint(w, "data: [DONE]\n\n")
+		}))
+	}
+	stream := func(srv *httptest.Server) (string, error) {
+		c, err := newTestClient(config.AiProviderEntry{
+			Name: "a", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}, Timeout: 100 * time.Millisecond,
+		})
+		if err != nil {
+			return "", err
+		}
+		var out string
+		err = c.ChatStreamOpenAI(context.Background(), openai.ChatCompletionNewParams{Model: "m"}, func(chunk *openai.ChatCompletionChunk) error {
+			if len(chunk.Choices) > 0 {
+				out += chunk.Choices[0].Delta.Content
+			}
+			return nil
+		})
+		return out, err
+	}
+
+	Convey("provider timeout", t, func() {
+		Convey("does not cut off a stream that outlasts it", func() {
+			srv := slowStream(0, 60*time.Millisecond)
+			defer srv.Close()
+			out, err := stream(srv)
+			So(err, ShouldBeNil)
+			So(out, ShouldEqual, "0123")
+		})
+
+		Convey("bounds the time to the first byte", func() {
+			srv := slowStream(300*time.Millisecond, 0)
+			defer srv.Close()
+			_, err := stream(srv)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package ai_provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/openai/openai-go/v2"
+)
+
+// Usage 一次模型调用的 token 用量，Provider/Model 为实际处理请求的服务与模型（降级后为降级目标）；
+// MCP 工具内部调用模型时，以 JSON 形式放在 tools/call 结果的 _meta 中回报给 host
+type Usage struct {
+	Provider         string `json:"provider"`
+	Model            string `json:"model"`
+	PromptTokens     int64  `json:"prompt_tokens"`
+	CompletionTokens int64  `json:"completion_tokens"`
+	TotalTokens      int64  `json:"total_tokens"`
+}
+
+// UsageFunc 接收本次调用产生的 token 用量，每次成功的模型调用回调一次
+type UsageFunc func(Usage)
+
+type usageKey struct{}
+
+// WithUsageRecorder 订阅 ctx 上模型调用的 token 用量；
+// 设置后流式 OpenAI 兼容请求会附带 stream_options.include_usage
+func WithUsageRecorder(ctx context.Context, fn UsageFunc) context.Context {
+	return context.WithValue(ctx, usageKey{}, fn)
+}
+
+func usageRecorder(ctx context.Context) UsageFunc {
+	fn, _ := ctx.Value(usageKey{}).(UsageFunc)
+	return fn
+}
+
+// recordUsage 回调用量，服务未返回用量（全为 0）时忽略
+func recordUsage(ctx context.Context, u Usage) {
+	fn := usageRecorder(ctx)
+	if fn == nil || (u.PromptTokens == 0 && u.CompletionTokens == 0 && u.TotalTokens == 0) {
+		return
+	}
+	if u.TotalTokens == 0 {
+		u.TotalTokens = u.PromptTokens + u.CompletionTokens
+	}
+	fn(u)
+}
+
+func openaiUsage(provider, model string, u openai.CompletionUsage) Usage {
+	return Usage{
+		Provider:         provider,
+		Model:            model,
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+	}
+}
+
+// ollamaUsage Ollama 原生接口在最后一个（done）响应中给出 prompt_eval_count/eval_count
+func ollamaUsage(provider, model string, r *ChatResponse) Usage {
+	return Usage{
+		Provider:         provider,
+		Model:            model,
+		PromptTokens:     r.PromptEvalCount,
+		CompletionTokens: r.EvalCount,
+	}
+}
+
+// drainOllamaUsage 读完剩余的流式响应，只记录 done 响应中的用量
+func drainOllamaUsage(ctx context.Context, provider, model string, sc *bufio.Scanner) {
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var chunk ChatResponse
+		if json.Unmarshal(line, &chunk) != nil {
+			return
+		}
+		if chunk.Done {
+			recordUsage(ctx, ollamaUsage(provider, model, &chunk))
+			return
+		}
+	}
+}
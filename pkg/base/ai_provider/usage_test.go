@@ -0,0 +1,95 @@
+package ai_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUsage(t *testing.T) {
+	Convey("non-stream chat completions report response usage", t, func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id":"x","object":"chat.completion","model":"m","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"ok"}}],"usage":{"prompt_tokens":12,"completion_tokens":3,"total_tokens":15}}`)
+		}))
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "p", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		var got []Usage
+		ctx := WithUsageRecorder(context.Background(), func(u Usage) { got = append(got, u) })
+		_, err = c.ChatOpenAI(ctx, testParams)
+		So(err, ShouldBeNil)
+		So(got, ShouldResemble, []Usage{{Provider: "p", Model: "m", PromptTokens: 12, CompletionTokens: 3, TotalTokens: 15}})
+	})
+
+	Convey("streaming requests ask for include_usage and report the final chunk", t, func() {
+		var includeUsage bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				StreamOptions struct {
+					IncludeUsage bool `json:"include_usage"`
+				} `json:"stream_options"`
+			}
+			b, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(b, &body)
+			includeUsage = body.StreamOptions.IncludeUsage
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: {\"id\":\"x\",\"object\":\"chat.completion.chunk\",\"model\":\"m\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"ok\"}}]}\n\n")
+			fmt.Fprint(w, "data: {\"id\":\"x\",\"object\":\"chat.completion.chunk\",\"model\":\"m\",\"choices\":[],\"usage\":{\"prompt_tokens\":7,\"completion_tokens\":2,\"total_tokens\":9}}\n\n")
+			fmt.Fprint(w, "data: [DONE]\n\n")
+		}))
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "p", Type: constant.AiProviderTypeOpenAI, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		var got []Usage
+		ctx := WithUsageRecorder(context.Background(), func(u Usage) { got = append(got, u) })
+		err = c.ChatStreamOpenAI(ctx, testParams, func(*openai.ChatCompletionChunk) error { return nil })
+		So(err, ShouldBeNil)
+		So(includeUsage, ShouldBeTrue)
+		So(got, ShouldResemble, []Usage{{Provider: "p", Model: "m", PromptTokens: 7, CompletionTokens: 2, TotalTokens: 9}})
+
+		got = nil
+		err = c.ChatStreamOpenAI(ctx, testParams, func(*openai.ChatCompletionChunk) error { return errno.OllamaInternalStopStream })
+		So(err, ShouldBeNil)
+		So(got, ShouldHaveLength, 1)
+		So(got[0].TotalTokens, ShouldEqual, 9)
+
+		err = c.ChatStreamOpenAI(context.Background(), testParams, func(*openai.ChatCompletionChunk) error { return nil })
+		So(err, ShouldBeNil)
+		So(includeUsage, ShouldBeFalse)
+	})
+
+	Convey("ollama native chat reports eval counts of the done chunk", t, func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{"model":"m","message":{"role":"assistant","content":"o"},"done":false}`)
+			fmt.Fprintln(w, `{"model":"m","message":{"role":"assistant","content":"k"},"done":true,"prompt_eval_count":20,"eval_count":5}`)
+		}))
+		defer srv.Close()
+		c, err := newTestClient(config.AiProviderEntry{Name: "o", Type: constant.AiProviderTypeOllama, BaseURL: srv.URL, Models: []string{"m"}})
+		So(err, ShouldBeNil)
+
+		var got []Usage
+		ctx := WithUsageRecorder(context.Background(), func(u Usage) { got = append(got, u) })
+		err = c.ChatStream(ctx, ChatRequest{Model: "m"}, func(*ChatResponse) error { return nil })
+		So(err, ShouldBeNil)
+		So(got, ShouldResemble, []Usage{{Provider: "o", Model: "m", PromptTokens: 20, CompletionTokens: 5, TotalTokens: 25}})
+
+		got = nil
+		err = c.ChatStream(ctx, ChatRequest{Model: "m"}, func(*ChatResponse) error { return errno.OllamaInternalStopStream })
+		So(err, ShouldBeNil)
+		So(got, ShouldHaveLength, 1)
+		So(got[0].TotalTokens, ShouldEqual, 25)
+	})
+}
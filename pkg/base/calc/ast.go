@@ -0,0 +1,239 @@
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+var (
+	ErrUnknownVariable = errors.New("calc: unknown variable")
+	ErrArity           = errors.New("calc: wrong number of arguments")
+)
+
+// Env 变量取值
+type Env map[string]float64
+
+// constants 未在 Env 中给出时可直接使用的常数
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// Node 表达式树节点
+type Node interface {
+	// Eval 在 env 下求值，定义域外返回 NaN 而不是错误（如 ln(-1)），便于作图时跳过
+	Eval(env Env) (float64, error)
+	// String 以最少的括号输出，可被 Parse 重新解析
+	String() string
+	prec() int
+}
+
+// 运算符优先级，用于输出时决定是否加括号
+const (
+	precAdd = iota + 1
+	precMul
+	precNeg
+	precPow
+	precAtom
+)
+
+// Num 数值常量
+type Num float64
+
+func (n Num) Eval(Env) (float64, error) { return float64(n), nil }
+func (n Num) String() string            { return FormatNumber(float64(n)) }
+func (n Num) prec() int {
+	if n < 0 {
+		return precNeg
+	}
+	return precAtom
+}
+
+// Var 变量或命名常数
+type Var string
+
+func (v Var) Eval(env Env) (float64, error) {
+	if x, ok := env[string(v)]; ok {
+		return x, nil
+	}
+	if x, ok := constants[string(v)]; ok {
+		return x, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownVariable, string(v))
+}
+func (v Var) String() string { return string(v) }
+func (v Var) prec() int      { return precAtom }
+
+// Neg 一元负号
+type Neg struct{ X Node }
+
+func (n *Neg) Eval(env Env) (float64, error) {
+	x, err := n.X.Eval(env)
+	return -x, err
+}
+func (n *Neg) String() string { return "-" + wrap(n.X, n.X.prec() < precNeg) }
+func (n *Neg) prec() int      { return precNeg }
+
+// Binary 二元运算，Op 为 + - * / ^
+type Binary struct {
+	Op   byte
+	L, R Node
+}
+
+func (b *Binary) Eval(env Env) (float64, error) {
+	l, err := b.L.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	r, err := b.R.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	switch b.Op {
+	case '+':
+		return l + r, nil
+	case '-':
+		return l - r, nil
+	case '*':
+		return l * r, nil
+	case '/':
+		return l / r, nil
+	case '^':
+		return math.Pow(l, r), nil
+	}
+	return 0, fmt.Errorf("calc: unknown operator %q", b.Op)
+}
+
+func (b *Binary) String() string {
+	p := b.prec()
+	var left, right bool
+	switch b.Op {
+	case '^':
+		// 右结合：左侧同级也要括号
+		left, right = b.L.prec() <= p, b.R.prec() < precNeg
+	case '-', '/':
+		left, right = b.L.prec() < p, b.R.prec() <= p
+	default:
+		left, right = b.L.prec() < p, b.R.prec() < p
+	}
+	op := " " + string(b.Op) + " "
+	if b.Op == '*' || b.Op == '/' || b.Op == '^' {
+		op = string(b.Op)
+	}
+	return wrap(b.L, left) + op + wrap(b.R, right)
+}
+
+func (b *Binary) prec() int {
+	switch b.Op {
+	case '+', '-':
+		return precAdd
+	case '*', '/':
+		return precMul
+	}
+	return precPow
+}
+
+// Call 函数调用
+type Call struct {
+	Fn   string
+	Args []Node
+}
+
+func newCall(name string, args []Node) (*Call, error) {
+	f := functions[name]
+	if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+		return nil, fmt.Errorf("%w: %s takes %s", ErrArity, name, f.arity())
+	}
+	return &Call{Fn: name, Args: args}, nil
+}
+
+func (c *Call) Eval(env Env) (float64, error) {
+	vals := make([]float64, len(c.Args))
+	for i, a := range c.Args {
+		v, err := a.Eval(env)
+		if err != nil {
+			return 0, err
+		}
+		vals[i] = v
+	}
+	return functions[c.Fn].eval(vals), nil
+}
+
+func (c *Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = a.String()
+	}
+	return c.Fn + "(" + strings.Join(args, ", ") + ")"
+}
+func (c *Call) prec() int { return precAtom }
+
+func wrap(n Node, paren bool) string {
+	if paren {
+		return "(" + n.String() + ")"
+	}
+	return n.String()
+}
+
+// Vars 表达式中出现的变量（不含已知常数），按字母序
+func Vars(n Node) []string {
+	set := map[string]bool{}
+	var walk func(n Node)
+	walk = func(n Node) {
+		switch x := n.(type) {
+		case Var:
+			if _, ok := constants[string(x)]; !ok {
+				set[string(x)] = true
+			}
+		case *Neg:
+			walk(x.X)
+		case *Binary:
+			walk(x.L)
+			walk(x.R)
+		case *Call:
+			for _, a := range x.Args {
+				walk(a)
+			}
+		}
+	}
+	walk(n)
+	out := make([]string, 0, len(set))
+	for v := range set {
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// dependsOn 表达式是否含变量 v
+func dependsOn(n Node, v string) bool {
+	for _, x := range Vars(n) {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatNumber 保留 constant.MathNumberSignificantDig 位有效数字并去掉浮点噪声（0.30000000000000004 -> 0.3）
+func FormatNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case f == 0:
+		return "0"
+	}
+	s := strconv.FormatFloat(f, 'g', constant.MathNumberSignificantDig, 64)
+	v, _ := strconv.ParseFloat(s, 64)
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,225 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func eval(s string, env Env) float64 {
+	n, err := Parse(s)
+	So(err, ShouldBeNil)
+	v, err := n.Eval(env)
+	So(err, ShouldBeNil)
+	return v
+}
+
+func TestParseEval(t *testing.T) {
+	Convey("Parse and Eval", t, func() {
+		Convey("follows operator precedence and associativity", func() {
+			So(eval("1 + 2*3", nil), ShouldEqual, 7)
+			So(eval("2^3^2", nil), ShouldEqual, 512)
+			So(eval("2**-1", nil), ShouldEqual, 0.5)
+			So(eval("-2^2", nil), ShouldEqual, -4)
+			So(eval("10 - 4 - 3", nil), ShouldEqual, 3)
+			So(eval("1.5e3 / [2 * {3 - 1.5}]", nil), ShouldEqual, 500)
+		})
+
+		Convey("supports implicit multiplication, constants and functions", func() {
+			So(eval("2x + 3(x+1)", Env{"x": 2}), ShouldEqual, 13)
+			So(eval("2pi", nil), ShouldAlmostEqual, 2*math.Pi)
+			So(eval("sin(pi/2) + ln(e) + max(1, 5, 3)", nil), ShouldAlmostEqual, 7)
+			So(eval("x sin(x)", Env{"x": math.Pi / 2}), ShouldAlmostEqual, math.Pi/2)
+		})
+
+		Convey("prints a form that parses back to the same tree", func() {
+			for _, s := range []string{"(x + 1)^2", "-(a - b)", "x^-2", "(-2)^x", "a - (b - c)", "a/(b*c)", "2^3^2", "(2^3)^2", "atan2(y, x)"} {
+				n, err := Parse(s)
+				So(err, ShouldBeNil)
+				again, err := Parse(n.String())
+				So(err, ShouldBeNil)
+				So(again.String(), ShouldEqual, n.String())
+				env := Env{"x": 2, "a": 3, "b": 5, "c": 7, "y": 2}
+				v1, _ := n.Eval(env)
+				v2, _ := again.Eval(env)
+				So(v2, ShouldEqual, v1)
+			}
+		})
+
+		Convey("reports syntax, arity and unknown variables", func() {
+			for _, s := range []string{"1 +", "(1", "sin x", "2 $ 3", ""} {
+				_, err := Parse(s)
+				So(errors.Is(err, ErrSyntax), ShouldBeTrue)
+			}
+			_, err := Parse("sin(1, 2)")
+			So(errors.Is(err, ErrArity), ShouldBeTrue)
+			n, _ := Parse("x + y")
+			_, err = n.Eval(Env{"x": 1})
+			So(errors.Is(err, ErrUnknownVariable), ShouldBeTrue)
+			So(Vars(n), ShouldResemble, []string{"x", "y"})
+		})
+
+		Convey("parses equations as lhs - rhs", func() {
+			n, err := ParseEquation("x^2 = 4")
+			So(err, ShouldBeNil)
+			So(n.String(), ShouldEqual, "x^2 - 4")
+		})
+	})
+}
+
+func TestDerivative(t *testing.T) {
+	Convey("Derivative", t, func() {
+		d := func(s string) string {
+			n, err := Parse(s)
+			So(err, ShouldBeNil)
+			dn, err := Derivative(context.Background(), n, "x")
+			So(err, ShouldBeNil)
+			return dn.String()
+		}
+
+		Convey("simplifies common results", func() {
+			So(d("x^3"), ShouldEqual, "3*x^2")
+			So(d("5x + 2"), ShouldEqual, "5")
+			So(d("sin(x)"), ShouldEqual, "cos(x)")
+			So(d("cos(2x)"), ShouldEqual, "-2*sin(2*x)")
+			So(d("exp(x^2)"), ShouldEqual, "2*exp(x^2)*x")
+			So(d("ln(x)"), ShouldEqual, "1/x")
+			So(d("2^x"), ShouldEqual, "2^x*ln(2)")
+			So(d("a*x"), ShouldEqual, "a")
+			So(d("exp(-x^2/2)"), ShouldEqual, "-(exp(-x^2/2)*x)")
+			So(d("1/x"), ShouldEqual, "-1/x^2")
+		})
+
+		Convey("collects like terms in higher derivatives", func() {
+			So(d(d("x^3*sin(x)")), ShouldEqual, "6*x*sin(x) + 6*x^2*cos(x) - x^3*sin(x)")
+			So(d(d("2*(x+1)^3")), ShouldEqual, "12*x + 12")
+		})
+
+		Convey("agrees with finite differences", func() {
+			for _, s := range []string{"x^x", "tan(x)/x", "sqrt(1 + x^2)", "atan(x) * log10(x)", "asin(x/2) - acos(x/3)", "tanh(x)^2", "cbrt(x) + abs(x)"} {
+				n, err := Parse(s)
+				So(err, ShouldBeNil)
+				dn, err := Derivative(context.Background(), n, "x")
+				So(err, ShouldBeNil)
+				f := Func(n, "x", nil)
+				x, h := 0.7, 1e-6
+				hi, _ := f(x + h)
+				lo, _ := f(x - h)
+				got, err := dn.Eval(Env{"x": x})
+				So(err, ShouldBeNil)
+				So(got, ShouldAlmostEqual, (hi-lo)/(2*h), 1e-5)
+			}
+		})
+
+		Convey("rejects functions without a derivative", func() {
+			n, _ := Parse("floor(x)")
+			_, err := Derivative(context.Background(), n, "x")
+			So(errors.Is(err, ErrNotDifferentiable), ShouldBeTrue)
+		})
+
+		Convey("stops when the result grows too large", func() {
+			for _, s := range []string{"x^x^x^x^x^x^x^x", strings.Repeat("sin(", 60) + "x" + strings.Repeat(")", 60)} {
+				n, err := Parse(s)
+				So(err, ShouldBeNil)
+				start := time.Now()
+				for i := 0; i < 5 && err == nil; i++ {
+					n, err = Derivative(context.Background(), n, "x")
+				}
+				So(errors.Is(err, ErrTooLarge), ShouldBeTrue)
+				So(time.Since(start), ShouldBeLessThan, time.Second)
+			}
+		})
+
+		Convey("honours a cancelled context", func() {
+			n, _ := Parse(strings.Repeat("sin(", 60) + "x" + strings.Repeat(")", 60))
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := Derivative(ctx, n, "x")
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		})
+	})
+}
+
+func TestNumeric(t *testing.T) {
+	ctx := context.Background()
+	Convey("Integrate and Roots", t, func() {
+		Convey("integrates smooth functions accurately", func() {
+			n, _ := Parse("sin(x)")
+			v, _, err := Integrate(ctx, n, "x", 0, math.Pi, nil)
+			So(err, ShouldBeNil)
+			So(v, ShouldAlmostEqual, 2, 1e-9)
+
+			n, _ = Parse("exp(-x^2)")
+			v, _, err = Integrate(ctx, n, "x", 3, -3, nil)
+			So(err, ShouldBeNil)
+			So(v, ShouldAlmostEqual, -math.Sqrt(math.Pi)*math.Erf(3), 1e-9)
+
+			n, _ = Parse("1/x")
+			_, _, err = Integrate(ctx, n, "x", -1, 1, nil)
+			So(errors.Is(err, ErrNotFinite), ShouldBeTrue)
+		})
+
+		Convey("finds sign-change roots and skips poles", func() {
+			n, _ := ParseEquation("x^2 = 2")
+			roots, err := Roots(ctx, n, "x", -5, 5, nil)
+			So(err, ShouldBeNil)
+			So(len(roots), ShouldEqual, 2)
+			So(roots[0], ShouldAlmostEqual, -math.Sqrt2, 1e-9)
+			So(roots[1], ShouldAlmostEqual, math.Sqrt2, 1e-9)
+
+			n, _ = Parse("tan(x)")
+			roots, err = Roots(ctx, n, "x", -2, 2, nil)
+			So(err, ShouldBeNil)
+			So(len(roots), ShouldEqual, 1)
+			So(roots[0], ShouldAlmostEqual, 0, 1e-9)
+		})
+
+		Convey("gives up on integrands that never converge", func() {
+			n, _ := Parse("sin(100000*x)")
+			start := time.Now()
+			_, _, err := Integrate(ctx, n, "x", 0.001, 100, nil)
+			So(errors.Is(err, ErrTooManyEvaluations), ShouldBeTrue)
+			So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+		})
+
+		Convey("honours a cancelled context", func() {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+			n, _ := Parse("sin(100000*x)")
+			_, _, err := Integrate(cancelled, n, "x", 0.001, 100, nil)
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			_, err = Roots(cancelled, n, "x", 0.001, 100, nil)
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			_, _, err = Plot(cancelled, []Series{{Label: "s", Expr: n}}, PlotOptions{Samples: constant.MathPlotMaxSamples}, nil)
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		})
+	})
+}
+
+func TestPlot(t *testing.T) {
+	ctx := context.Background()
+	Convey("Plot", t, func() {
+		sinx, _ := Parse("sin(x)")
+		tanx, _ := Parse("tan(x)")
+		svg, info, err := Plot(ctx, []Series{{Label: "sin(x)", Expr: sinx}, {Label: "tan(x) <&>", Expr: tanx}}, PlotOptions{Title: "trig"}, nil)
+		So(err, ShouldBeNil)
+		So(svg, ShouldStartWith, "<svg ")
+		So(svg, ShouldEndWith, "</svg>")
+		So(strings.Count(svg, "<path "), ShouldEqual, 2)
+		So(svg, ShouldContainSubstring, "tan(x) &lt;&amp;&gt;")
+		So(info.XMin, ShouldEqual, -10)
+		// tan 的渐近线不应把纵轴范围撑到极大
+		So(info.YMax, ShouldBeLessThan, 100)
+
+		sqrtx, _ := Parse("sqrt(x)")
+		lo, hi := -5.0, -1.0
+		_, _, err = Plot(ctx, []Series{{Label: "sqrt", Expr: sqrtx}}, PlotOptions{XMin: &lo, XMax: &hi}, nil)
+		So(errors.Is(err, ErrPlot), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,396 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+var (
+	ErrNotDifferentiable = errors.New("calc: not differentiable")
+	ErrTooLarge          = errors.New("calc: expression too large")
+)
+
+// Derivative 对变量 v 求一阶导数，结果经过常数折叠、0/1 化简与同类项合并。
+// 导数的规模可能随嵌套指数级增长（如 x^x^x^x 的高阶导数），
+// 节点数超过 constant.MathMaxDerivativeNodes 时返回 ErrTooLarge，ctx 取消时返回 ctx.Err()
+func Derivative(ctx context.Context, n Node, v string) (Node, error) {
+	if tooLarge(n, constant.MathMaxDerivativeNodes) {
+		return nil, ErrTooLarge
+	}
+	d := &deriver{ctx: ctx, v: v}
+	dn, err := d.derive(n)
+	if err != nil {
+		return nil, err
+	}
+	// 求导结果共享子树，按树展开后可能远大于内存中的节点数，collect 与 String 都按展开后的规模计算
+	if tooLarge(dn, constant.MathMaxDerivativeNodes) {
+		return nil, ErrTooLarge
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return collect(dn), nil
+}
+
+// tooLarge 按树展开统计节点数（共享的子树重复计数），超过 limit 即停止遍历
+func tooLarge(n Node, limit int) bool {
+	count := 0
+	var walk func(n Node) bool
+	walk = func(n Node) bool {
+		if count++; count > limit {
+			return true
+		}
+		switch x := n.(type) {
+		case *Neg:
+			return walk(x.X)
+		case *Binary:
+			return walk(x.L) || walk(x.R)
+		case *Call:
+			for _, a := range x.Args {
+				if walk(a) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return walk(n)
+}
+
+// deriver 保存一次求导的变量与 context，每访问 constant.MathDeriveCheckInterval 个节点检查一次取消
+type deriver struct {
+	ctx    context.Context
+	v      string
+	visits int
+}
+
+func (d *deriver) derive(n Node) (Node, error) {
+	if d.visits++; d.visits%constant.MathDeriveCheckInterval == 0 {
+		if err := d.ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	switch x := n.(type) {
+	case Num:
+		return Num(0), nil
+	case Var:
+		if string(x) == d.v {
+			return Num(1), nil
+		}
+		return Num(0), nil
+	case *Neg:
+		dx, err := d.derive(x.X)
+		if err != nil {
+			return nil, err
+		}
+		return neg(dx), nil
+	case *Binary:
+		return d.deriveBinary(x)
+	case *Call:
+		return d.deriveCall(x)
+	}
+	return nil, fmt.Errorf("%w: %T", ErrNotDifferentiable, n)
+}
+
+func (d *deriver) deriveBinary(b *Binary) (Node, error) {
+	v := d.v
+	dl, err := d.derive(b.L)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := d.derive(b.R)
+	if err != nil {
+		return nil, err
+	}
+	switch b.Op {
+	case '+':
+		return add(dl, dr), nil
+	case '-':
+		return sub(dl, dr), nil
+	case '*':
+		return add(mul(dl, b.R), mul(b.L, dr)), nil
+	case '/':
+		if !dependsOn(b.R, v) {
+			return div(dl, b.R), nil
+		}
+		// (l/r)' = (l'r - lr') / r^2
+		return div(sub(mul(dl, b.R), mul(b.L, dr)), pow(b.R, Num(2))), nil
+	case '^':
+		switch {
+		case !dependsOn(b.R, v):
+			// (u^c)' = c*u^(c-1)*u'
+			return mul(mul(b.R, pow(b.L, sub(b.R, Num(1)))), dl), nil
+		case !dependsOn(b.L, v):
+			// (c^u)' = c^u*ln(c)*u'
+			return mul(mul(b, call("ln", b.L)), dr), nil
+		default:
+			// (u^w)' = u^w*(w'*ln(u) + w*u'/u)
+			return mul(b, add(mul(dr, call("ln", b.L)), div(mul(b.R, dl), b.L))), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: operator %q", ErrNotDifferentiable, b.Op)
+}
+
+func (d *deriver) deriveCall(c *Call) (Node, error) {
+	if !dependsOn(c, d.v) {
+		return Num(0), nil
+	}
+	f := functions[c.Fn]
+	if f.deriv == nil || len(c.Args) != 1 {
+		return nil, fmt.Errorf("%w: %s", ErrNotDifferentiable, c.Fn)
+	}
+	du, err := d.derive(c.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	return mul(f.deriv(c.Args[0]), du), nil
+}
+
+// collect 合并和式中的同类项：6*x*cos(x) + 6*x*cos(x) -> 12*x*cos(x)
+func collect(n Node) Node {
+	type term struct {
+		coef float64
+		node Node // nil 表示常数项
+	}
+	var (
+		terms []term
+		index = map[string]int{}
+	)
+	// k 为外层累积的系数，常数乘以和式时展开：2*(a + b) -> 2*a + 2*b
+	var walk func(n Node, k float64)
+	walk = func(n Node, k float64) {
+		switch x := n.(type) {
+		case *Binary:
+			switch x.Op {
+			case '+':
+				walk(x.L, k)
+				walk(x.R, k)
+				return
+			case '-':
+				walk(x.L, k)
+				walk(x.R, -k)
+				return
+			}
+		case *Neg:
+			walk(x.X, -k)
+			return
+		}
+		c, rest := split(n)
+		if c != 1 && rest != nil {
+			if _, ok := rest.(*Binary); ok && rest.prec() == precAdd {
+				walk(rest, k*c)
+				return
+			}
+		}
+		key := ""
+		if rest != nil {
+			key = rest.String()
+		}
+		if i, ok := index[key]; ok {
+			terms[i].coef += k * c
+			return
+		}
+		index[key] = len(terms)
+		terms = append(terms, term{coef: k * c, node: rest})
+	}
+	walk(n, 1)
+
+	var out Node = Num(0)
+	for _, t := range terms {
+		if t.coef == 0 {
+			continue
+		}
+		x := scale(math.Abs(t.coef), t.node)
+		if t.coef < 0 {
+			out = sub(out, x)
+		} else {
+			out = add(out, x)
+		}
+	}
+	return out
+}
+
+// 以下构造函数在生成导数时做简单化简，避免 0*x + 1*y 之类的冗余
+
+func isNum(n Node, v float64) bool {
+	x, ok := n.(Num)
+	return ok && float64(x) == v
+}
+
+// call 不折叠常数参数，保留 ln(2) 这类精确形式
+func call(name string, args ...Node) Node {
+	return &Call{Fn: name, Args: args}
+}
+
+func neg(x Node) Node {
+	switch n := x.(type) {
+	case Num:
+		return -n
+	case *Neg:
+		return n.X
+	}
+	if c, body := split(x); c != 1 {
+		return scale(-c, body)
+	}
+	return &Neg{X: x}
+}
+
+func add(l, r Node) Node {
+	switch {
+	case isNum(l, 0):
+		return r
+	case isNum(r, 0):
+		return l
+	}
+	if a, ok := l.(Num); ok {
+		if b, ok := r.(Num); ok {
+			return a + b
+		}
+	}
+	if c, body := split(r); c < 0 {
+		return sub(l, scale(-c, body))
+	}
+	switch n := r.(type) {
+	case *Neg:
+		return sub(l, n.X)
+	case *Binary:
+		// 保持左结合：a + (b - c) -> a + b - c
+		if n.Op == '+' {
+			return add(add(l, n.L), n.R)
+		}
+		if n.Op == '-' {
+			return sub(add(l, n.L), n.R)
+		}
+	}
+	return &Binary{Op: '+', L: l, R: r}
+}
+
+func sub(l, r Node) Node {
+	switch {
+	case isNum(r, 0):
+		return l
+	case isNum(l, 0):
+		return neg(r)
+	}
+	if a, ok := l.(Num); ok {
+		if b, ok := r.(Num); ok {
+			return a - b
+		}
+	}
+	if c, body := split(r); c < 0 {
+		return add(l, scale(-c, body))
+	}
+	switch n := r.(type) {
+	case *Neg:
+		return add(l, n.X)
+	case *Binary:
+		// a - (b + c) -> a - b - c，a - (b - c) -> a - b + c
+		if n.Op == '+' {
+			return sub(sub(l, n.L), n.R)
+		}
+		if n.Op == '-' {
+			return add(sub(l, n.L), n.R)
+		}
+	}
+	return &Binary{Op: '-', L: l, R: r}
+}
+
+func mul(l, r Node) Node {
+	if n, ok := l.(*Neg); ok {
+		return neg(mul(n.X, r))
+	}
+	if n, ok := r.(*Neg); ok {
+		return neg(mul(l, n.X))
+	}
+	// 常数系数统一提到最前面并相乘：x*2 -> 2*x，3*(2*x) -> 6*x
+	cl, bl := split(l)
+	cr, br := split(r)
+	return scale(cl*cr, product(bl, br))
+}
+
+// split 把乘积拆成数值系数与其余部分，纯数值时其余部分为 nil
+func split(n Node) (float64, Node) {
+	switch x := n.(type) {
+	case Num:
+		return float64(x), nil
+	case *Binary:
+		if x.Op == '*' {
+			cl, bl := split(x.L)
+			cr, br := split(x.R)
+			return cl * cr, product(bl, br)
+		}
+	}
+	return 1, n
+}
+
+func product(l, r Node) Node {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	}
+	return &Binary{Op: '*', L: l, R: r}
+}
+
+// scale 构造 c*body，body 为 nil 表示纯数值
+func scale(c float64, body Node) Node {
+	switch {
+	case body == nil:
+		return Num(c)
+	case c == 0:
+		return Num(0)
+	case c == 1:
+		return body
+	case c == -1:
+		return &Neg{X: body}
+	}
+	return &Binary{Op: '*', L: Num(c), R: body}
+}
+
+func div(l, r Node) Node {
+	switch {
+	case isNum(l, 0):
+		return Num(0)
+	case isNum(r, 1):
+		return l
+	}
+	if n, ok := l.(*Neg); ok {
+		return neg(div(n.X, r))
+	}
+	// 除以常数且能整除时直接约掉：4*x/2 -> 2*x
+	if c, ok := r.(Num); ok && c != 0 {
+		cl, bl := split(l)
+		if q := cl / float64(c); q == math.Trunc(q) {
+			return scale(q, bl)
+		}
+	}
+	return &Binary{Op: '/', L: l, R: r}
+}
+
+func pow(l, r Node) Node {
+	switch {
+	case isNum(r, 0):
+		return Num(1)
+	case isNum(r, 1):
+		return l
+	}
+	if a, ok := l.(Num); ok {
+		if b, ok := r.(Num); ok {
+			return Num(math.Pow(float64(a), float64(b)))
+		}
+	}
+	// (u^a)^b -> u^(a*b)，仅在两个指数都是整数时化简，避免 (x^2)^0.5 变成 x
+	if inner, ok := l.(*Binary); ok && inner.Op == '^' {
+		a, okA := inner.R.(Num)
+		b, okB := r.(Num)
+		if okA && okB && a == Num(math.Trunc(float64(a))) && b == Num(math.Trunc(float64(b))) {
+			return pow(inner.L, a*b)
+		}
+	}
+	return &Binary{Op: '^', L: l, R: r}
+}
@@ -0,0 +1,100 @@
+package calc
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// function 内置函数：eval 计算数值，deriv 给出一元函数对自身参数的导数 f'(u)（不含链式法则的 u'），
+// 为 nil 表示不支持求导
+type function struct {
+	minArgs, maxArgs int // maxArgs < 0 表示不限
+	eval             func(args []float64) float64
+	deriv            func(u Node) Node
+}
+
+func (f function) arity() string {
+	switch {
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d argument(s)", f.minArgs)
+	case f.maxArgs < 0:
+		return fmt.Sprintf("at least %d argument(s)", f.minArgs)
+	}
+	return fmt.Sprintf("%d to %d arguments", f.minArgs, f.maxArgs)
+}
+
+func unary(fn func(float64) float64, deriv func(u Node) Node) function {
+	return function{minArgs: 1, maxArgs: 1, eval: func(a []float64) float64 { return fn(a[0]) }, deriv: deriv}
+}
+
+var functions map[string]function
+
+func init() {
+	functions = map[string]function{
+		"sin":  unary(math.Sin, func(u Node) Node { return call("cos", u) }),
+		"cos":  unary(math.Cos, func(u Node) Node { return neg(call("sin", u)) }),
+		"tan":  unary(math.Tan, func(u Node) Node { return div(Num(1), pow(call("cos", u), Num(2))) }),
+		"asin": unary(math.Asin, func(u Node) Node { return div(Num(1), call("sqrt", sub(Num(1), pow(u, Num(2))))) }),
+		"acos": unary(math.Acos, func(u Node) Node { return neg(div(Num(1), call("sqrt", sub(Num(1), pow(u, Num(2)))))) }),
+		"atan": unary(math.Atan, func(u Node) Node { return div(Num(1), add(Num(1), pow(u, Num(2)))) }),
+		"sinh": unary(math.Sinh, func(u Node) Node { return call("cosh", u) }),
+		"cosh": unary(math.Cosh, func(u Node) Node { return call("sinh", u) }),
+		"tanh": unary(math.Tanh, func(u Node) Node { return sub(Num(1), pow(call("tanh", u), Num(2))) }),
+		"exp":  unary(math.Exp, func(u Node) Node { return call("exp", u) }),
+		"ln":   unary(math.Log, func(u Node) Node { return div(Num(1), u) }),
+		"log":  unary(math.Log, func(u Node) Node { return div(Num(1), u) }), // 与 ln 相同，为自然对数
+		"log10": unary(math.Log10, func(u Node) Node {
+			return div(Num(1), mul(u, call("ln", Num(10))))
+		}),
+		"log2": unary(math.Log2, func(u Node) Node {
+			return div(Num(1), mul(u, call("ln", Num(2))))
+		}),
+		"sqrt":  unary(math.Sqrt, func(u Node) Node { return div(Num(1), mul(Num(2), call("sqrt", u))) }),
+		"cbrt":  unary(math.Cbrt, func(u Node) Node { return div(Num(1), mul(Num(3), pow(call("cbrt", u), Num(2)))) }),
+		"abs":   unary(math.Abs, func(u Node) Node { return call("sign", u) }),
+		"sign":  unary(sign, func(Node) Node { return Num(0) }),
+		"floor": unary(math.Floor, nil),
+		"ceil":  unary(math.Ceil, nil),
+		"round": unary(math.Round, nil),
+		"atan2": {minArgs: 2, maxArgs: 2, eval: func(a []float64) float64 { return math.Atan2(a[0], a[1]) }},
+		"hypot": {minArgs: 2, maxArgs: 2, eval: func(a []float64) float64 { return math.Hypot(a[0], a[1]) }},
+		"mod":   {minArgs: 2, maxArgs: 2, eval: func(a []float64) float64 { return math.Mod(a[0], a[1]) }},
+		"min": {minArgs: 1, maxArgs: -1, eval: func(a []float64) float64 {
+			m := a[0]
+			for _, v := range a[1:] {
+				m = math.Min(m, v)
+			}
+			return m
+		}},
+		"max": {minArgs: 1, maxArgs: -1, eval: func(a []float64) float64 {
+			m := a[0]
+			for _, v := range a[1:] {
+				m = math.Max(m, v)
+			}
+			return m
+		}},
+	}
+}
+
+func sign(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	case x == 0:
+		return 0
+	}
+	return math.NaN()
+}
+
+// FunctionNames 内置函数名，用于工具描述
+func FunctionNames() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,202 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+var (
+	ErrNotFinite          = errors.New("calc: non-finite value")
+	ErrTooManyEvaluations = errors.New("calc: too many function evaluations")
+)
+
+// Func 把表达式看作变量 v 的一元函数，env 中的其他变量保持不变
+func Func(n Node, v string, env Env) func(float64) (float64, error) {
+	local := make(Env, len(env)+1)
+	for k, x := range env {
+		local[k] = x
+	}
+	return func(x float64) (float64, error) {
+		local[v] = x
+		return n.Eval(local)
+	}
+}
+
+// checked 包装 f：每 constant.MathEvalCheckInterval 次求值检查一次 ctx，
+// limit > 0 时求值次数超过 limit 返回 ErrTooManyEvaluations
+func checked(ctx context.Context, f func(float64) (float64, error), limit int) func(float64) (float64, error) {
+	evals := 0
+	return func(x float64) (float64, error) {
+		if evals++; evals%constant.MathEvalCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+		if limit > 0 && evals > limit {
+			return 0, fmt.Errorf("%w: more than %d", ErrTooManyEvaluations, limit)
+		}
+		return f(x)
+	}
+}
+
+// Integrate 自适应 Simpson 求 [a, b] 上的定积分，返回积分值与误差估计。
+// 求值次数超过 constant.MathIntegrateMaxEvals 时返回 ErrTooManyEvaluations，ctx 取消时返回 ctx.Err()
+func Integrate(ctx context.Context, n Node, v string, a, b float64, env Env) (value, errEst float64, err error) {
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		return 0, 0, fmt.Errorf("%w: integration bounds must be finite", ErrNotFinite)
+	}
+	if a == b {
+		return 0, 0, nil
+	}
+	sign := 1.0
+	if a > b {
+		a, b, sign = b, a, -1
+	}
+	f := checked(ctx, Func(n, v, env), constant.MathIntegrateMaxEvals)
+	eval := func(x float64) (float64, error) {
+		y, err := f(x)
+		if err != nil {
+			return 0, err
+		}
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return 0, fmt.Errorf("%w: f(%s) = %s", ErrNotFinite, FormatNumber(x), FormatNumber(y))
+		}
+		return y, nil
+	}
+	fa, err := eval(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	fb, err := eval(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	m := (a + b) / 2
+	fm, err := eval(m)
+	if err != nil {
+		return 0, 0, err
+	}
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	s := &simpson{f: eval}
+	value, err = s.step(a, b, fa, fm, fb, whole, constant.MathIntegrateTolerance, constant.MathIntegrateMaxDepth)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sign * value, s.errEst, nil
+}
+
+type simpson struct {
+	f      func(float64) (float64, error)
+	errEst float64
+}
+
+func (s *simpson) step(a, b, fa, fm, fb, whole, tol float64, depth int) (float64, error) {
+	m := (a + b) / 2
+	lm, rm := (a+m)/2, (m+b)/2
+	flm, err := s.f(lm)
+	if err != nil {
+		return 0, err
+	}
+	frm, err := s.f(rm)
+	if err != nil {
+		return 0, err
+	}
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	delta := left + right - whole
+	if depth <= 0 || math.Abs(delta) <= 15*tol {
+		s.errEst += math.Abs(delta) / 15
+		return left + right + delta/15, nil
+	}
+	l, err := s.step(a, m, fa, flm, fm, left, tol/2, depth-1)
+	if err != nil {
+		return 0, err
+	}
+	r, err := s.step(m, b, fm, frm, fb, right, tol/2, depth-1)
+	if err != nil {
+		return 0, err
+	}
+	return l + r, nil
+}
+
+// Roots 在 [a, b] 上找实根：等距扫描符号变化后二分，排除间断点处的伪根（如 tan 的渐近线）。
+// 偶重根（不变号）只有恰好落在扫描点上时才能找到。ctx 取消时返回 ctx.Err()
+func Roots(ctx context.Context, n Node, v string, a, b float64, env Env) ([]float64, error) {
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		return nil, fmt.Errorf("%w: search interval must be finite", ErrNotFinite)
+	}
+	if a > b {
+		a, b = b, a
+	}
+	f := checked(ctx, Func(n, v, env), 0)
+	steps := constant.MathRootScanIntervals
+	h := (b - a) / float64(steps)
+	tol := 1e-12 * math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+
+	var roots []float64
+	addRoot := func(x float64) {
+		if len(roots) > 0 && math.Abs(roots[len(roots)-1]-x) <= math.Max(h/2, tol) {
+			return
+		}
+		roots = append(roots, x)
+	}
+	x0 := a
+	y0, err := f(x0)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i <= steps && len(roots) < constant.MathMaxRoots; i++ {
+		x1 := a + float64(i)*h
+		y1, err := f(x1)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case y0 == 0:
+			addRoot(x0)
+		case finite(y0) && finite(y1) && y1 != 0 && (y0 < 0) != (y1 < 0):
+			r, fr, err := bisect(f, x0, x1, y0, tol)
+			if err != nil {
+				return nil, err
+			}
+			// 真根处 |f| 应远小于两端；间断点二分后 |f| 反而很大
+			if math.Abs(fr) <= 1e-6*math.Max(1, math.Min(math.Abs(y0), math.Abs(y1))) {
+				addRoot(r)
+			}
+		}
+		x0, y0 = x1, y1
+	}
+	if y0 == 0 && len(roots) < constant.MathMaxRoots {
+		addRoot(x0)
+	}
+	sort.Float64s(roots)
+	return roots, nil
+}
+
+func bisect(f func(float64) (float64, error), lo, hi, flo, tol float64) (x, fx float64, err error) {
+	for i := 0; i < 200 && hi-lo > tol; i++ {
+		mid := (lo + hi) / 2
+		fm, err := f(mid)
+		if err != nil {
+			return 0, 0, err
+		}
+		if fm == 0 {
+			return mid, 0, nil
+		}
+		if (fm < 0) == (flo < 0) {
+			lo, flo = mid, fm
+		} else {
+			hi = mid
+		}
+	}
+	x = (lo + hi) / 2
+	fx, err = f(x)
+	return x, fx, err
+}
+
+func finite(x float64) bool { return !math.IsNaN(x) && !math.IsInf(x, 0) }
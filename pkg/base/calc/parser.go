@@ -0,0 +1,269 @@
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+var ErrSyntax = errors.New("calc: syntax error")
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNum
+	tokIdent
+	tokOp // + - * / ^ ( ) ,
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	pos  int
+}
+
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			// 科学计数法：1e-3、2E+5；"2e" 后面不是数字时 e 视为常数
+			if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+				k := j + 1
+				if k < len(s) && (s[k] == '+' || s[k] == '-') {
+					k++
+				}
+				if k < len(s) && s[k] >= '0' && s[k] <= '9' {
+					for k < len(s) && s[k] >= '0' && s[k] <= '9' {
+						k++
+					}
+					j = k
+				}
+			}
+			v, err := strconv.ParseFloat(s[i:j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bad number %q at %d", ErrSyntax, s[i:j], i)
+			}
+			toks = append(toks, token{kind: tokNum, text: s[i:j], num: v, pos: i})
+			i = j
+		case c == '_' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || s[j] >= '0' && s[j] <= '9') {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, text: s[i:j], pos: i})
+			i = j
+		case c == '*' && i+1 < len(s) && s[i+1] == '*':
+			toks = append(toks, token{kind: tokOp, text: "^", pos: i})
+			i += 2
+		case strings.IndexByte("+-*/^(),", c) >= 0:
+			toks = append(toks, token{kind: tokOp, text: string(c), pos: i})
+			i++
+		case c == '[' || c == '{':
+			toks = append(toks, token{kind: tokOp, text: "(", pos: i})
+			i++
+		case c == ']' || c == '}':
+			toks = append(toks, token{kind: tokOp, text: ")", pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, c, i)
+		}
+	}
+	return append(toks, token{kind: tokEOF, pos: len(s)}), nil
+}
+
+// Parse 解析表达式。支持 + - * / ^（** 同 ^，右结合）、一元负号、括号、函数调用、
+// 科学计数法以及省略乘号（2x、3(x+1)、x sin(x)）；常数 pi、e
+func Parse(s string) (Node, error) {
+	if len(s) > constant.MathMaxExpressionLen {
+		return nil, fmt.Errorf("%w: expression longer than %d characters", ErrSyntax, constant.MathMaxExpressionLen)
+	}
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, t.text, t.pos)
+	}
+	return n, nil
+}
+
+// ParseEquation 解析 "lhs = rhs" 为 lhs - (rhs)，不含等号时与 Parse 相同；用于求根
+func ParseEquation(s string) (Node, error) {
+	lhs, rhs, ok := strings.Cut(s, "=")
+	if !ok {
+		return Parse(s)
+	}
+	l, err := Parse(lhs)
+	if err != nil {
+		return nil, err
+	}
+	r, err := Parse(rhs)
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{Op: '-', L: l, R: r}, nil
+}
+
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token { return p.toks[p.i] }
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokOp && t.text == op
+}
+
+// expr := term (('+' | '-') term)*
+func (p *parser) expr() (Node, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+") || p.isOp("-") {
+		op := p.next().text[0]
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, L: left, R: right}
+	}
+	return left, nil
+}
+
+// term := unary (('*' | '/') unary | 省略乘号的 power)*
+func (p *parser) term() (Node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch t := p.peek(); {
+		case p.isOp("*") || p.isOp("/"):
+			op := p.next().text[0]
+			right, err := p.unary()
+			if err != nil {
+				return nil, err
+			}
+			left = &Binary{Op: op, L: left, R: right}
+		case t.kind == tokNum || t.kind == tokIdent || p.isOp("("):
+			right, err := p.power()
+			if err != nil {
+				return nil, err
+			}
+			left = &Binary{Op: '*', L: left, R: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+// unary := ('-' | '+') unary | power
+func (p *parser) unary() (Node, error) {
+	if p.isOp("-") || p.isOp("+") {
+		neg := p.next().text == "-"
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if neg {
+			return &Neg{X: x}, nil
+		}
+		return x, nil
+	}
+	return p.power()
+}
+
+// power := primary ('^' unary)?，右结合，指数允许带负号（2^-x）
+func (p *parser) power() (Node, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("^") {
+		p.next()
+		exp, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Binary{Op: '^', L: base, R: exp}, nil
+	}
+	return base, nil
+}
+
+// primary := number | ident | ident '(' args ')' | '(' expr ')'
+func (p *parser) primary() (Node, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokNum:
+		return Num(t.num), nil
+	case t.kind == tokIdent:
+		if _, ok := functions[t.text]; ok {
+			if !p.isOp("(") {
+				return nil, fmt.Errorf("%w: function %s needs parentheses at %d", ErrSyntax, t.text, t.pos)
+			}
+			p.next()
+			var args []Node
+			for !p.isOp(")") {
+				arg, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if !p.isOp(",") {
+					break
+				}
+				p.next()
+			}
+			if !p.isOp(")") {
+				return nil, fmt.Errorf("%w: missing ) after arguments of %s", ErrSyntax, t.text)
+			}
+			p.next()
+			return newCall(t.text, args)
+		}
+		return Var(t.text), nil
+	case t.kind == tokOp && t.text == "(":
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOp(")") {
+			return nil, fmt.Errorf("%w: missing ) at %d", ErrSyntax, p.peek().pos)
+		}
+		p.next()
+		return n, nil
+	case t.kind == tokEOF:
+		return nil, fmt.Errorf("%w: unexpected end of expression", ErrSyntax)
+	default:
+		return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, t.text, t.pos)
+	}
+}
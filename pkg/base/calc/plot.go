@@ -0,0 +1,249 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+var ErrPlot = errors.New("calc: cannot plot")
+
+// Series 一条曲线
+type Series struct {
+	Label string
+	Expr  Node
+}
+
+// PlotOptions 作图参数，坐标范围为 nil 时自动选取
+type PlotOptions struct {
+	XMin, XMax *float64
+	YMin, YMax *float64
+	Samples    int
+	Title      string
+	Var        string // 自变量名，默认 x
+}
+
+// PlotInfo 实际使用的坐标范围，便于模型在文字回答中描述图像
+type PlotInfo struct {
+	XMin, XMax float64
+	YMin, YMax float64
+	Samples    int
+}
+
+var seriesColors = []string{"#1f77b4", "#d62728", "#2ca02c", "#ff7f0e", "#9467bd", "#8c564b", "#e377c2", "#17becf"}
+
+const (
+	plotMarginLeft   = 56.0
+	plotMarginRight  = 16.0
+	plotMarginTop    = 32.0
+	plotMarginBottom = 36.0
+)
+
+// Plot 在服务端采样并生成 SVG 折线图；定义域外的点（NaN）和间断处的跳变会断开曲线。ctx 取消时返回 ctx.Err()
+func Plot(ctx context.Context, series []Series, opts PlotOptions, env Env) (string, PlotInfo, error) {
+	if len(series) == 0 {
+		return "", PlotInfo{}, fmt.Errorf("%w: no expressions", ErrPlot)
+	}
+	if len(series) > constant.MathPlotMaxSeries {
+		return "", PlotInfo{}, fmt.Errorf("%w: at most %d expressions", ErrPlot, constant.MathPlotMaxSeries)
+	}
+	v := opts.Var
+	if v == "" {
+		v = constant.MathDefaultVariable
+	}
+	info := PlotInfo{XMin: -constant.MathPlotDefaultRange, XMax: constant.MathPlotDefaultRange, Samples: opts.Samples}
+	if opts.XMin != nil {
+		info.XMin = *opts.XMin
+	}
+	if opts.XMax != nil {
+		info.XMax = *opts.XMax
+	}
+	if !finite(info.XMin) || !finite(info.XMax) || info.XMin >= info.XMax {
+		return "", PlotInfo{}, fmt.Errorf("%w: x range must be finite with x_min < x_max", ErrPlot)
+	}
+	if info.Samples <= 1 {
+		info.Samples = constant.MathPlotDefaultSamples
+	}
+	info.Samples = min(info.Samples, constant.MathPlotMaxSamples)
+
+	xs := make([]float64, info.Samples)
+	for i := range xs {
+		xs[i] = info.XMin + (info.XMax-info.XMin)*float64(i)/float64(info.Samples-1)
+	}
+	ys := make([][]float64, len(series))
+	var all []float64
+	for i, s := range series {
+		f := checked(ctx, Func(s.Expr, v, env), 0)
+		ys[i] = make([]float64, len(xs))
+		for j, x := range xs {
+			y, err := f(x)
+			if err != nil {
+				return "", PlotInfo{}, err
+			}
+			if math.IsInf(y, 0) {
+				y = math.NaN()
+			}
+			ys[i][j] = y
+			if !math.IsNaN(y) {
+				all = append(all, y)
+			}
+		}
+	}
+	if len(all) == 0 && (opts.YMin == nil || opts.YMax == nil) {
+		return "", PlotInfo{}, fmt.Errorf("%w: no real values in [%s, %s]", ErrPlot, FormatNumber(info.XMin), FormatNumber(info.XMax))
+	}
+	info.YMin, info.YMax = autoRange(all)
+	if opts.YMin != nil {
+		info.YMin = *opts.YMin
+	}
+	if opts.YMax != nil {
+		info.YMax = *opts.YMax
+	}
+	if !finite(info.YMin) || !finite(info.YMax) || info.YMin >= info.YMax {
+		return "", PlotInfo{}, fmt.Errorf("%w: y range must be finite with y_min < y_max", ErrPlot)
+	}
+	return renderSVG(series, xs, ys, opts.Title, v, info), info, nil
+}
+
+// autoRange 取 2%~98% 分位数并留白，避免渐近线附近的极大值把曲线压平
+func autoRange(vals []float64) (float64, float64) {
+	if len(vals) == 0 {
+		return -1, 1
+	}
+	sorted := append([]float64(nil), vals...)
+	sort.Float64s(sorted)
+	lo, hi := sorted[0], sorted[len(sorted)-1]
+	if len(sorted) >= 50 {
+		plo, phi := sorted[len(sorted)*2/100], sorted[len(sorted)*98/100]
+		// 只在尾部明显是离群值时才截断
+		if span := phi - plo; span > 0 && (hi-lo) > 10*span {
+			lo, hi = plo, phi
+		}
+	}
+	if lo == hi {
+		d := math.Max(1, math.Abs(lo)*0.1)
+		return lo - d, hi + d
+	}
+	pad := (hi - lo) * 0.05
+	return lo - pad, hi + pad
+}
+
+// niceTicks 1/2/5×10^k 步长的刻度
+func niceTicks(lo, hi float64, target int) []float64 {
+	raw := (hi - lo) / float64(target)
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	step := mag
+	for _, m := range []float64{1, 2, 5, 10} {
+		if raw <= m*mag {
+			step = m * mag
+			break
+		}
+	}
+	var ticks []float64
+	for t := math.Ceil(lo/step) * step; t <= hi+step*1e-9; t += step {
+		if math.Abs(t) < step*1e-9 {
+			t = 0
+		}
+		ticks = append(ticks, t)
+	}
+	return ticks
+}
+
+func renderSVG(series []Series, xs []float64, ys [][]float64, title, v string, info PlotInfo) string {
+	w, h := float64(constant.MathPlotWidth), float64(constant.MathPlotHeight)
+	pw, ph := w-plotMarginLeft-plotMarginRight, h-plotMarginTop-plotMarginBottom
+	px := func(x float64) float64 { return plotMarginLeft + (x-info.XMin)/(info.XMax-info.XMin)*pw }
+	py := func(y float64) float64 { return plotMarginTop + (info.YMax-y)/(info.YMax-info.YMin)*ph }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`,
+		constant.MathPlotWidth, constant.MathPlotHeight, constant.MathPlotWidth, constant.MathPlotHeight)
+	b.WriteString(`<rect width="100%" height="100%" fill="#fff"/>`)
+	fmt.Fprintf(&b, `<defs><clipPath id="plot-area"><rect x="%.1f" y="%.1f" width="%.1f" height="%.1f"/></clipPath></defs>`,
+		plotMarginLeft, plotMarginTop, pw, ph)
+
+	// 网格与刻度
+	b.WriteString(`<g stroke="#e5e5e5" stroke-width="1">`)
+	xticks, yticks := niceTicks(info.XMin, info.XMax, 8), niceTicks(info.YMin, info.YMax, 6)
+	for _, t := range xticks {
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f"/>`, px(t), plotMarginTop, px(t), plotMarginTop+ph)
+	}
+	for _, t := range yticks {
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f"/>`, plotMarginLeft, py(t), plotMarginLeft+pw, py(t))
+	}
+	b.WriteString(`</g><g fill="#555">`)
+	for _, t := range xticks {
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`, px(t), plotMarginTop+ph+14, FormatNumber(t))
+	}
+	for _, t := range yticks {
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`, plotMarginLeft-6, py(t), FormatNumber(t))
+	}
+	fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`, plotMarginLeft+pw/2, h-6, html.EscapeString(v))
+	b.WriteString(`</g>`)
+
+	// 坐标轴：0 在范围内时画在 0 处，否则画在边框上
+	b.WriteString(`<g stroke="#333" stroke-width="1">`)
+	axisY := plotMarginTop + ph
+	if info.YMin <= 0 && info.YMax >= 0 {
+		axisY = py(0)
+	}
+	axisX := plotMarginLeft
+	if info.XMin <= 0 && info.XMax >= 0 {
+		axisX = px(0)
+	}
+	fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f"/>`, plotMarginLeft, axisY, plotMarginLeft+pw, axisY)
+	fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f"/>`, axisX, plotMarginTop, axisX, plotMarginTop+ph)
+	fmt.Fprintf(&b, `</g><rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="none" stroke="#999"/>`,
+		plotMarginLeft, plotMarginTop, pw, ph)
+
+	// 曲线：跳变超过绘图区高度时视为间断
+	b.WriteString(`<g clip-path="url(#plot-area)" fill="none" stroke-width="1.8" stroke-linejoin="round">`)
+	for i := range series {
+		var d strings.Builder
+		pen := false
+		var lastY float64
+		for j, x := range xs {
+			y := ys[i][j]
+			if math.IsNaN(y) {
+				pen = false
+				continue
+			}
+			sy := py(y)
+			// 远超画布的点也会使路径失真，裁到画布外一点即可
+			sy = math.Max(-h, math.Min(2*h, sy))
+			if pen && math.Abs(sy-lastY) > ph {
+				pen = false
+			}
+			if pen {
+				fmt.Fprintf(&d, "L%.2f %.2f", px(x), sy)
+			} else {
+				fmt.Fprintf(&d, "M%.2f %.2f", px(x), sy)
+			}
+			pen, lastY = true, sy
+		}
+		fmt.Fprintf(&b, `<path stroke="%s" d="%s"/>`, seriesColors[i%len(seriesColors)], d.String())
+	}
+	b.WriteString(`</g>`)
+
+	// 图例与标题
+	b.WriteString(`<g>`)
+	for i, s := range series {
+		y := plotMarginTop + 12 + float64(i)*16
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="2"/>`,
+			plotMarginLeft+10, y, plotMarginLeft+30, y, seriesColors[i%len(seriesColors)])
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" dominant-baseline="middle" fill="#222">%s</text>`,
+			plotMarginLeft+36, y, html.EscapeString(s.Label))
+	}
+	b.WriteString(`</g>`)
+	if title != "" {
+		fmt.Fprintf(&b, `<text x="%.1f" y="20" text-anchor="middle" font-size="14" fill="#111">%s</text>`, w/2, html.EscapeString(title))
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
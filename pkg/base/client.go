@@ -1,7 +1,7 @@
 package base
 
 import (
-	"sync"
+	"context"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
@@ -10,19 +10,13 @@ import (
 	"gorm.io/gorm"
 )
 
-var (
-	instance       *ClientSet
-	globalInstance *ClientSet
-	once           sync.Once
-	globalMutex    sync.RWMutex
-)
-
 // ClientSet storage various client objects
 // Notice: some or all of them maybe nil, we should check obj when use
 type ClientSet struct {
 	MCPCli           mcp_client.ToolClient
 	AiProviderCli    *ai_provider.Client
 	RegistryResolver registry.Resolver
+	UserMCPPool      *mcp_client.UserClientPool
 	ActualDB         *gorm.DB
 	Cache            *redis.Client
 	cleanups         []func()
@@ -30,16 +24,12 @@ type ClientSet struct {
 
 type Option func(clientSet *ClientSet)
 
-// NewClientSet will be protected by sync.Once for ensure only 1 instance could be created in 1 lifecycle
+// NewClientSet 每次调用都会建立新的连接，由调用方持有并显式传给依赖它的组件
 func NewClientSet(opt ...Option) *ClientSet {
-	once.Do(func() {
-		var options []Option
-		instance = &ClientSet{}
-		options = append(options, opt...)
-		for _, opt := range options {
-			opt(instance)
-		}
-	})
+	instance := &ClientSet{}
+	for _, o := range opt {
+		o(instance)
+	}
 	return instance
 }
 func (cs *ClientSet) Close() {
@@ -48,16 +38,21 @@ func (cs *ClientSet) Close() {
 	}
 }
 
-// SetGlobalClientSet 设置全局 ClientSet 实例（用于 MCP 服务等场景）
-func SetGlobalClientSet(cs *ClientSet) {
-	globalMutex.Lock()
-	defer globalMutex.Unlock()
-	globalInstance = cs
-}
-
-// GetGlobalClientSet 获取全局 ClientSet 实例
-func GetGlobalClientSet() *ClientSet {
-	globalMutex.RLock()
-	defer globalMutex.RUnlock()
-	return globalInstance
+// HealthCheck 探测已初始化依赖的连通性，返回 依赖名 -> 错误（nil 表示健康），未初始化的依赖不参与检查
+func (cs *ClientSet) HealthCheck(ctx context.Context) map[string]error {
+	out := make(map[string]error)
+	if cs == nil {
+		return out
+	}
+	if cs.ActualDB != nil {
+		sqlDB, err := cs.ActualDB.DB()
+		if err == nil {
+			err = sqlDB.PingContext(ctx)
+		}
+		out["db"] = err
+	}
+	if cs.Cache != nil {
+		out["redis"] = cs.Cache.Ping(ctx).Err()
+	}
+	return out
 }
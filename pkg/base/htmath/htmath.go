@@ -0,0 +1,316 @@
+package htmath
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	blockPattern = regexp.MustCompile(`(?is)<htmath>(.*?)</htmath>`)
+	// 模型习惯在 <htmath> 内再包一层 <html>，里面是实体转义后的 HTML
+	wrapperPattern = regexp.MustCompile(`(?is)^\s*<html>(.*)</html>\s*$`)
+)
+
+// Block 模型输出中的一个 <htmath> 片段
+type Block struct {
+	Start, End int    // 整个 <htmath>...</htmath> 在原文中的字节区间
+	HTML       string // 去掉 <html> 包装并反转义后的 HTML
+}
+
+// Extract 找出输出中所有 <htmath> 片段
+func Extract(output string) []Block {
+	var blocks []Block
+	for _, m := range blockPattern.FindAllStringSubmatchIndex(output, -1) {
+		inner := output[m[2]:m[3]]
+		if w := wrapperPattern.FindStringSubmatch(inner); w != nil {
+			inner = w[1]
+		}
+		// 只有在没有真实标签、却有转义标签时才反转义，避免把代码里的 &lt; 误还原
+		if strings.Contains(inner, "&lt;") && !strings.Contains(inner, "<") {
+			inner = html.UnescapeString(inner)
+		}
+		blocks = append(blocks, Block{Start: m[0], End: m[1], HTML: strings.TrimSpace(inner)})
+	}
+	return blocks
+}
+
+// Validate 检查片段：标签闭合正确、只使用允许的标签、外链脚本来自允许的 CDN、内联脚本不调用受限 API。
+// 返回的每条问题描述可直接反馈给模型用于重新生成
+func Validate(fragment string) []string {
+	var problems []string
+	if fragment == "" {
+		return []string{"empty <htmath> block"}
+	}
+	if len(fragment) > constant.HtmathMaxBlockBytes {
+		problems = append(problems, fmt.Sprintf("block is %d bytes, limit is %d", len(fragment), constant.HtmathMaxBlockBytes))
+	}
+	problems = append(problems, checkWellFormed(fragment)...)
+
+	z := xhtml.NewTokenizer(strings.NewReader(fragment))
+	inScript := false
+	for {
+		tt := z.Next()
+		switch tt {
+		case xhtml.ErrorToken:
+			return problems
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			tok := z.Token()
+			name := strings.ToLower(tok.Data)
+			if !allowedTag(name) {
+				problems = append(problems, fmt.Sprintf("tag <%s> is not allowed", name))
+				continue
+			}
+			for _, a := range tok.Attr {
+				if p := checkAttr(name, a); p != "" {
+					problems = append(problems, p)
+				}
+			}
+			inScript = name == "script" && tt == xhtml.StartTagToken
+		case xhtml.EndTagToken:
+			inScript = false
+		case xhtml.TextToken:
+			if inScript {
+				problems = append(problems, checkScript(string(z.Text()))...)
+			}
+		}
+	}
+}
+
+// voidTags 无需闭合的元素
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// optionalEndTags 允许省略结束标签的元素
+var optionalEndTags = map[string]bool{
+	"p": true, "li": true, "dt": true, "dd": true, "tr": true, "td": true, "th": true,
+	"thead": true, "tbody": true, "tfoot": true, "option": true,
+}
+
+// checkWellFormed 按标签栈检查开闭是否匹配
+func checkWellFormed(fragment string) []string {
+	var (
+		problems []string
+		stack    []string
+	)
+	z := xhtml.NewTokenizer(strings.NewReader(fragment))
+	for {
+		tt := z.Next()
+		switch tt {
+		case xhtml.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				problems = append(problems, fmt.Sprintf("cannot tokenize html: %v", err))
+			}
+			for i := len(stack) - 1; i >= 0; i-- {
+				if !optionalEndTags[stack[i]] {
+					problems = append(problems, fmt.Sprintf("<%s> is never closed", stack[i]))
+				}
+			}
+			return problems
+		case xhtml.StartTagToken:
+			name, _ := z.TagName()
+			if tag := strings.ToLower(string(name)); !voidTags[tag] {
+				stack = append(stack, tag)
+			}
+		case xhtml.EndTagToken:
+			name, _ := z.TagName()
+			tag := strings.ToLower(string(name))
+			if voidTags[tag] {
+				continue
+			}
+			i := len(stack) - 1
+			for i >= 0 && stack[i] != tag && optionalEndTags[stack[i]] {
+				i--
+			}
+			if i < 0 || stack[i] != tag {
+				if slices.Contains(stack, tag) {
+					problems = append(problems, fmt.Sprintf("</%s> closes <%s> before <%s> is closed", tag, tag, stack[len(stack)-1]))
+					stack = stack[:slices.Index(stack, tag)]
+				} else {
+					problems = append(problems, fmt.Sprintf("unexpected </%s>", tag))
+				}
+				continue
+			}
+			stack = stack[:i]
+		}
+	}
+}
+
+func allowedTag(name string) bool {
+	return slices.Contains(constant.HtmathAllowedTags, strings.ToLower(name))
+}
+
+// checkAttr 事件处理属性、javascript: 链接与非白名单外链脚本
+func checkAttr(tag string, a xhtml.Attribute) string {
+	key := strings.ToLower(a.Key)
+	switch {
+	case strings.HasPrefix(key, "on"):
+		return fmt.Sprintf("event handler attribute %s on <%s> is not allowed, use addEventListener in a script", key, tag)
+	case key == "srcdoc" || key == "formaction" || key == "action":
+		return fmt.Sprintf("attribute %s on <%s> is not allowed", key, tag)
+	case isURLAttr(key) && unsafeURL(a.Val):
+		return fmt.Sprintf("%s=%q on <%s> uses a forbidden scheme", key, a.Val, tag)
+	case tag == "script" && key == "src" && !allowedScriptSrc(a.Val):
+		return fmt.Sprintf("script source %q is not allowed, load libraries over https from %s",
+			a.Val, strings.Join(constant.HtmathAllowedScriptHosts, ", "))
+	}
+	return ""
+}
+
+// checkScript 受限 API 的字符串匹配很容易绕过，只用来提示模型重写；
+// 真正的限制由 Wrap/Document 附带的 CSP 保证
+func checkScript(code string) []string {
+	var problems []string
+	for _, api := range constant.HtmathForbiddenScriptAPIs {
+		if strings.Contains(code, api) {
+			problems = append(problems, fmt.Sprintf("scripts must not use %s", strings.TrimSuffix(api, "(")))
+		}
+	}
+	return problems
+}
+
+func isURLAttr(key string) bool {
+	return key == "href" || key == "src" || key == "xlink:href" || key == "poster" || key == "background"
+}
+
+// unsafeURL javascript:/vbscript: 一律禁止，data: 只允许图片
+func unsafeURL(v string) bool {
+	v = strings.ToLower(strings.Join(strings.Fields(v), ""))
+	switch {
+	case strings.HasPrefix(v, "javascript:"), strings.HasPrefix(v, "vbscript:"):
+		return true
+	case strings.HasPrefix(v, "data:"):
+		return !strings.HasPrefix(v, "data:image/")
+	}
+	return false
+}
+
+func allowedScriptSrc(src string) bool {
+	u, err := url.Parse(strings.TrimSpace(src))
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	return slices.Contains(constant.HtmathAllowedScriptHosts, strings.ToLower(u.Hostname()))
+}
+
+// Sanitize 按 Validate 的规则清洗片段：移除不允许的标签（连同内容）、受限属性与外链脚本，
+// 含受限 API 的内联脚本整段移除，结果经重新序列化保证标签闭合
+func Sanitize(fragment string) (string, error) {
+	body := &xhtml.Node{Type: xhtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(fragment), body)
+	if err != nil {
+		return "", fmt.Errorf("htmath: parse fragment: %w", err)
+	}
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if n.Type == xhtml.ElementNode && !keepNode(n) {
+			continue
+		}
+		if n.Type == xhtml.CommentNode {
+			continue
+		}
+		sanitizeNode(n)
+		if err := xhtml.Render(&buf, n); err != nil {
+			return "", fmt.Errorf("htmath: render fragment: %w", err)
+		}
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func sanitizeNode(n *xhtml.Node) {
+	if n.Type == xhtml.ElementNode {
+		attrs := n.Attr[:0]
+		for _, a := range n.Attr {
+			if checkAttr(strings.ToLower(n.Data), a) == "" {
+				attrs = append(attrs, a)
+			}
+		}
+		n.Attr = attrs
+	}
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == xhtml.CommentNode || (c.Type == xhtml.ElementNode && !keepNode(c)) {
+			n.RemoveChild(c)
+		} else {
+			sanitizeNode(c)
+		}
+		c = next
+	}
+}
+
+func keepNode(n *xhtml.Node) bool {
+	name := strings.ToLower(n.Data)
+	if !allowedTag(name) {
+		return false
+	}
+	if name != "script" {
+		return true
+	}
+	for _, a := range n.Attr {
+		if strings.ToLower(a.Key) == "src" && !allowedScriptSrc(a.Val) {
+			return false
+		}
+	}
+	if n.FirstChild != nil && len(checkScript(n.FirstChild.Data)) > 0 {
+		return false
+	}
+	return true
+}
+
+// wrapEscaper 与提示词示例一致，只转义 & < >，属性引号保持原样
+var wrapEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// CSP 渲染 htmath 页面时的内容安全策略：脚本只能内联或来自允许的 CDN，
+// 禁止任何网络请求、表单提交与嵌套页面
+func CSP() string {
+	hosts := make([]string, 0, len(constant.HtmathAllowedScriptHosts))
+	for _, h := range constant.HtmathAllowedScriptHosts {
+		hosts = append(hosts, "https://"+h)
+	}
+	cdn := strings.Join(hosts, " ")
+	return strings.Join([]string{
+		"default-src 'none'",
+		"script-src 'unsafe-inline' " + cdn,
+		"style-src 'unsafe-inline' " + cdn,
+		"font-src data: " + cdn,
+		"img-src data: blob:",
+		"connect-src 'none'",
+		"form-action 'none'",
+		"frame-src 'none'",
+		"base-uri 'none'",
+	}, "; ")
+}
+
+// cspMeta 放在文档最前面，解析时会进入 <head> 并对后续内容生效
+func cspMeta() string {
+	return `<meta http-equiv="Content-Security-Policy" content="` + html.EscapeString(CSP()) + `">`
+}
+
+// Wrap 按前端约定把 HTML 包装为 <htmath><html>转义后的 HTML</html></htmath>，片段前附带 CSP
+func Wrap(fragment string) string {
+	return "<htmath><html>" + wrapEscaper.Replace(cspMeta()+fragment) + "</html></htmath>"
+}
+
+// Document 把片段包装为独立的 HTML 文档（附带 CSP），用于以 text/html 嵌入资源返回
+func Document(fragment string) string {
+	return "<!DOCTYPE html>\n<html><head><meta charset=\"utf-8\">" + cspMeta() + "</head><body>\n" + fragment + "\n</body></html>"
+}
+
+// ResourceURI 按内容哈希生成嵌入资源的 URI
+func ResourceURI(fragment string) string {
+	sum := sha256.Sum256([]byte(fragment))
+	return constant.HtmathResourceURI + hex.EncodeToString(sum[:8]) + ".html"
+}
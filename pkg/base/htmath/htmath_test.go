@@ -0,0 +1,81 @@
+package htmath
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	xhtml "golang.org/x/net/html"
+)
+
+func TestHtmath(t *testing.T) {
+	Convey("htmath", t, func() {
+		Convey("Extract unwraps <html> and unescapes entity-escaped HTML", func() {
+			out := "看图：<htmath><html>&lt;div id=\"plot\"&gt;&lt;/div&gt;</html></htmath> 以及 <htmath><p>a &lt; b</p></htmath>"
+			blocks := Extract(out)
+			So(len(blocks), ShouldEqual, 2)
+			So(blocks[0].HTML, ShouldEqual, `<div id="plot"></div>`)
+			So(blocks[1].HTML, ShouldEqual, "<p>a &lt; b</p>")
+			So(out[blocks[0].Start:blocks[0].End], ShouldStartWith, "<htmath>")
+		})
+
+		Convey("Validate accepts the documented Plotly pattern", func() {
+			ok := `<div id="plot"></div>
+<script src="https://cdn.plot.ly/plotly-2.30.0.min.js"></script>
+<script>document.addEventListener('DOMContentLoaded', function() { Plotly.newPlot('plot', [{x: [1, 2], y: [1, 4]}]); });</script>
+<ul><li>one<li>two</ul><img src="data:image/png;base64,AAAA"><br>`
+			So(Validate(ok), ShouldBeEmpty)
+		})
+
+		Convey("Validate reports malformed markup and unsafe content", func() {
+			problems := strings.Join(Validate(`<div onclick="x()"><span>a</div>`+
+				`<script src="http://cdn.plot.ly/p.js"></script><script>localStorage.x = 1</script>`+
+				`<iframe></iframe><a href=" javascript:alert(1)">x</a>`), "\n")
+			for _, want := range []string{"</div> closes <div> before <span>", "onclick", "http://cdn.plot.ly/p.js",
+				"localStorage", "<iframe> is not allowed", "forbidden scheme"} {
+				So(problems, ShouldContainSubstring, want)
+			}
+			So(Validate(""), ShouldNotBeEmpty)
+			So(Validate("<div>"), ShouldResemble, []string{"<div> is never closed"})
+		})
+
+		Convey("Sanitize removes what Validate rejects", func() {
+			dirty := `<div id="plot" onclick="x()">` +
+				`<script src="https://evil.example/a.js"></script><script>fetch("/steal")</script>` +
+				`<iframe src="x"></iframe><a href="javascript:alert(1)">link</a><span>a</div>`
+			clean, err := Sanitize(dirty)
+			So(err, ShouldBeNil)
+			So(clean, ShouldEqual, `<div id="plot"><a>link</a><span>a</span></div>`)
+			So(Validate(clean), ShouldBeEmpty)
+		})
+
+		Convey("Wrap round-trips through Extract", func() {
+			html := `<div id="plot">1 &amp; 2</div><script>if (a < b && c > d) {}</script>`
+			blocks := Extract("前文" + Wrap(html))
+			So(len(blocks), ShouldEqual, 1)
+			So(blocks[0].HTML, ShouldEqual, cspMeta()+html)
+			So(Document(html), ShouldContainSubstring, "<body>\n"+html)
+			So(ResourceURI(html), ShouldStartWith, "htmath://")
+		})
+
+		Convey("Wrap and Document carry a CSP that blocks network access", func() {
+			csp := CSP()
+			So(csp, ShouldContainSubstring, "connect-src 'none'")
+			So(csp, ShouldContainSubstring, "form-action 'none'")
+			So(csp, ShouldContainSubstring, "script-src 'unsafe-inline' https://cdn.plot.ly")
+			So(csp, ShouldNotContainSubstring, "unsafe-eval")
+
+			// meta 在首个元素之前，解析后落入 <head>
+			doc, err := xhtml.Parse(strings.NewReader(Extract(Wrap("<div>x</div>"))[0].HTML))
+			So(err, ShouldBeNil)
+			head := doc.FirstChild.FirstChild
+			So(head.Data, ShouldEqual, "head")
+			So(head.FirstChild.Data, ShouldEqual, "meta")
+			So(head.FirstChild.Attr[1].Val, ShouldEqual, csp)
+
+			doc, err = xhtml.Parse(strings.NewReader(Document("<div>x</div>")))
+			So(err, ShouldBeNil)
+			So(doc.LastChild.FirstChild.LastChild.Attr[1].Val, ShouldEqual, csp)
+		})
+	})
+}
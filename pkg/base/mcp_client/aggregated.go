@@ -8,6 +8,7 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"go.uber.org/zap"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,12 +23,20 @@ import (
 type AggregatedClient struct {
 	resolver        registry.Resolver
 	refreshInterval time.Duration
+	tokens          *tokenSource // 连接各实例共用的访问令牌，未启用授权时为 nil
+	dial            func(addr string) (*MCPClient, error)
+
+	// applyMu 串行化 apply：防抖回调与 Watch 失败后的轮询可能同时触发，
+	// 各自对比的是同一份旧连接表，并发执行会重复建连或互相关闭对方刚建好的连接
+	applyMu sync.Mutex
 
 	mu               sync.RWMutex
 	discoverServices []string
 	clients          map[string]*MCPClient // url -> client
 	toolIndex        map[string]string     // toolName -> url
 	toolSnapshot     map[string]mcp.Tool   // 聚合后的 tool 定义
+	resourceIndex    map[string]string     // 资源 URI scheme -> url
+	promptIndex      map[string]string     // promptName -> url
 
 	stopCh   chan struct{}
 	stopOnce sync.Once
@@ -39,19 +48,30 @@ func NewAggregatedClient(resolver registry.Resolver, services []string) *Aggrega
 	}
 	ac := &AggregatedClient{
 		resolver:         resolver,
+		tokens:           newTokenSource(),
 		discoverServices: services,
 		refreshInterval:  config.Registry.RefreshInterval,
 		clients:          make(map[string]*MCPClient),
 		toolIndex:        make(map[string]string),
 		toolSnapshot:     make(map[string]mcp.Tool),
+		resourceIndex:    make(map[string]string),
+		promptIndex:      make(map[string]string),
 		stopCh:           make(chan struct{}),
 	}
+	ac.dial = func(addr string) (*MCPClient, error) {
+		return dialMCPClient("http://"+addr+"/mcp", ac.tokens)
+	}
 	// 启动定时刷新goroutine
 	go ac.updateAggregatedClient()
 	return ac
 }
 
 func (a *AggregatedClient) updateAggregatedClient() {
+	// 支持推送的注册中心（如 etcd）直接监听变更，不再定时轮询
+	if w, ok := a.resolver.(registry.Watcher); ok {
+		a.watch(w)
+		return
+	}
 	a.refresh()
 	ticker := time.NewTicker(a.refreshInterval)
 	defer ticker.Stop()
@@ -65,6 +85,63 @@ func (a *AggregatedClient) updateAggregatedClient() {
 	}
 }
 
+// watch 监听注册中心推送的实例变更，窗口期内的多次变更合并后再应用；
+// Watch 异常返回时退化为轮询一次，并在一个刷新周期后重新 Watch
+func (a *AggregatedClient) watch(w registry.Watcher) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-a.stopCh
+		cancel()
+	}()
+
+	// 只保留最新一次的全量实例
+	pending := make(chan map[string][]string, 1)
+	go a.debounceApply(ctx, pending)
+	push := func(serviceToUrls map[string][]string) {
+		select {
+		case <-pending:
+		default:
+		}
+		pending <- serviceToUrls
+	}
+
+	for {
+		err := w.Watch(ctx, a.discoverServices, push)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn("registry watch, fallback to polling:", zap.Error(err))
+		a.refresh()
+		select {
+		case <-time.After(a.refreshInterval):
+		case <-a.stopCh:
+			return
+		}
+	}
+}
+
+// debounceApply 收到变更后等待 constant.RegistryWatchDebounce 再应用窗口内最新的实例列表
+func (a *AggregatedClient) debounceApply(ctx context.Context, pending <-chan map[string][]string) {
+	var (
+		latest map[string][]string
+		timer  <-chan time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case latest = <-pending:
+			if timer == nil {
+				timer = time.After(constant.RegistryWatchDebounce)
+			}
+		case <-timer:
+			timer = nil
+			a.apply(latest)
+		}
+	}
+}
+
 // refresh 刷新registryCli与注册中心的连接，来更新可用的MCP服务实例列表
 func (a *AggregatedClient) refresh() {
 	if a.resolver == nil {
@@ -77,6 +154,15 @@ func (a *AggregatedClient) refresh() {
 		logger.Warn("registry resolve:", zap.Error(err))
 		return
 	}
+	a.apply(serviceToUrls)
+}
+
+// apply 按最新的服务实例列表增删 MCP 连接并重建索引。
+// 调用之间由 applyMu 串行；建连在 mu 之外进行，不阻塞正在路由的工具调用
+func (a *AggregatedClient) apply(serviceToUrls map[string][]string) {
+	a.applyMu.Lock()
+	defer a.applyMu.Unlock()
+
 	// 转化为set
 	target := make(map[string]struct{})
 	for _, urls := range serviceToUrls {
@@ -85,43 +171,63 @@ func (a *AggregatedClient) refresh() {
 		}
 	}
 
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
-	// 删除已关闭的连接
-	for u, cli := range a.clients {
-		if _, ok := target[u]; ok {
-			continue
-		}
-		if u == "fzuhelper-mcp" {
-			continue
+	// clients 只在 apply 中修改，持有 applyMu 时读到的就是最新的连接表
+	a.mu.RLock()
+	var stale []string
+	for u := range a.clients {
+		if _, ok := target[u]; !ok && u != "fzuhelper-mcp" {
+			stale = append(stale, u)
 		}
-		cli.Close()
-		delete(a.clients, u)
-		logger.Info("mcp disconnected: ", zap.String("url", u))
 	}
-	// 新增连接
+	var missing []string
 	for u := range target {
-		if _, ok := a.clients[u]; ok {
-			continue
+		if _, ok := a.clients[u]; !ok {
+			missing = append(missing, u)
 		}
-		cli, err := NewMCPClient("http://" + u + "/mcp")
+	}
+	needFzu := a.clients["fzuhelper-mcp"] == nil
+	a.mu.RUnlock()
+
+	// 新增连接
+	added := make(map[string]*MCPClient, len(missing))
+	for _, u := range missing {
+		cli, err := a.dial(u)
 		if err != nil {
 			logger.Errorf("mcp dial %s: %v", u, err)
 			continue
 		}
-		a.clients[u] = cli
+		added[u] = cli
 		logger.Infof("mcp connected: %s (tools=%d)", u, len(cli.Tools))
 	}
-	// 建立fzu-helper-mcp连接
-	fzuCli, err := NewMCPClient(constant.FzuHelperServerMCPUrl)
-	if err != nil {
-		logger.Errorf("mcp dial %s: %v", constant.FzuHelperServerMCPUrl, err)
+	// 建立fzu-helper-mcp连接（外部服务，不附带内部 MCP 访问令牌），失败时下次 apply 重试
+	var fzuCli *MCPClient
+	if needFzu {
+		var err error
+		if fzuCli, err = NewMCPClientWithHeaders(constant.FzuHelperServerMCPUrl, nil); err != nil {
+			logger.Errorf("mcp dial %s: %v", constant.FzuHelperServerMCPUrl, err)
+		}
 	}
-	a.clients["fzuhelper-mcp"] = fzuCli
-	//logger.Infof("fzu-mcp connected: %s (tools=%d)", constant.FzuHelperServerMCPUrl, len(fzuCli.Tools))
 
+	a.mu.Lock()
+	removed := make([]*MCPClient, 0, len(stale))
+	for _, u := range stale {
+		removed = append(removed, a.clients[u])
+		delete(a.clients, u)
+		logger.Info("mcp disconnected: ", zap.String("url", u))
+	}
+	for u, cli := range added {
+		a.clients[u] = cli
+	}
+	if needFzu {
+		a.clients["fzuhelper-mcp"] = fzuCli
+	}
 	a.rebuildIndex()
+	a.mu.Unlock()
+
+	// 先从索引中摘除再关闭，已路由到旧连接的调用自行失败
+	for _, cli := range removed {
+		cli.Close()
+	}
 }
 
 // rebuildIndex 重建MCPClient映射
@@ -156,6 +262,32 @@ func (a *AggregatedClient) rebuildIndex() {
 	}
 	a.toolIndex = index
 	a.toolSnapshot = toolDef
+
+	resources := make(map[string]string)
+	for url, cli := range a.clients {
+		if cli == nil {
+			continue
+		}
+		for _, scheme := range cli.resourceSchemes() {
+			if prev, ok := resources[scheme]; !ok || url < prev {
+				resources[scheme] = url
+			}
+		}
+	}
+	a.resourceIndex = resources
+
+	prompts := make(map[string]string)
+	for url, cli := range a.clients {
+		if cli == nil {
+			continue
+		}
+		for _, p := range cli.Prompts {
+			if prev, ok := prompts[p.Name]; !ok || url < prev {
+				prompts[p.Name] = url
+			}
+		}
+	}
+	a.promptIndex = prompts
 }
 
 // scoreURL URL权重，不是很必要
@@ -218,22 +350,49 @@ func (a *AggregatedClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUni
 	return out
 }
 
-func (a *AggregatedClient) CallTool(ctx context.Context, name string, args any) (string, error) {
+func (a *AggregatedClient) CallTool(ctx context.Context, name string, args any) (*ToolResult, error) {
 	a.mu.RLock()
 	url, ok := a.toolIndex[name]
 	cli := a.clients[url]
 	a.mu.RUnlock()
 	if !ok || cli == nil {
-		return "", fmt.Errorf("tool %q not found (no connected MCP server provides it)", name)
+		return nil, fmt.Errorf("tool %q not found (no connected MCP server provides it)", name)
 	}
 	return cli.CallTool(ctx, name, args)
 }
 
+// ReadResource 按 URI scheme 路由到声明了对应资源模板的 MCP server
+func (a *AggregatedClient) ReadResource(ctx context.Context, uri string) (string, error) {
+	scheme, _, _ := strings.Cut(uri, "://")
+	a.mu.RLock()
+	url, ok := a.resourceIndex[scheme]
+	cli := a.clients[url]
+	a.mu.RUnlock()
+	if !ok || cli == nil {
+		return "", fmt.Errorf("resource %q not found (no connected MCP server provides it)", uri)
+	}
+	return cli.ReadResource(ctx, uri)
+}
+
+// GetPrompt 按名称路由到声明了该提示词的 MCP server
+func (a *AggregatedClient) GetPrompt(ctx context.Context, name string, args map[string]string) (string, error) {
+	a.mu.RLock()
+	url, ok := a.promptIndex[name]
+	cli := a.clients[url]
+	a.mu.RUnlock()
+	if !ok || cli == nil {
+		return "", fmt.Errorf("prompt %q not found (no connected MCP server provides it)", name)
+	}
+	return cli.GetPrompt(ctx, name, args)
+}
+
 func (a *AggregatedClient) Close() {
 	a.stopOnce.Do(func() { close(a.stopCh) })
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	for _, cli := range a.clients {
-		cli.Close()
+		if cli != nil {
+			cli.Close()
+		}
 	}
 }
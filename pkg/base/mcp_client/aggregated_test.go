@@ -0,0 +1,150 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAggregatedClientApply(t *testing.T) {
+	servers := map[string]string{}
+	for _, name := range []string{"a", "b", "fzu"} {
+		srv := newEchoServer(name + ":")
+		defer srv.Close()
+		servers[name] = srv.URL
+	}
+
+	var (
+		mu    sync.Mutex
+		dials []string
+	)
+	recorded := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		// 同一次 apply 内按 map 顺序建连，排序后比较
+		out := append([]string(nil), dials...)
+		sort.Strings(out)
+		return out
+	}
+	// newClient gate 非 nil 时每次建连都要等 gate 放行；fzuhelper-mcp 预先连到本地服务，避免访问外网
+	newClient := func(gate <-chan struct{}) *AggregatedClient {
+		mu.Lock()
+		dials = nil
+		mu.Unlock()
+		fzu, err := newHTTPMCPClientWithConn(servers["fzu"])
+		So(err, ShouldBeNil)
+		a := &AggregatedClient{
+			clients:       map[string]*MCPClient{"fzuhelper-mcp": fzu},
+			toolIndex:     make(map[string]string),
+			toolSnapshot:  make(map[string]mcp.Tool),
+			resourceIndex: make(map[string]string),
+			promptIndex:   make(map[string]string),
+			stopCh:        make(chan struct{}),
+		}
+		a.dial = func(addr string) (*MCPClient, error) {
+			mu.Lock()
+			dials = append(dials, addr)
+			mu.Unlock()
+			if gate != nil {
+				<-gate
+			}
+			url, ok := servers[addr]
+			if !ok {
+				return nil, fmt.Errorf("unknown instance %s", addr)
+			}
+			return newHTTPMCPClientWithConn(url)
+		}
+		return a
+	}
+	connected := func(a *AggregatedClient) []string {
+		a.mu.RLock()
+		defer a.mu.RUnlock()
+		var out []string
+		for _, name := range []string{"a", "b"} {
+			if _, ok := a.clients[name]; ok {
+				out = append(out, name)
+			}
+		}
+		return out
+	}
+
+	Convey("AggregatedClient.apply", t, func() {
+		Convey("adds new instances and closes removed ones", func() {
+			a := newClient(nil)
+			defer a.Close()
+			a.apply(map[string][]string{"svc": {"a", "b"}})
+			So(connected(a), ShouldResemble, []string{"a", "b"})
+			a.apply(map[string][]string{"svc": {"b"}})
+			So(connected(a), ShouldResemble, []string{"b"})
+			So(recorded(), ShouldResemble, []string{"a", "b"})
+		})
+
+		Convey("concurrent applies are serialized and dial each instance once", func() {
+			gate := make(chan struct{})
+			a := newClient(gate)
+			defer a.Close()
+			a.apply(nil)
+
+			done := make(chan struct{}, 2)
+			for i := 0; i < 2; i++ {
+				go func() {
+					a.apply(map[string][]string{"svc": {"a"}})
+					done <- struct{}{}
+				}()
+			}
+			So(waitFor(func() bool { return len(recorded()) == 1 }), ShouldBeTrue)
+
+			// 建连期间仍可路由到已有连接
+			res, err := a.CallTool(context.Background(), "echo", map[string]any{"text": "x"})
+			So(err, ShouldBeNil)
+			So(res.Text, ShouldEqual, "fzu:x")
+
+			close(gate)
+			<-done
+			<-done
+			So(recorded(), ShouldResemble, []string{"a"})
+			So(connected(a), ShouldResemble, []string{"a"})
+		})
+
+		Convey("debounceApply applies only the latest update in the window", func() {
+			a := newClient(nil)
+			defer a.Close()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			pending := make(chan map[string][]string, 1)
+			go a.debounceApply(ctx, pending)
+
+			pending <- map[string][]string{"svc": {"a"}}
+			<-time.After(constant.RegistryWatchDebounce / 3)
+			// 与 watch 中的 push 一致：丢弃尚未取走的旧值
+			select {
+			case <-pending:
+			default:
+			}
+			pending <- map[string][]string{"svc": {"b"}}
+
+			So(waitFor(func() bool { return len(connected(a)) == 1 }), ShouldBeTrue)
+			<-time.After(constant.RegistryWatchDebounce)
+			So(recorded(), ShouldResemble, []string{"b"})
+			So(connected(a), ShouldResemble, []string{"b"})
+		})
+	})
+}
+
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return false
+}
@@ -11,8 +11,12 @@ type ToolClient interface {
 	ConvertToolsToOllama() []map[string]any
 	// ConvertToolsToOpenAI 将MCP工具定义转换为 OpenAI Chat Completions 的 tools 参数
 	ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam
-	// CallTool 调用工具
-	CallTool(ctx context.Context, name string, args any) (string, error)
+	// CallTool 调用工具；工具自身报告的错误体现在 ToolResult.IsError，error 只表示调用失败
+	CallTool(ctx context.Context, name string, args any) (*ToolResult, error)
+	// ReadResource 读取 MCP 资源，返回其文本内容
+	ReadResource(ctx context.Context, uri string) (string, error)
+	// GetPrompt 获取 MCP server 渲染后的提示词文本
+	GetPrompt(ctx context.Context, name string, args map[string]string) (string, error)
 	// Close 关闭客户端连接
 	Close()
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -21,7 +22,7 @@ func newSSEMCPClientWithConn(url string) (*MCPClient, error) {
 	if err := c.Start(ctx); err != nil {
 		return nil, fmt.Errorf("sse start: %w", err)
 	}
-	_, err = c.Initialize(ctx, mcp.InitializeRequest{
+	initRes, err := c.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
 			ClientInfo: mcp.Implementation{Name: "mcp-host", Version: "0.1.0"},
 		},
@@ -35,12 +36,15 @@ func newSSEMCPClientWithConn(url string) (*MCPClient, error) {
 		return nil, fmt.Errorf("list tools: %w", err)
 	}
 
-	return &MCPClient{Client: c, Tools: resTool.Tools}, nil
+	cli := newMCPClient(c, resTool.Tools)
+	cli.loadResourceTemplates(ctx, initRes.Capabilities)
+	cli.loadPrompts(ctx, initRes.Capabilities)
+	return cli, nil
 }
 
 // newHTTPMCPClientWithConn 通过 Streamable HTTP 连接指定 URL
-func newHTTPMCPClientWithConn(url string) (*MCPClient, error) {
-	c, err := mcpc.NewStreamableHttpClient(url)
+func newHTTPMCPClientWithConn(url string, opts ...transport.StreamableHTTPCOption) (*MCPClient, error) {
+	c, err := mcpc.NewStreamableHttpClient(url, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("new http client: %w", err)
 	}
@@ -51,7 +55,7 @@ func newHTTPMCPClientWithConn(url string) (*MCPClient, error) {
 	if err := c.Start(ctx); err != nil {
 		return nil, fmt.Errorf("http start: %w", err)
 	}
-	_, err = c.Initialize(ctx, mcp.InitializeRequest{
+	initRes, err := c.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
 			ClientInfo: mcp.Implementation{Name: "mcp-host", Version: "0.1.0"},
 		},
@@ -65,5 +69,13 @@ func newHTTPMCPClientWithConn(url string) (*MCPClient, error) {
 		return nil, fmt.Errorf("list tools: %w", err)
 	}
 
-	return &MCPClient{Client: c, Tools: resTool.Tools}, nil
+	cli := newMCPClient(c, resTool.Tools)
+	cli.loadResourceTemplates(ctx, initRes.Capabilities)
+	cli.loadPrompts(ctx, initRes.Capabilities)
+	return cli, nil
+}
+
+// NewMCPClientWithHeaders 通过 Streamable HTTP 连接指定 URL，并在每个请求上附带给定请求头（如用户的 bearer token）
+func NewMCPClientWithHeaders(url string, headers map[string]string) (*MCPClient, error) {
+	return newHTTPMCPClientWithConn(url, transport.WithHTTPHeaders(headers))
 }
@@ -0,0 +1,125 @@
+package mcp_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/client/transport"
+)
+
+// tokenSource 以 client_credentials 向授权服务器获取 MCP 访问令牌，缓存并在过期前刷新
+type tokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// newTokenSource 按 mcp.auth 创建 host 连接内部 MCP 服务使用的令牌来源，未启用授权时返回 nil。
+// 令牌缓存在返回的实例上，由持有它的客户端共用
+func newTokenSource() *tokenSource {
+	auth := config.MCP.Auth
+	if !auth.Enable {
+		return nil
+	}
+	tokenURL := auth.TokenURL
+	if tokenURL == "" {
+		tokenURL = strings.TrimRight(auth.Issuer, "/") + constant.MCPAuthTokenPath
+	}
+	return &tokenSource{
+		tokenURL:     tokenURL,
+		clientID:     auth.ClientID,
+		clientSecret: auth.ClientSecret,
+		scopes:       auth.Scopes,
+		httpClient:   &http.Client{Timeout: constant.MCPClientInitTimeout},
+	}
+}
+
+// options 为请求附带 bearer 令牌，t 为 nil（未启用授权）时不附带
+func (t *tokenSource) options() []transport.StreamableHTTPCOption {
+	if t == nil {
+		return nil
+	}
+	return []transport.StreamableHTTPCOption{transport.WithHTTPHeaderFunc(t.headers)}
+}
+
+// headers 作为 transport.HTTPHeaderFunc 使用；获取失败时不带令牌，由 server 返回 401
+func (t *tokenSource) headers(ctx context.Context) map[string]string {
+	token, err := t.Token(ctx)
+	if err != nil {
+		logger.Errorf("mcp oauth: fetch token from %s: %v", t.tokenURL, err)
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + token}
+}
+
+// Token 返回有效令牌，距过期不足 constant.MCPAuthTokenRefreshSkew 时重新获取
+func (t *tokenSource) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && time.Until(t.expiresAt) > constant.MCPAuthTokenRefreshSkew {
+		return t.token, nil
+	}
+	token, expiresIn, err := t.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	t.token = token
+	t.expiresAt = time.Now().Add(expiresIn)
+	return t.token, nil
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (t *tokenSource) fetch(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(t.scopes) > 0 {
+		form.Set("scope", strings.Join(t.scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(t.clientID), url.QueryEscape(t.clientSecret))
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	var body tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", 0, fmt.Errorf("decode token response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return "", 0, fmt.Errorf("token endpoint status %d: %s %s", resp.StatusCode, body.Error, body.ErrorDescription)
+	}
+	expiresIn := time.Duration(body.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = constant.MCPAuthTokenTTL
+	}
+	return body.AccessToken, expiresIn, nil
+}
@@ -0,0 +1,85 @@
+package mcp_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTokenSource(t *testing.T) {
+	var issued atomic.Int32
+	expiresIn := int64(3600)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "host" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client", "error_description": "client authentication failed"})
+			return
+		}
+		n := issued.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+			"scope":        r.PostFormValue("scope"),
+		})
+	}))
+	defer srv.Close()
+
+	newSource := func(secret string) *tokenSource {
+		return &tokenSource{
+			tokenURL:     srv.URL,
+			clientID:     "host",
+			clientSecret: secret,
+			scopes:       []string{"mcp:tools"},
+			httpClient:   srv.Client(),
+		}
+	}
+
+	Convey("tokenSource", t, func() {
+		issued.Store(0)
+		expiresIn = 3600
+
+		Convey("caches the token until it is about to expire", func() {
+			ts := newSource("s3cret")
+			token, err := ts.Token(context.Background())
+			So(err, ShouldBeNil)
+			So(token, ShouldEqual, "token-1")
+			token, _ = ts.Token(context.Background())
+			So(token, ShouldEqual, "token-1")
+			So(issued.Load(), ShouldEqual, 1)
+
+			ts.expiresAt = time.Now().Add(time.Second)
+			token, _ = ts.Token(context.Background())
+			So(token, ShouldEqual, "token-2")
+		})
+
+		Convey("falls back to the default ttl without expires_in", func() {
+			expiresIn = 0
+			ts := newSource("s3cret")
+			_, err := ts.Token(context.Background())
+			So(err, ShouldBeNil)
+			So(time.Until(ts.expiresAt), ShouldBeGreaterThan, 30*time.Minute)
+		})
+
+		Convey("surfaces a wrong secret and sends no header", func() {
+			ts := newSource("wrong")
+			_, err := ts.Token(context.Background())
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "invalid_client")
+			So(ts.headers(context.Background()), ShouldBeNil)
+		})
+
+		Convey("headers carries the bearer token", func() {
+			So(newSource("s3cret").headers(context.Background()), ShouldResemble, map[string]string{"Authorization": "Bearer token-1"})
+		})
+	})
+}
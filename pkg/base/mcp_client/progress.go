@@ -0,0 +1,82 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Progress 一条来自 MCP Server 的工具进度通知
+type Progress struct {
+	Progress float64 `json:"progress"`
+	Total    float64 `json:"total,omitempty"`
+	Message  string  `json:"message,omitempty"`
+}
+
+// ProgressFunc 接收当前工具调用的进度通知；在连接的读取协程中同步调用，不应阻塞
+type ProgressFunc func(Progress)
+
+type progressKey struct{}
+
+// WithProgress 为 ctx 上的工具调用订阅进度：CallTool 会生成 progressToken 并把对应通知转给 fn
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+func progressFrom(ctx context.Context) (ProgressFunc, bool) {
+	fn, ok := ctx.Value(progressKey{}).(ProgressFunc)
+	return fn, ok && fn != nil
+}
+
+// progressSeq 进程内唯一的 progressToken 序号，多个连接共用以免路由混淆
+var progressSeq atomic.Uint64
+
+// progressRouter 按 progressToken 把连接上的进度通知分发给发起调用的一方
+type progressRouter struct {
+	mu       sync.RWMutex
+	handlers map[string]ProgressFunc
+}
+
+func newProgressRouter(c *mcpc.Client) *progressRouter {
+	r := &progressRouter{handlers: make(map[string]ProgressFunc)}
+	c.OnNotification(r.dispatch)
+	return r
+}
+
+// register 登记一次调用，返回 token 与注销函数。注销会等待正在执行的回调结束，
+// 保证 CallTool 返回后不再有该调用的进度回调
+func (r *progressRouter) register(fn ProgressFunc) (string, func()) {
+	token := fmt.Sprintf("p-%d", progressSeq.Add(1))
+	r.mu.Lock()
+	r.handlers[token] = fn
+	r.mu.Unlock()
+	return token, func() {
+		r.mu.Lock()
+		delete(r.handlers, token)
+		r.mu.Unlock()
+	}
+}
+
+func (r *progressRouter) dispatch(n mcp.JSONRPCNotification) {
+	if n.Method != constant.MCPProgressMethod {
+		return
+	}
+	fields := n.Params.AdditionalFields
+	token, _ := fields["progressToken"].(string)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	fn, ok := r.handlers[token]
+	if !ok {
+		return
+	}
+	p := Progress{}
+	p.Progress, _ = fields["progress"].(float64)
+	p.Total, _ = fields["total"].(float64)
+	p.Message, _ = fields["message"].(string)
+	fn(p)
+}
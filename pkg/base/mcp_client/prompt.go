@@ -0,0 +1,44 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// loadPrompts server 声明了 prompts 能力时拉取提示词列表，用于按名称路由 prompts/get
+func (m *MCPClient) loadPrompts(ctx context.Context, caps mcp.ServerCapabilities) {
+	if caps.Prompts == nil {
+		return
+	}
+	res, err := m.Client.ListPrompts(ctx, mcp.ListPromptsRequest{})
+	if err != nil {
+		logger.Warnf("list prompts: %v", err)
+		return
+	}
+	m.Prompts = res.Prompts
+}
+
+// GetPrompt 获取渲染后的提示词，多条消息的文本内容按空行拼接
+func (m *MCPClient) GetPrompt(ctx context.Context, name string, args map[string]string) (string, error) {
+	req := mcp.GetPromptRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	res, err := m.Client.GetPrompt(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("get prompt %s: %w", name, err)
+	}
+	parts := make([]string, 0, len(res.Messages))
+	for _, msg := range res.Messages {
+		if text, ok := msg.Content.(mcp.TextContent); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	if len(parts) == 0 {
+		return "", fmt.Errorf("get prompt %s: no text content", name)
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
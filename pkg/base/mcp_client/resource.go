@@ -0,0 +1,63 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// loadResourceTemplates server 声明了 resources 能力时拉取资源模板，用于按 URI scheme 路由 resources/read
+func (m *MCPClient) loadResourceTemplates(ctx context.Context, caps mcp.ServerCapabilities) {
+	if caps.Resources == nil {
+		return
+	}
+	res, err := m.Client.ListResourceTemplates(ctx, mcp.ListResourceTemplatesRequest{})
+	if err != nil {
+		logger.Warnf("list resource templates: %v", err)
+		return
+	}
+	m.ResourceTemplates = res.ResourceTemplates
+}
+
+// resourceSchemes 资源模板覆盖的 URI scheme
+func (m *MCPClient) resourceSchemes() []string {
+	var out []string
+	for _, t := range m.ResourceTemplates {
+		if t.URITemplate == nil {
+			continue
+		}
+		if scheme, _, ok := strings.Cut(t.URITemplate.Raw(), "://"); ok {
+			out = append(out, scheme)
+		}
+	}
+	return out
+}
+
+// ReadResource 读取资源并拼接其文本内容，二进制内容只给出类型与大小；
+// ctx 中的用户 ID 经请求头 constant.MCPHeaderUserID 传给 server，作为校验资源归属的调用方身份
+func (m *MCPClient) ReadResource(ctx context.Context, uri string) (string, error) {
+	req := mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: uri}}
+	if userID, ok := utils.ExtractUserID(ctx); ok {
+		req.Header = http.Header{constant.MCPHeaderUserID: {userID}}
+	}
+	res, err := m.Client.ReadResource(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("read resource %s: %w", uri, err)
+	}
+	var b strings.Builder
+	for _, c := range res.Contents {
+		switch x := c.(type) {
+		case mcp.TextResourceContents:
+			b.WriteString(x.Text)
+		case mcp.BlobResourceContents:
+			fmt.Fprintf(&b, "(binary %s, %d bytes base64)", x.MIMEType, len(x.Blob))
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,120 @@
+package mcp_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToolResult MCP 工具调用结果，保留文本、图片、资源、结构化输出与错误标记
+type ToolResult struct {
+	Text       string         // 文本内容，多段按换行拼接
+	Images     []ToolImage    // 图片内容
+	Resources  []ToolResource // 内嵌资源与资源链接
+	Structured any            // structuredContent
+	IsError    bool           // 工具自身报告的错误（isError），区别于调用失败
+	// Usage 工具内部调用模型产生的 token 用量，来自结果 _meta 的 constant.MCPMetaTokenUsage，由调用方计入用户配额
+	Usage []ai_provider.Usage
+}
+
+// ToolImage 工具返回的图片，Data 为 base64 编码
+type ToolImage struct {
+	MIMEType string
+	Data     string
+}
+
+// DataURL 转成 data URL，供多模态消息与前端直接使用
+func (i ToolImage) DataURL() string {
+	return "data:" + i.MIMEType + ";base64," + i.Data
+}
+
+// ToolResource 工具返回的资源；资源链接只有 URI，二进制资源不带 Text
+type ToolResource struct {
+	URI      string
+	MIMEType string
+	Text     string
+}
+
+// ErrorToolResult 把调用失败包装成错误结果，方便调用方统一回填给模型
+func ErrorToolResult(err error) *ToolResult {
+	return &ToolResult{Text: err.Error(), IsError: true}
+}
+
+func newToolResult(res *mcp.CallToolResult) *ToolResult {
+	out := &ToolResult{Structured: res.StructuredContent, IsError: res.IsError}
+	var texts []string
+	for _, c := range res.Content {
+		switch x := c.(type) {
+		case mcp.TextContent:
+			texts = append(texts, x.Text)
+		case mcp.ImageContent:
+			out.Images = append(out.Images, ToolImage{MIMEType: x.MIMEType, Data: x.Data})
+		case mcp.EmbeddedResource:
+			switch r := x.Resource.(type) {
+			case mcp.TextResourceContents:
+				out.Resources = append(out.Resources, ToolResource{URI: r.URI, MIMEType: r.MIMEType, Text: r.Text})
+			case mcp.BlobResourceContents:
+				out.Resources = append(out.Resources, ToolResource{URI: r.URI, MIMEType: r.MIMEType})
+			}
+		case mcp.ResourceLink:
+			out.Resources = append(out.Resources, ToolResource{URI: x.URI, MIMEType: x.MIMEType})
+		}
+	}
+	out.Text = strings.Join(texts, "\n")
+	out.Usage = tokenUsage(res.Meta)
+	return out
+}
+
+// tokenUsage 解析 _meta 中回报的 token 用量，格式不对时忽略
+func tokenUsage(meta *mcp.Meta) []ai_provider.Usage {
+	if meta == nil || meta.AdditionalFields[constant.MCPMetaTokenUsage] == nil {
+		return nil
+	}
+	data, err := json.Marshal(meta.AdditionalFields[constant.MCPMetaTokenUsage])
+	if err != nil {
+		return nil
+	}
+	var usage []ai_provider.Usage
+	if err := json.Unmarshal(data, &usage); err != nil {
+		logger.Warnf("mcp_client: ignore malformed %s in tool result: %v", constant.MCPMetaTokenUsage, err)
+		return nil
+	}
+	return usage
+}
+
+// String 回填给模型的文本：文本内容与资源，没有文本时退回结构化输出；
+// isError 时带 "tool error: " 前缀，让模型能区分失败
+func (r *ToolResult) String() string {
+	var b strings.Builder
+	b.WriteString(r.Text)
+	for _, res := range r.Resources {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		if res.Text != "" {
+			fmt.Fprintf(&b, "<resource uri=%q>\n%s\n</resource>", res.URI, res.Text)
+		} else {
+			fmt.Fprintf(&b, "(resource %s, %s)", res.URI, res.MIMEType)
+		}
+	}
+	if b.Len() == 0 && r.Structured != nil {
+		data, _ := json.Marshal(r.Structured)
+		b.Write(data)
+	}
+	if b.Len() == 0 {
+		if len(r.Images) > 0 {
+			fmt.Fprintf(&b, "(%d image(s))", len(r.Images))
+		} else {
+			b.WriteString("(no content)")
+		}
+	}
+	if r.IsError {
+		return "tool error: " + b.String()
+	}
+	return b.String()
+}
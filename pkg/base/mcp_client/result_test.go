@@ -0,0 +1,72 @@
+package mcp_client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestToolResult(t *testing.T) {
+	Convey("newToolResult", t, func() {
+		res := newToolResult(&mcp.CallToolResult{Content: []mcp.Content{
+			mcp.NewTextContent("line 1"),
+			mcp.NewImageContent("iVBORw0K", "image/png"),
+			mcp.NewTextContent("line 2"),
+			mcp.NewEmbeddedResource(mcp.TextResourceContents{URI: "todo://u1/1", MIMEType: "application/json", Text: `{"id":1}`}),
+			mcp.NewEmbeddedResource(mcp.BlobResourceContents{URI: "file:///a.bin", MIMEType: "application/octet-stream", Blob: "AAAA"}),
+			mcp.NewResourceLink("https://example.com/doc", "doc", "", "text/html"),
+		}})
+
+		So(res.Text, ShouldEqual, "line 1\nline 2")
+		So(res.Images, ShouldResemble, []ToolImage{{MIMEType: "image/png", Data: "iVBORw0K"}})
+		So(res.Images[0].DataURL(), ShouldEqual, "data:image/png;base64,iVBORw0K")
+		So(res.Resources, ShouldResemble, []ToolResource{
+			{URI: "todo://u1/1", MIMEType: "application/json", Text: `{"id":1}`},
+			{URI: "file:///a.bin", MIMEType: "application/octet-stream"},
+			{URI: "https://example.com/doc", MIMEType: "text/html"},
+		})
+		So(res.String(), ShouldEqual, "line 1\nline 2\n"+
+			"<resource uri=\"todo://u1/1\">\n{\"id\":1}\n</resource>\n"+
+			"(resource file:///a.bin, application/octet-stream)\n"+
+			"(resource https://example.com/doc, text/html)")
+	})
+
+	Convey("token usage reported in _meta", t, func() {
+		var res mcp.CallToolResult
+		So(json.Unmarshal([]byte(`{"content":[{"type":"text","text":"ok"}],"_meta":{"token_usage":[
+			{"provider":"aliyun","model":"qwen-plus","prompt_tokens":10,"completion_tokens":5,"total_tokens":15}]}}`), &res), ShouldBeNil)
+		So(newToolResult(&res).Usage, ShouldResemble, []ai_provider.Usage{
+			{Provider: "aliyun", Model: "qwen-plus", PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		})
+
+		res.Meta = mcp.NewMetaFromMap(map[string]any{"token_usage": "bogus"})
+		So(newToolResult(&res).Usage, ShouldBeNil)
+		res.Meta = nil
+		So(newToolResult(&res).Usage, ShouldBeNil)
+	})
+
+	Convey("ToolResult.String", t, func() {
+		cases := []struct {
+			name string
+			res  ToolResult
+			want string
+		}{
+			{"text wins over structured output", ToolResult{Text: "ok", Structured: map[string]any{"n": 1}}, "ok"},
+			{"falls back to structured output", ToolResult{Structured: map[string]any{"n": 1}}, `{"n":1}`},
+			{"counts images without text", ToolResult{Images: []ToolImage{{}, {}}}, "(2 image(s))"},
+			{"empty result", ToolResult{}, "(no content)"},
+			{"errors are prefixed", ToolResult{Text: "boom", IsError: true}, "tool error: boom"},
+			{"empty errors are prefixed", ToolResult{IsError: true}, "tool error: (no content)"},
+			{"call failures", *ErrorToolResult(errors.New("dial failed")), "tool error: dial failed"},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				So(c.res.String(), ShouldEqual, c.want)
+			})
+		}
+	})
+}
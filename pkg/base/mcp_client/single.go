@@ -4,10 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 	mcpc "github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/openai/openai-go/v2"
@@ -15,19 +16,32 @@ import (
 )
 
 type MCPClient struct {
-	Client *mcpc.Client
-	Tools  []mcp.Tool
+	Client            *mcpc.Client
+	Tools             []mcp.Tool
+	ResourceTemplates []mcp.ResourceTemplate
+	Prompts           []mcp.Prompt
+
+	progress *progressRouter
+}
+
+func newMCPClient(c *mcpc.Client, tools []mcp.Tool) *MCPClient {
+	return &MCPClient{Client: c, Tools: tools, progress: newProgressRouter(c)}
 }
 
-// NewMCPClient 启动 MCP Server 并建立连接
+// NewMCPClient 启动 MCP Server 并建立连接；http 传输在启用授权时自动附带 bearer 令牌
 func NewMCPClient(url string) (*MCPClient, error) {
+	return dialMCPClient(url, newTokenSource())
+}
+
+// dialMCPClient 按 mcp.transport 建立连接，http 传输使用 tokens 获取令牌（nil 表示不附带）
+func dialMCPClient(url string, tokens *tokenSource) (*MCPClient, error) {
 	switch config.MCP.Transport {
 	case "stdio", "":
 		return newStdioMCPClient()
 	case "sse":
 		return newSSEMCPClientWithConn(url)
 	case "http":
-		return newHTTPMCPClientWithConn(url)
+		return newHTTPMCPClientWithConn(url, tokens.options()...)
 	default:
 		return nil, fmt.Errorf("unknown MCP transport: %s", config.MCP.Transport)
 	}
@@ -82,52 +96,39 @@ func (m *MCPClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam
 	return out
 }
 
-// CallTool 调用 MCP 工具
-func (m *MCPClient) CallTool(ctx context.Context, name string, args any) (string, error) {
-	// 设置进度通知处理（这里应该用不上，是streamable HTTP的特性，太高级了）
-	//m.Client.OnNotification(func(notification mcp.JSONRPCNotification) {
-	//	logger.Infof("Received notification: %v", notification)
-	//	if notification.Method == "notifications/progress" {
-	//		fmt.Println(notification)
-	//		params := notification.Params.AdditionalFields
-	//		progress := params["progress"].(float64)
-	//		total := params["total"].(float64)
-	//		message := params["message"].(string)
-	//
-	//		// 打印进度信息
-	//		fmt.Printf("Progress: %.2f%% - %s\n", (progress/total)*100, message)
-	//	}
-	//})
-
+// CallTool 调用 MCP 工具，ctx 经 WithProgress 订阅时会转发该次调用的进度通知
+func (m *MCPClient) CallTool(ctx context.Context, name string, args any) (*ToolResult, error) {
+	meta := &mcp.Meta{}
+	// 调用方订阅了进度时才携带 progressToken，server 据此决定是否发送 notifications/progress
+	if fn, ok := progressFrom(ctx); ok && m.progress != nil {
+		token, unregister := m.progress.register(fn)
+		defer unregister()
+		meta.ProgressToken = token
+	}
+	// 携带对话 ID，供 server 端按对话维护状态（如文件修改的撤销日志）
+	// 携带用户 ID，供 server 端按用户限流与审计
+	fields := map[string]any{}
+	if conversationID, ok := utils.ExtractConversationID(ctx); ok {
+		fields[constant.MCPMetaConversationID] = conversationID
+	}
+	if userID, ok := utils.ExtractUserID(ctx); ok {
+		fields[constant.MCPMetaUserID] = userID
+	}
+	if len(fields) > 0 {
+		meta.AdditionalFields = fields
+	}
 	res, err := m.Client.CallTool(ctx, mcp.CallToolRequest{
 		Params: mcp.CallToolParams{
 			Name:      name,
 			Arguments: args,
-			Meta: &mcp.Meta{
-				ProgressToken: time.Now().Unix(),
-			},
+			Meta:      meta,
 		},
 	})
 	if err != nil {
 		logger.Errorf("call tool %s: %v", name, err)
-		return "", fmt.Errorf("call tool %s: %w", name, err)
-	}
-
-	// 提取返回文本
-	var text string
-	for _, c := range res.Content {
-		if tc, ok := mcp.AsTextContent(c); ok {
-			text += tc.Text + "\n"
-		}
-	}
-	if text == "" && res.StructuredContent != nil {
-		b, _ := json.Marshal(res.StructuredContent)
-		text = string(b)
-	}
-	if text == "" {
-		text = "(no content)"
+		return nil, fmt.Errorf("call tool %s: %w", name, err)
 	}
-	return text, nil
+	return newToolResult(res), nil
 }
 
 // Close 关闭连接
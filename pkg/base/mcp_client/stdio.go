@@ -23,7 +23,11 @@ func newStdioMCPClient() (*MCPClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPClientInitTimeout)
 	defer cancel()
 
-	_, err = client.Initialize(ctx, mcp.InitializeRequest{
+	// 传输层已启动，这里的 Start 只为挂上通知分发（进度通知依赖它）
+	if err := client.Start(ctx); err != nil {
+		return nil, fmt.Errorf("start stdio client: %w", err)
+	}
+	initRes, err := client.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
 			ClientInfo: mcp.Implementation{
 				Name:    "mcp-host",
@@ -39,5 +43,8 @@ func newStdioMCPClient() (*MCPClient, error) {
 	if err != nil {
 		return nil, fmt.Errorf("list tools: %w", err)
 	}
-	return &MCPClient{Client: client, Tools: res.Tools}, nil
+	cli := newMCPClient(client, res.Tools)
+	cli.loadResourceTemplates(ctx, initRes.Capabilities)
+	cli.loadPrompts(ctx, initRes.Capabilities)
+	return cli, nil
 }
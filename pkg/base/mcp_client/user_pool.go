@@ -0,0 +1,441 @@
+package mcp_client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/openai/openai-go/v2"
+)
+
+// UserServer 用户自定义的 MCP 服务（凭据已解密）
+type UserServer struct {
+	ID      string
+	Name    string
+	URL     string
+	Headers map[string]string
+}
+
+// fingerprint URL 或凭据变化后需要重新建立连接
+func (s *UserServer) fingerprint() string {
+	h := sha256.New()
+	h.Write([]byte(s.URL))
+	keys := make([]string, 0, len(s.Headers))
+	for k := range s.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(s.Headers[k]))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// userConn 池中的一个连接。对话期间 userToolClient 持有引用，
+// 被池移除（淘汰、回收、凭据变更）时若仍有引用，推迟到最后一个引用释放后再关闭
+type userConn struct {
+	name        string
+	fingerprint string
+	cli         *MCPClient
+
+	mu      sync.Mutex
+	refs    int
+	retired bool
+}
+
+// retain 由 Acquire 在 entry.mu 内调用，此时连接一定还未被移除
+func (c *userConn) retain() {
+	c.mu.Lock()
+	c.refs++
+	c.mu.Unlock()
+}
+
+func (c *userConn) release() {
+	c.mu.Lock()
+	c.refs--
+	closeNow := c.refs == 0 && c.retired
+	c.mu.Unlock()
+	if closeNow {
+		c.cli.Close()
+	}
+}
+
+// retire 连接已从池中移除，没有引用时立即关闭
+func (c *userConn) retire() {
+	c.mu.Lock()
+	c.retired = true
+	closeNow := c.refs == 0
+	c.mu.Unlock()
+	if closeNow {
+		c.cli.Close()
+	}
+}
+
+type userEntry struct {
+	mu       sync.Mutex
+	conns    map[string]*userConn // serverID -> conn
+	failed   map[string]time.Time // fingerprint -> 上次连接失败时间
+	lastUsed time.Time
+}
+
+// UserClientPool 按用户维护其自定义 MCP 服务的连接：
+// 连接在首次对话时建立并复用，空闲超时后回收，总连接数超限时按最久未使用淘汰
+type UserClientPool struct {
+	maxPerUser  int
+	maxConns    int
+	idleTimeout time.Duration
+	dial        func(s *UserServer) (*MCPClient, error)
+
+	mu    sync.Mutex
+	users map[string]*userEntry
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func NewUserClientPool() *UserClientPool {
+	httpClient := userHTTPClient(config.MCP.User.AllowPrivate)
+	p := &UserClientPool{
+		maxPerUser:  config.MCP.User.MaxServersPerUser,
+		maxConns:    config.MCP.User.MaxConnections,
+		idleTimeout: config.MCP.User.IdleTimeout,
+		dial: func(s *UserServer) (*MCPClient, error) {
+			return newHTTPMCPClientWithConn(s.URL, transport.WithHTTPHeaders(s.Headers), transport.WithHTTPBasicClient(httpClient))
+		},
+		users:  make(map[string]*userEntry),
+		stopCh: make(chan struct{}),
+	}
+	if p.maxPerUser <= 0 {
+		p.maxPerUser = constant.UserMCPMaxServersPerUser
+	}
+	if p.maxConns <= 0 {
+		p.maxConns = constant.UserMCPMaxConnections
+	}
+	if p.idleTimeout <= 0 {
+		p.idleTimeout = constant.UserMCPIdleTimeout
+	}
+	go p.evictLoop()
+	return p
+}
+
+// ErrPrivateAddress 用户 MCP 服务地址解析到内网或回环地址
+var ErrPrivateAddress = errors.New("user mcp: refusing to connect to a private or loopback address")
+
+// userHTTPClient 连接用户 MCP 服务的 HTTP 客户端。地址由用户填写，
+// 默认在建立连接时校验解析后的 IP，避免借 host 访问内网服务（SSRF）
+func userHTTPClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: constant.MCPClientInitTimeout}
+	if !allowPrivate {
+		dialer.Control = utils.PublicDialControl(ErrPrivateAddress)
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			// 不走环境代理，否则连接的是代理地址，无法校验目标地址
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: constant.MCPClientInitTimeout,
+			MaxIdleConns:        64,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// MaxServersPerUser 单用户允许的 MCP 服务数
+func (p *UserClientPool) MaxServersPerUser() int {
+	return p.maxPerUser
+}
+
+// Acquire 返回合并了全局工具与用户私有工具的 ToolClient，以及用完后必须调用的 release。
+// release 之前其中的连接不会被关闭，即使期间被淘汰或回收；release 时刷新用户的最近使用时间。
+// 连接失败的服务会被跳过（并在 constant.UserMCPDialRetryInterval 内不再重试），不影响全局工具的使用
+func (p *UserClientPool) Acquire(base ToolClient, userID string, servers []UserServer) (ToolClient, func()) {
+	if len(servers) == 0 {
+		return base, func() {}
+	}
+	if len(servers) > p.maxPerUser {
+		servers = servers[:p.maxPerUser]
+	}
+
+	p.mu.Lock()
+	entry, ok := p.users[userID]
+	if !ok {
+		entry = &userEntry{
+			conns:  make(map[string]*userConn),
+			failed: make(map[string]time.Time),
+		}
+		p.users[userID] = entry
+	}
+	entry.lastUsed = time.Now()
+	p.mu.Unlock()
+
+	entry.mu.Lock()
+	want := make(map[string]struct{}, len(servers))
+	for i := range servers {
+		s := &servers[i]
+		want[s.ID] = struct{}{}
+		fp := s.fingerprint()
+		if c, ok := entry.conns[s.ID]; ok {
+			if c.fingerprint == fp {
+				c.name = s.Name
+				continue
+			}
+			// URL 或凭据已更新，关闭旧连接
+			c.retire()
+			delete(entry.conns, s.ID)
+		}
+		if t, ok := entry.failed[fp]; ok && time.Since(t) < constant.UserMCPDialRetryInterval {
+			continue
+		}
+		cli, err := p.dial(s)
+		if err != nil {
+			logger.Warnf("user mcp: user %s dial %s(%s): %v", userID, s.Name, s.URL, err)
+			entry.failed[fp] = time.Now()
+			continue
+		}
+		delete(entry.failed, fp)
+		entry.conns[s.ID] = &userConn{name: s.Name, fingerprint: fp, cli: cli}
+		logger.Infof("user mcp: user %s connected %s (tools=%d)", userID, s.Name, len(cli.Tools))
+	}
+	// 已删除或停用的服务
+	for id, c := range entry.conns {
+		if _, ok := want[id]; !ok {
+			c.retire()
+			delete(entry.conns, id)
+		}
+	}
+	conns := make([]*userConn, 0, len(entry.conns))
+	for _, c := range entry.conns {
+		c.retain()
+		conns = append(conns, c)
+	}
+	entry.mu.Unlock()
+
+	p.enforceLimit(userID)
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			for _, c := range conns {
+				c.release()
+			}
+			p.mu.Lock()
+			entry.lastUsed = time.Now()
+			p.mu.Unlock()
+		})
+	}
+	return newUserToolClient(base, conns), release
+}
+
+// Evict 关闭用户的全部连接（如用户删除或修改了 MCP 服务）
+func (p *UserClientPool) Evict(userID string) {
+	p.mu.Lock()
+	entry, ok := p.users[userID]
+	delete(p.users, userID)
+	p.mu.Unlock()
+	if ok {
+		entry.close()
+	}
+}
+
+// Close 停止回收协程并关闭全部连接
+func (p *UserClientPool) Close() {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+	p.mu.Lock()
+	users := p.users
+	p.users = make(map[string]*userEntry)
+	p.mu.Unlock()
+	for _, entry := range users {
+		entry.close()
+	}
+}
+
+func (e *userEntry) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id, c := range e.conns {
+		c.retire()
+		delete(e.conns, id)
+	}
+}
+
+func (e *userEntry) size() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.conns)
+}
+
+// evictLoop 定期回收空闲用户的连接
+func (p *UserClientPool) evictLoop() {
+	ticker := time.NewTicker(p.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.evictIdle()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+func (p *UserClientPool) evictIdle() {
+	var idle []*userEntry
+	p.mu.Lock()
+	for uid, entry := range p.users {
+		if time.Since(entry.lastUsed) > p.idleTimeout {
+			idle = append(idle, entry)
+			delete(p.users, uid)
+			logger.Infof("user mcp: evict idle connections of user %s", uid)
+		}
+	}
+	p.mu.Unlock()
+	for _, entry := range idle {
+		entry.close()
+	}
+}
+
+// enforceLimit 总连接数超限时，按最久未使用淘汰其他用户的连接
+func (p *UserClientPool) enforceLimit(currentUser string) {
+	type candidate struct {
+		uid      string
+		entry    *userEntry
+		lastUsed time.Time
+	}
+	var (
+		total      int
+		candidates []candidate
+	)
+	p.mu.Lock()
+	for uid, entry := range p.users {
+		total += entry.size()
+		if uid != currentUser {
+			candidates = append(candidates, candidate{uid: uid, entry: entry, lastUsed: entry.lastUsed})
+		}
+	}
+	if total <= p.maxConns {
+		p.mu.Unlock()
+		return
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].lastUsed.Before(candidates[j].lastUsed) })
+	var evicted []*userEntry
+	for _, c := range candidates {
+		if total <= p.maxConns {
+			break
+		}
+		total -= c.entry.size()
+		delete(p.users, c.uid)
+		evicted = append(evicted, c.entry)
+		logger.Warnf("user mcp: connection limit %d reached, evict user %s", p.maxConns, c.uid)
+	}
+	p.mu.Unlock()
+	for _, entry := range evicted {
+		entry.close()
+	}
+}
+
+// userToolRoute 对外暴露的工具名 -> 实际连接与工具名
+type userToolRoute struct {
+	cli  *MCPClient
+	name string
+}
+
+// userToolClient 全局工具 + 用户私有工具的合并视图。
+// 私有工具与全局工具重名时以 服务名__工具名 暴露，全局工具优先；
+// 连接由 UserClientPool 管理，Close 不关闭底层连接，用完后调用 Acquire 返回的 release
+type userToolClient struct {
+	base   ToolClient
+	tools  []mcp.Tool
+	routes map[string]userToolRoute
+}
+
+func newUserToolClient(base ToolClient, conns []*userConn) *userToolClient {
+	taken := make(map[string]struct{})
+	if base != nil {
+		for _, t := range base.ConvertToolsToOpenAI() {
+			if t.OfFunction != nil {
+				taken[t.OfFunction.Function.Name] = struct{}{}
+			}
+		}
+	}
+	// 稳定顺序（按服务名）
+	sort.Slice(conns, func(i, j int) bool { return conns[i].name < conns[j].name })
+
+	u := &userToolClient{base: base, routes: make(map[string]userToolRoute)}
+	for _, c := range conns {
+		for _, t := range c.cli.Tools {
+			exposed := t.Name
+			if _, dup := taken[exposed]; dup {
+				exposed = c.name + constant.UserMCPToolSeparator + t.Name
+			}
+			if _, dup := taken[exposed]; dup {
+				logger.Warnf("user mcp: duplicated tool %s from %s, skipped", t.Name, c.name)
+				continue
+			}
+			taken[exposed] = struct{}{}
+			route := userToolRoute{cli: c.cli, name: t.Name}
+			t.Name = exposed
+			u.tools = append(u.tools, t)
+			u.routes[exposed] = route
+		}
+	}
+	return u
+}
+
+func (u *userToolClient) ConvertToolsToOllama() []map[string]any {
+	var out []map[string]any
+	if u.base != nil {
+		out = u.base.ConvertToolsToOllama()
+	}
+	return append(out, (&MCPClient{Tools: u.tools}).ConvertToolsToOllama()...)
+}
+
+func (u *userToolClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam {
+	var out []openai.ChatCompletionToolUnionParam
+	if u.base != nil {
+		out = u.base.ConvertToolsToOpenAI()
+	}
+	return append(out, (&MCPClient{Tools: u.tools}).ConvertToolsToOpenAI()...)
+}
+
+func (u *userToolClient) CallTool(ctx context.Context, name string, args any) (*ToolResult, error) {
+	if r, ok := u.routes[name]; ok {
+		return r.cli.CallTool(ctx, r.name, args)
+	}
+	if u.base == nil {
+		return nil, fmt.Errorf("tool %q not found", name)
+	}
+	return u.base.CallTool(ctx, name, args)
+}
+
+// ReadResource 资源只由全局 MCP 服务提供
+func (u *userToolClient) ReadResource(ctx context.Context, uri string) (string, error) {
+	if u.base == nil {
+		return "", fmt.Errorf("resource %q not found", uri)
+	}
+	return u.base.ReadResource(ctx, uri)
+}
+
+// GetPrompt 提示词只由全局 MCP 服务提供
+func (u *userToolClient) GetPrompt(ctx context.Context, name string, args map[string]string) (string, error) {
+	if u.base == nil {
+		return "", fmt.Errorf("prompt %q not found", name)
+	}
+	return u.base.GetPrompt(ctx, name, args)
+}
+
+// Close 连接归 UserClientPool 所有，这里不做任何事
+func (u *userToolClient) Close() {}
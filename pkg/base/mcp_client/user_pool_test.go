@@ -0,0 +1,221 @@
+package mcp_client
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/openai/openai-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newEchoServer 启动一个只提供 echo 工具的 Streamable HTTP MCP 服务，工具返回 prefix+text
+func newEchoServer(prefix string) *httptest.Server {
+	s := server.NewMCPServer("echo", "0.0.1")
+	s.AddTool(mcp.NewTool("echo", mcp.WithString("text")), func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(prefix + req.GetString("text", "")), nil
+	})
+	return httptest.NewServer(server.NewStreamableHTTPServer(s))
+}
+
+// baseClient 只提供一个名为 echo 的全局工具
+type baseClient struct{ ToolClient }
+
+func (baseClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam {
+	return (&MCPClient{Tools: []mcp.Tool{mcp.NewTool("echo")}}).ConvertToolsToOpenAI()
+}
+
+func (baseClient) CallTool(context.Context, string, any) (*ToolResult, error) {
+	return &ToolResult{Text: "base"}, nil
+}
+
+func toolNames(c ToolClient) []string {
+	var names []string
+	for _, t := range c.ConvertToolsToOpenAI() {
+		names = append(names, t.OfFunction.Function.Name)
+	}
+	return names
+}
+
+func TestUserClientPool(t *testing.T) {
+	alpha, beta := newEchoServer("alpha:"), newEchoServer("beta:")
+	defer alpha.Close()
+	defer beta.Close()
+
+	var dials []string
+	newPool := func(maxPerUser, maxConns int) *UserClientPool {
+		httpClient := userHTTPClient(true)
+		dials = nil
+		return &UserClientPool{
+			maxPerUser:  maxPerUser,
+			maxConns:    maxConns,
+			idleTimeout: time.Minute,
+			dial: func(s *UserServer) (*MCPClient, error) {
+				dials = append(dials, s.Name)
+				return newHTTPMCPClientWithConn(s.URL, transport.WithHTTPHeaders(s.Headers), transport.WithHTTPBasicClient(httpClient))
+			},
+			users:  make(map[string]*userEntry),
+			stopCh: make(chan struct{}),
+		}
+	}
+	size := func(p *UserClientPool, uid string) int {
+		p.mu.Lock()
+		entry, ok := p.users[uid]
+		p.mu.Unlock()
+		if !ok {
+			return 0
+		}
+		return entry.size()
+	}
+
+	Convey("UserClientPool", t, func() {
+		Convey("merges user tools with global tools and routes calls", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			cli, release := p.Acquire(baseClient{}, "u1", []UserServer{
+				{ID: "1", Name: "alpha", URL: alpha.URL},
+				{ID: "2", Name: "beta", URL: beta.URL},
+			})
+			defer release()
+			So(toolNames(cli), ShouldResemble, []string{"echo", "alpha__echo", "beta__echo"})
+
+			res, err := cli.CallTool(context.Background(), "beta__echo", map[string]any{"text": "hi"})
+			So(err, ShouldBeNil)
+			So(res.Text, ShouldEqual, "beta:hi")
+			res, err = cli.CallTool(context.Background(), "echo", nil)
+			So(err, ShouldBeNil)
+			So(res.Text, ShouldEqual, "base")
+		})
+
+		Convey("keeps the bare tool name when it does not collide", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			cli, release := p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}})
+			defer release()
+			So(toolNames(cli), ShouldResemble, []string{"echo"})
+		})
+
+		Convey("reuses connections and reconnects when the url or credentials change", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			servers := []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}}
+			p.Acquire(nil, "u1", servers)
+			p.Acquire(nil, "u1", servers)
+			So(dials, ShouldResemble, []string{"alpha"})
+
+			servers[0].Headers = map[string]string{"Authorization": "Bearer new"}
+			p.Acquire(nil, "u1", servers)
+			So(dials, ShouldResemble, []string{"alpha", "alpha"})
+
+			servers[0].URL = beta.URL
+			cli, release := p.Acquire(nil, "u1", servers)
+			defer release()
+			res, err := cli.CallTool(context.Background(), "echo", map[string]any{"text": "x"})
+			So(err, ShouldBeNil)
+			So(res.Text, ShouldEqual, "beta:x")
+		})
+
+		Convey("closes connections of removed servers", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}, {ID: "2", Name: "beta", URL: beta.URL}})
+			So(size(p, "u1"), ShouldEqual, 2)
+			cli, release := p.Acquire(nil, "u1", []UserServer{{ID: "2", Name: "beta", URL: beta.URL}})
+			defer release()
+			So(size(p, "u1"), ShouldEqual, 1)
+			So(toolNames(cli), ShouldResemble, []string{"echo"})
+		})
+
+		Convey("does not redial a failed server within the retry interval", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			down := []UserServer{{ID: "1", Name: "down", URL: "http://127.0.0.1:1/mcp"}}
+			cli, release := p.Acquire(baseClient{}, "u1", down)
+			defer release()
+			So(toolNames(cli), ShouldResemble, []string{"echo"})
+			p.Acquire(baseClient{}, "u1", down)
+			So(dials, ShouldResemble, []string{"down"})
+		})
+
+		Convey("caps servers per user", func() {
+			p := newPool(1, 10)
+			defer p.Close()
+			p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}, {ID: "2", Name: "beta", URL: beta.URL}})
+			So(dials, ShouldResemble, []string{"alpha"})
+		})
+
+		Convey("evicts the least recently used user over the connection limit", func() {
+			p := newPool(5, 2)
+			defer p.Close()
+			p.Acquire(nil, "old", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}})
+			p.Acquire(nil, "mid", []UserServer{{ID: "2", Name: "alpha", URL: alpha.URL}})
+			p.Acquire(nil, "new", []UserServer{{ID: "3", Name: "beta", URL: beta.URL}})
+			So(size(p, "old"), ShouldEqual, 0)
+			So(size(p, "mid"), ShouldEqual, 1)
+			So(size(p, "new"), ShouldEqual, 1)
+		})
+
+		Convey("evicts idle users", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}})
+			p.users["u1"].lastUsed = time.Now().Add(-2 * time.Minute)
+			p.evictIdle()
+			So(size(p, "u1"), ShouldEqual, 0)
+		})
+
+		Convey("keeps evicted connections open until they are released", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			cli, release := p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}})
+			conn := p.users["u1"].conns["1"]
+			p.Evict("u1")
+			res, err := cli.CallTool(context.Background(), "echo", map[string]any{"text": "still open"})
+			So(err, ShouldBeNil)
+			So(res.Text, ShouldEqual, "alpha:still open")
+
+			release()
+			release()
+			So(conn.refs, ShouldEqual, 0)
+			_, err = cli.CallTool(context.Background(), "echo", map[string]any{"text": "closed"})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("refreshes the last use on release", func() {
+			p := newPool(5, 10)
+			defer p.Close()
+			_, release := p.Acquire(nil, "u1", []UserServer{{ID: "1", Name: "alpha", URL: alpha.URL}})
+			p.users["u1"].lastUsed = time.Now().Add(-2 * time.Minute)
+			release()
+			p.evictIdle()
+			So(size(p, "u1"), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestUserHTTPClient(t *testing.T) {
+	srv := newEchoServer("")
+	defer srv.Close()
+	port := srv.URL[strings.LastIndex(srv.URL, ":"):]
+
+	Convey("userHTTPClient", t, func() {
+		Convey("refuses loopback addresses, including names resolving to them", func() {
+			for _, u := range []string{srv.URL, "http://localhost" + port} {
+				_, err := userHTTPClient(false).Get(u)
+				So(errors.Is(err, ErrPrivateAddress), ShouldBeTrue)
+			}
+		})
+
+		Convey("allows them when explicitly enabled", func() {
+			resp, err := userHTTPClient(true).Get(srv.URL)
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+		})
+	})
+}
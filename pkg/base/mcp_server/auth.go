@@ -0,0 +1,116 @@
+package mcp_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/jwt"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// bearerAuth 校验 Authorization: Bearer 令牌，并把令牌放入请求 context 以便工具按 scope 鉴权。
+// 校验失败按 MCP 授权规范返回 401，并在 WWW-Authenticate 中给出受保护资源元数据地址
+func bearerAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			unauthorized(w, r, "")
+			return
+		}
+		claims, err := jwt.VerifyMCPAccessToken(strings.TrimSpace(token))
+		if err != nil {
+			unauthorized(w, r, "invalid_token")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(jwt.WithMCPClaims(r.Context(), claims)))
+	})
+}
+
+func unauthorized(w http.ResponseWriter, r *http.Request, errCode string) {
+	challenge := fmt.Sprintf(`Bearer resource_metadata=%q`, baseURL(r)+constant.MCPAuthProtectedResourcePath)
+	if errCode != "" {
+		challenge += fmt.Sprintf(`, error=%q`, errCode)
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+// protectedResourceMetadata 受保护资源元数据（RFC 9728），告知客户端到哪个授权服务器获取令牌
+func protectedResourceMetadata(w http.ResponseWriter, r *http.Request) {
+	var authServers []string
+	if config.MCP.Auth.Issuer != "" {
+		authServers = append(authServers, config.MCP.Auth.Issuer)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"resource":                 baseURL(r) + constant.RegistryMCPDefaultPath,
+		"authorization_servers":    authServers,
+		"scopes_supported":         supportedScopes(),
+		"bearer_methods_supported": []string{"header"},
+	})
+}
+
+// baseURL 以请求的 Host 拼出对外地址，监听 0.0.0.0 时也能给出客户端可访问的地址
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// supportedScopes 基础 scope 与各工具所需 scope 的并集
+func supportedScopes() []string {
+	set := map[string]struct{}{constant.MCPAuthDefaultScope: {}}
+	for _, scope := range config.MCP.Auth.ToolScopes {
+		set[scope] = struct{}{}
+	}
+	scopes := make([]string, 0, len(set))
+	for scope := range set {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// requiredScope 调用工具所需的 scope；配置经 viper 读取后 key 为小写，这里按小写匹配
+func requiredScope(tool string) string {
+	if scope, ok := config.MCP.Auth.ToolScopes[strings.ToLower(tool)]; ok && scope != "" {
+		return scope
+	}
+	return constant.MCPAuthDefaultScope
+}
+
+// scopeMiddleware 令牌缺少工具所需 scope 时拒绝调用；stdio 等不经过 bearerAuth 的传输不做限制
+func scopeMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if claims, ok := jwt.MCPClaimsFrom(ctx); ok {
+			if scope := requiredScope(req.Params.Name); !claims.HasScope(scope) {
+				return mcp.NewToolResultError(fmt.Sprintf("insufficient_scope: tool %s requires scope %s", req.Params.Name, scope)), nil
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// scopeFilter tools/list 只返回令牌有权调用的工具
+func scopeFilter(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+	claims, ok := jwt.MCPClaimsFrom(ctx)
+	if !ok {
+		return tools
+	}
+	allowed := make([]mcp.Tool, 0, len(tools))
+	for _, t := range tools {
+		if claims.HasScope(requiredScope(t.Name)) {
+			allowed = append(allowed, t)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,159 @@
+package mcp_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/jwt"
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// loadAuthConfig 以临时配置文件启用 MCP 授权，测试之间共用
+func loadAuthConfig(t *testing.T) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(`
+server:
+  private-key: "user-secret"
+mcp:
+  auth:
+    enable: true
+    signing_key: "mcp-secret"
+    issuer: "http://127.0.0.1:10001"
+    audience: "go-mcp-demo"
+    tool_scopes:
+      code_run: "mcp:tools:exec"
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.Load(path, "mcp_local")
+}
+
+func signMCPToken(key, audience string, expiresAt time.Time, scope string) string {
+	claims := &jwt.MCPClaims{
+		Scope:    scope,
+		ClientID: "host",
+		RegisteredClaims: gojwt.RegisteredClaims{
+			Subject:   "host",
+			Audience:  gojwt.ClaimStrings{audience},
+			Issuer:    "http://127.0.0.1:10001",
+			IssuedAt:  gojwt.NewNumericDate(expiresAt.Add(-time.Hour)),
+			ExpiresAt: gojwt.NewNumericDate(expiresAt),
+		},
+	}
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, claims).SignedString([]byte(key))
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
+func TestBearerAuth(t *testing.T) {
+	loadAuthConfig(t)
+
+	var got *jwt.MCPClaims
+	handler := bearerAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = jwt.MCPClaimsFrom(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	serve := func(authorization string) *httptest.ResponseRecorder {
+		got = nil
+		req := httptest.NewRequest(http.MethodPost, "http://mcp.local/mcp", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	later := time.Now().Add(time.Hour)
+
+	Convey("bearerAuth", t, func() {
+		Convey("challenges requests without a token", func() {
+			rec := serve("")
+			So(rec.Code, ShouldEqual, http.StatusUnauthorized)
+			So(rec.Header().Get("WWW-Authenticate"), ShouldEqual,
+				`Bearer resource_metadata="http://mcp.local/.well-known/oauth-protected-resource"`)
+		})
+
+		Convey("accepts a valid token and exposes its claims", func() {
+			token, _, err := jwt.GenerateMCPAccessToken("host", []string{"mcp:tools"})
+			So(err, ShouldBeNil)
+			rec := serve("Bearer " + token)
+			So(rec.Code, ShouldEqual, http.StatusNoContent)
+			So(got, ShouldNotBeNil)
+			So(got.ClientID, ShouldEqual, "host")
+		})
+
+		for name, token := range map[string]string{
+			"wrong secret":   signMCPToken("user-secret", "go-mcp-demo", later, "mcp:tools"),
+			"expired":        signMCPToken("mcp-secret", "go-mcp-demo", time.Now().Add(-time.Minute), "mcp:tools"),
+			"wrong audience": signMCPToken("mcp-secret", "another-api", later, "mcp:tools"),
+			"garbage":        "not-a-jwt",
+		} {
+			Convey("rejects a token with "+name, func() {
+				rec := serve("Bearer " + token)
+				So(rec.Code, ShouldEqual, http.StatusUnauthorized)
+				So(rec.Header().Get("WWW-Authenticate"), ShouldContainSubstring, `error="invalid_token"`)
+				So(got, ShouldBeNil)
+			})
+		}
+
+		Convey("rejects a user login token", func() {
+			token, err := jwt.GenerateAccessToken("102301000")
+			So(err, ShouldBeNil)
+			So(serve("Bearer "+token).Code, ShouldEqual, http.StatusUnauthorized)
+		})
+	})
+}
+
+func TestScopes(t *testing.T) {
+	loadAuthConfig(t)
+
+	tools := []mcp.Tool{mcp.NewTool("todo_list"), mcp.NewTool("code_run")}
+	names := func(tools []mcp.Tool) []string {
+		var out []string
+		for _, t := range tools {
+			out = append(out, t.Name)
+		}
+		return out
+	}
+	withScope := func(scope string) context.Context {
+		return jwt.WithMCPClaims(context.Background(), &jwt.MCPClaims{Scope: scope})
+	}
+	call := func(ctx context.Context, tool string) *mcp.CallToolResult {
+		handler := scopeMiddleware(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("ok"), nil
+		})
+		req := mcp.CallToolRequest{}
+		req.Params.Name = tool
+		res, err := handler(ctx, req)
+		So(err, ShouldBeNil)
+		return res
+	}
+
+	Convey("scope filtering", t, func() {
+		Convey("tools/list only returns tools the token may call", func() {
+			So(names(scopeFilter(withScope("mcp:tools"), tools)), ShouldResemble, []string{"todo_list"})
+			So(names(scopeFilter(withScope("mcp:tools mcp:tools:exec"), tools)), ShouldResemble, []string{"todo_list", "code_run"})
+			So(scopeFilter(withScope(""), tools), ShouldBeEmpty)
+			So(names(scopeFilter(context.Background(), tools)), ShouldResemble, []string{"todo_list", "code_run"})
+		})
+
+		Convey("tools/call rejects a missing scope", func() {
+			So(call(withScope("mcp:tools"), "todo_list").IsError, ShouldBeFalse)
+			res := call(withScope("mcp:tools"), "code_run")
+			So(res.IsError, ShouldBeTrue)
+			So(res.Content[0].(mcp.TextContent).Text, ShouldContainSubstring, "insufficient_scope")
+			So(call(withScope("mcp:tools:exec"), "CODE_RUN").IsError, ShouldBeFalse)
+		})
+	})
+}
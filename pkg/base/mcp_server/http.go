@@ -0,0 +1,209 @@
+package mcp_server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HealthChecker 探测依赖健康状况，返回 依赖名 -> 错误（nil 表示健康）
+type HealthChecker func(ctx context.Context) map[string]error
+
+// HTTPServer 对 StreamableHTTPServer 的封装：
+// 在同一端口上提供 /mcp 与 /healthz，负责注册中心的注册/注销以及优雅退出
+type HTTPServer struct {
+	serviceName string
+	addr        string
+	streamable  *server.StreamableHTTPServer
+	httpServer  *http.Server
+	health      HealthChecker
+	calls       *callTracker // 所属 CoreServer 的工具调用计数
+}
+
+type HTTPOption func(s *HTTPServer)
+
+// WithHealthChecker 设置 /healthz 使用的依赖探测函数，不设置时只要进程存活即视为健康
+func WithHealthChecker(checker HealthChecker) HTTPOption {
+	return func(s *HTTPServer) {
+		s.health = checker
+	}
+}
+
+// Run 启动监听并注册到注册中心，ctx 取消（通常由 SIGINT/SIGTERM 触发）后依次：
+// 注销实例 -> 等待进行中的工具调用结束 -> 关闭 StreamableHTTPServer 与 http.Server
+func (s *HTTPServer) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("mcp_server: listen %s: %w", s.addr, err)
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.httpServer.Serve(ln)
+	}()
+
+	// 先监听再注册，保证注册中心的健康检查一开始就能通过
+	deregister, err := register(ctx, s.serviceName, s.addr)
+	if err != nil {
+		_ = s.httpServer.Close()
+		return err
+	}
+
+	select {
+	case err := <-serveErr:
+		if deregister != nil {
+			if derr := deregister(); derr != nil {
+				logger.Errorf("mcp_server: deregister %s failed: %v", s.serviceName, derr)
+			}
+		}
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Infof("mcp_server: %s shutting down", s.serviceName)
+	// 1. 从注册中心注销，host 侧不再把新的请求路由到本实例
+	if deregister != nil {
+		if err := deregister(); err != nil {
+			logger.Errorf("mcp_server: deregister %s failed: %v", s.serviceName, err)
+		} else {
+			logger.Infof("mcp_server: %s deregistered", s.serviceName)
+		}
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), constant.MCPServerShutdownTimeout)
+	defer cancel()
+	// 2. 拒绝新的工具调用，并等待进行中的调用结束
+	if err := s.calls.drain(shutdownCtx); err != nil {
+		logger.Warnf("mcp_server: drain in-flight tool calls: %v", err)
+	}
+	// 3. 关闭 http 监听；长连接的 SSE 流在超时后被强制断开
+	var errs []error
+	if err := s.streamable.Shutdown(shutdownCtx); err != nil {
+		_ = s.httpServer.Close()
+		errs = append(errs, fmt.Errorf("shutdown streamable http: %w", err))
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// healthz 返回各依赖的健康状况，任一依赖异常时返回 503，供注册中心做 HTTP 健康检查
+func (s *HTTPServer) healthz(w http.ResponseWriter, r *http.Request) {
+	status := http.StatusOK
+	deps := make(map[string]string)
+	if s.calls.isDraining() {
+		status = http.StatusServiceUnavailable
+		deps["server"] = "shutting down"
+	}
+	if s.health != nil {
+		ctx, cancel := context.WithTimeout(r.Context(), constant.MCPServerHealthTimeout)
+		defer cancel()
+		for name, err := range s.health(ctx) {
+			if err != nil {
+				status = http.StatusServiceUnavailable
+				deps[name] = err.Error()
+				continue
+			}
+			deps[name] = "ok"
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"service": s.serviceName,
+		"status":  http.StatusText(status),
+		"deps":    deps,
+	})
+}
+
+// callTracker 记录进行中的工具调用，用于优雅退出时排空
+type callTracker struct {
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+// middleware 作为 ToolHandlerMiddleware 挂在核心 Server 上
+func (t *callTracker) middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		t.mu.Lock()
+		if t.draining {
+			t.mu.Unlock()
+			return mcp.NewToolResultError("mcp server is shutting down, please retry"), nil
+		}
+		t.wg.Add(1)
+		t.mu.Unlock()
+		defer t.wg.Done()
+		return next(ctx, req)
+	}
+}
+
+// drain 拒绝新的调用并等待进行中的调用结束，ctx 超时则放弃等待
+func (t *callTracker) drain(ctx context.Context) error {
+	t.mu.Lock()
+	t.draining = true
+	t.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *callTracker) isDraining() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.draining
+}
+
+// newHTTPServer 组装 mux：/mcp 交给 StreamableHTTPServer，/healthz 用于健康检查，/metrics 暴露工具调用指标；
+// 启用授权时 /mcp 需携带 bearer 令牌，并额外提供受保护资源元数据
+func newHTTPServer(core *CoreServer, serviceName, addr string, opts ...HTTPOption) *HTTPServer {
+	s := &HTTPServer{
+		serviceName: serviceName,
+		addr:        addr,
+		calls:       core.calls,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	mux := http.NewServeMux()
+	s.httpServer = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.streamable = server.NewStreamableHTTPServer(core.MCPServer,
+		server.WithHeartbeatInterval(constant.MCPServerHeartbeatInterval),
+		server.WithStreamableHTTPServer(s.httpServer),
+		server.WithHTTPContextFunc(callerContext),
+	)
+	var mcpHandler http.Handler = core.subs.httpMiddleware(s.streamable)
+	if config.MCP.Auth.Enable {
+		mcpHandler = bearerAuth(mcpHandler)
+		mux.HandleFunc(constant.MCPAuthProtectedResourcePath, protectedResourceMetadata)
+	}
+	mux.Handle(constant.RegistryMCPDefaultPath, mcpHandler)
+	mux.HandleFunc(constant.MCPServerHealthPath, s.healthz)
+	mux.Handle(constant.MCPMetricsPath, promhttp.Handler())
+	return s
+}
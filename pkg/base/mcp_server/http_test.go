@@ -0,0 +1,133 @@
+package mcp_server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeConsul 只实现服务注册/注销接口的 consul agent，按顺序记录收到的请求
+type fakeConsul struct {
+	*httptest.Server
+	mu     sync.Mutex
+	events []string
+}
+
+func newFakeConsul(onDeregister func() string) *fakeConsul {
+	c := &fakeConsul{}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/agent/service/register":
+			c.record("register")
+		case strings.HasPrefix(r.URL.Path, "/v1/agent/service/deregister/"):
+			c.record("deregister" + onDeregister())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return c
+}
+
+func (c *fakeConsul) record(event string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, event)
+}
+
+func (c *fakeConsul) recorded() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.events...)
+}
+
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	return ln.Addr().String()
+}
+
+func healthStatus(addr string) int {
+	resp, err := http.Get("http://" + addr + constant.MCPServerHealthPath)
+	if err != nil {
+		return 0
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestHTTPServerRun(t *testing.T) {
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	var core *CoreServer
+	// 注销时记录排空是否已开始，用于确认注销发生在排空之前
+	consul := newFakeConsul(func() string {
+		if core.calls.isDraining() {
+			return " while draining"
+		}
+		return ""
+	})
+	defer consul.Close()
+	loadConfig(t, "registry:\n  provider: \"consul\"\n  consul:\n    address: \""+strings.TrimPrefix(consul.URL, "http://")+"\"\n")
+	core = newBlockingCore(started, release)
+
+	addr := freeAddr(t)
+	s := newHTTPServer(core, "test", addr)
+
+	Convey("HTTPServer.Run", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		runErr := make(chan error, 1)
+		go func() { runErr <- s.Run(ctx) }()
+
+		So(waitUntil(func() bool { return len(consul.recorded()) == 1 }), ShouldBeTrue)
+		So(healthStatus(addr), ShouldEqual, http.StatusOK)
+
+		cli, err := client.NewStreamableHttpClient("http://" + addr + constant.RegistryMCPDefaultPath)
+		So(err, ShouldBeNil)
+		defer cli.Close()
+		So(cli.Start(context.Background()), ShouldBeNil)
+		_, err = cli.Initialize(context.Background(), mcp.InitializeRequest{})
+		So(err, ShouldBeNil)
+		inflight := make(chan *mcp.CallToolResult, 1)
+		go func() { inflight <- callBlock(cli) }()
+		<-started
+
+		cancel()
+		// 先注销，再排空：进行中的调用结束前 healthz 返回 503，Run 不返回
+		So(waitUntil(func() bool { return len(consul.recorded()) == 2 }), ShouldBeTrue)
+		So(consul.recorded(), ShouldResemble, []string{"register", "deregister"})
+		So(waitUntil(core.calls.isDraining), ShouldBeTrue)
+		So(healthStatus(addr), ShouldEqual, http.StatusServiceUnavailable)
+		select {
+		case <-runErr:
+			t.Fatal("Run returned before the in-flight call finished")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		// 调用结束后关闭监听，Run 正常返回
+		close(release)
+		res := <-inflight
+		So(res, ShouldNotBeNil)
+		So(res.IsError, ShouldBeFalse)
+		select {
+		case err := <-runErr:
+			So(err, ShouldBeNil)
+		case <-time.After(constant.MCPServerShutdownTimeout):
+			t.Fatal("Run did not return after the drain")
+		}
+		So(healthStatus(addr), ShouldEqual, 0)
+	})
+}
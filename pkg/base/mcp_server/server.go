@@ -1,10 +1,18 @@
 package mcp_server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/consul"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/etcd"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
@@ -12,6 +20,7 @@ import (
 	"github.com/google/uuid"
 	"time"
 
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
@@ -23,18 +32,50 @@ type HTTPOpts struct {
 	HeartbeatInterval time.Duration // 建议 20~30s，降低中间件 idle 断开
 }
 
+// CoreServer 各传输层共享的核心 Server，连同进行中的工具调用与资源订阅等运行时状态；
+// 状态随实例创建，同一进程内的多个 CoreServer 互不影响
+type CoreServer struct {
+	*server.MCPServer
+	calls *callTracker
+	subs  *subscriptionRegistry
+}
+
 // NewCoreServer 在此注册 tools/prompts/resources
-func NewCoreServer(name, version string, toolSet *tool_set.ToolSet, promptSet *prompt_set.PromptSet) *server.MCPServer {
-	s := server.NewMCPServer(
-		name,
-		version,
+func NewCoreServer(name, version string, toolSet *tool_set.ToolSet, promptSet *prompt_set.PromptSet, resourceSet *resource_set.ResourceSet) *CoreServer {
+	c := &CoreServer{
+		calls: &callTracker{},
+		subs:  newSubscriptionRegistry(),
+	}
+	opts := []server.ServerOption{
 		server.WithRecovery(),
 		server.WithToolCapabilities(false),
-	)
+		server.WithToolHandlerMiddleware(c.calls.middleware),
+	}
+	if resourceSet != nil {
+		c.subs.owner = resourceSet.Owner
+		hooks := &server.Hooks{}
+		hooks.AddOnRegisterSession(c.subs.onRegister)
+		hooks.AddOnUnregisterSession(c.subs.onUnregister)
+		hooks.AddAfterListResources(listUserResources(resourceSet))
+		opts = append(opts,
+			server.WithResourceCapabilities(true, false),
+			server.WithHooks(hooks),
+		)
+	}
+	if config.MCP.Auth.Enable {
+		// 按令牌 scope 过滤 tools/list 并拦截越权调用
+		opts = append(opts,
+			server.WithToolHandlerMiddleware(scopeMiddleware),
+			server.WithToolFilter(scopeFilter),
+		)
+	}
+	s := server.NewMCPServer(name, version, opts...)
+	c.MCPServer = s
 
 	if toolSet != nil {
 		for _, t := range toolSet.Tools {
-			s.AddTool(*t, toolSet.HandlerFunc[t.Name])
+			// 按 InputSchema 校验并纠正参数后再交给 handler
+			s.AddTool(*t, tool_set.WithValidation(*t, toolSet.HandlerFunc[t.Name]))
 		}
 	}
 	if promptSet != nil {
@@ -42,44 +83,91 @@ func NewCoreServer(name, version string, toolSet *tool_set.ToolSet, promptSet *p
 			s.AddPrompt(*p, promptSet.HandlerFunc[p.Name])
 		}
 	}
+	if resourceSet != nil {
+		for _, t := range resourceSet.Templates {
+			s.AddResourceTemplate(*t, resourceSet.HandlerFunc[t.URITemplate.Raw()])
+		}
+	}
+
+	return c
+}
+
+// callerContext 把请求头 constant.MCPHeaderUserID 作为调用方身份写入 ctx，供资源读取时校验归属；
+// 与工具的 user_id 参数一样，该请求头由 host 在校验登录态后填写，启用授权时只有持有令牌的 host 能调用
+func callerContext(ctx context.Context, r *http.Request) context.Context {
+	if userID := r.Header.Get(constant.MCPHeaderUserID); userID != "" {
+		return utils.WithUserID(ctx, userID)
+	}
+	return ctx
+}
+
+// listUserResources 在 resources/list 的首页结果后追加调用方自己的具体资源
+func listUserResources(resourceSet *resource_set.ResourceSet) server.OnAfterListResourcesFunc {
+	return func(ctx context.Context, _ any, req *mcp.ListResourcesRequest, res *mcp.ListResourcesResult) {
+		userID, ok := utils.ExtractUserID(ctx)
+		if !ok || req.Params.Cursor != "" {
+			return
+		}
+		for _, list := range resourceSet.Listers {
+			resources, err := list(ctx, userID)
+			if err != nil {
+				logger.Warnf("mcp_server: list resources of user %s: %v", userID, err)
+				continue
+			}
+			res.Resources = append(res.Resources, resources...)
+		}
+	}
+}
 
-	return s
+// NewStreamableHTTPServer 基于核心 Server 创建StreamableHTTP服务器组件，通过 Run 启动、注册并在退出时优雅关闭
+func NewStreamableHTTPServer(core *CoreServer, serviceName string, addr string, opts ...HTTPOption) *HTTPServer {
+	return newHTTPServer(core, serviceName, addr, opts...)
 }
 
-// NewStreamableHTTPServer 基于核心 Server 创建StreamableHTTP服务器组件
-func NewStreamableHTTPServer(core *server.MCPServer, serviceName string, addr string) *server.StreamableHTTPServer {
+// register 按配置将实例注册到注册中心，返回注销函数（未启用注册中心时为 nil）
+func register(ctx context.Context, serviceName string, addr string) (func() error, error) {
+	id, _ := uuid.NewV7()
+	reg := &registry.Registration{
+		Service:   serviceName,
+		ID:        id.String(),
+		Address:   addr,
+		Port:      utils.AddrGetPort(addr),
+		Tags:      []string{constant.RegistryMCPTag},
+		Meta:      map[string]string{"addr": addr},
+		Scheme:    "http",
+		Path:      constant.RegistryMCPDefaultPath,
+		CheckPath: constant.MCPServerHealthPath,
+	}
+	var registrar registry.Registrar
 	switch config.Registry.Provider {
 	case constant.RegistryProviderConsul:
-		registrar := consul.NewRegistrar(serviceName)
-		id, _ := uuid.NewV7()
-		_, err := registrar.Register(&registry.Registration{
-			Service: serviceName,
-			ID:      id.String(),
-			Address: addr,
-			Port:    utils.AddrGetPort(addr),
-			Tags:    []string{constant.RegistryMCPTag},
-			Meta:    map[string]string{"addr": addr},
-			Path:    constant.RegistryMCPDefaultPath,
-		})
-		if err != nil {
-			panic("mcp_server: consul register failed, err: " + err.Error())
+		if r := consul.NewRegistrar(serviceName); r != nil {
+			registrar = r
+		}
+	case constant.RegistryProviderEtcd:
+		if r := etcd.NewRegistrar(serviceName); r != nil {
+			registrar = r
 		}
-		logger.Infof("%s : registered to consul successfully on %s", serviceName, addr)
 	default:
+		return nil, nil
 	}
-	var httpOpts []server.StreamableHTTPOption
-	httpOpts = append(httpOpts, server.WithHeartbeatInterval(constant.MCPServerHeartbeatInterval))
-	return server.NewStreamableHTTPServer(core, httpOpts...)
-}
-
-// ServeStdio stdio
-func ServeStdio(core *server.MCPServer) error {
-	return server.ServeStdio(core)
+	if registrar == nil {
+		return nil, fmt.Errorf("mcp_server: %s config invalid, can't register %s", config.Registry.Provider, serviceName)
+	}
+	deregister, err := registrar.Register(ctx, reg)
+	if err != nil {
+		return nil, fmt.Errorf("mcp_server: %s register failed: %w", config.Registry.Provider, err)
+	}
+	logger.Infof("%s : registered to %s successfully on %s", serviceName, config.Registry.Provider, addr)
+	return deregister, nil
 }
 
-// NewHTTPSSEServer [MCP规范已废弃]基于核心 Server 创建 SSE 服务器组件
-func NewHTTPSSEServer(core *server.MCPServer) *server.SSEServer {
-	var sseOpts []server.SSEOption
-	sseOpts = append(sseOpts, server.WithKeepAliveInterval(constant.MCPServerHeartbeatInterval))
-	return server.NewSSEServer(core, sseOpts...)
+// ServeStdio 在标准输入输出上提供服务，stdin 关闭或 ctx 取消时返回；
+// stdio 不携带调用方身份，用户资源无法读取与订阅
+func ServeStdio(ctx context.Context, core *CoreServer) error {
+	err := server.NewStdioServer(core.MCPServer).Listen(ctx, os.Stdin, os.Stdout)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
 }
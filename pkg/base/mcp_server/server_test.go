@@ -0,0 +1,129 @@
+package mcp_server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// loadConfig 以临时配置文件加载最小配置（不启用授权），extra 追加在末尾
+func loadConfig(t *testing.T, extra ...string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := "server:\n  private-key: \"user-secret\"\nmcp:\n  server_name: \"test\"\n" + strings.Join(extra, "")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.Load(path, "mcp_local")
+}
+
+// newBlockingCore 创建只有一个 block 工具的 CoreServer，工具在 release 关闭前不返回
+func newBlockingCore(started chan<- struct{}, release <-chan struct{}) *CoreServer {
+	tool := mcp.NewTool("block")
+	ts := tool_set.NewToolSet()
+	ts.Tools = append(ts.Tools, &tool)
+	ts.HandlerFunc[tool.Name] = func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started <- struct{}{}
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	}
+	return NewCoreServer("test", "0.0.1", ts, nil, nil)
+}
+
+func connect(core *CoreServer) *client.Client {
+	cli, err := client.NewInProcessClient(core.MCPServer)
+	So(err, ShouldBeNil)
+	_, err = cli.Initialize(context.Background(), mcp.InitializeRequest{})
+	So(err, ShouldBeNil)
+	return cli
+}
+
+// callBlock 可能在其他 goroutine 中调用，这里不做断言，调用失败时返回 nil
+func callBlock(cli *client.Client) *mcp.CallToolResult {
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "block"
+	res, err := cli.CallTool(context.Background(), req)
+	if err != nil {
+		return nil
+	}
+	return res
+}
+
+func TestCoreServerDrain(t *testing.T) {
+	loadConfig(t)
+
+	Convey("core servers drain independently", t, func() {
+		started := make(chan struct{}, 4)
+		releaseA, releaseB := make(chan struct{}), make(chan struct{})
+		a, b := newBlockingCore(started, releaseA), newBlockingCore(started, releaseB)
+		cliA, cliB := connect(a), connect(b)
+		defer cliA.Close()
+		defer cliB.Close()
+
+		inflight := make(chan *mcp.CallToolResult, 1)
+		go func() { inflight <- callBlock(cliA) }()
+		<-started
+
+		drained := make(chan error, 1)
+		go func() { drained <- a.calls.drain(context.Background()) }()
+		So(waitUntil(a.calls.isDraining), ShouldBeTrue)
+		So(b.calls.isDraining(), ShouldBeFalse)
+
+		// a 拒绝新的调用，b 不受影响
+		res := callBlock(cliA)
+		So(res, ShouldNotBeNil)
+		So(res.IsError, ShouldBeTrue)
+		So(res.Content[0].(mcp.TextContent).Text, ShouldContainSubstring, "shutting down")
+
+		close(releaseB)
+		res = callBlock(cliB)
+		So(res, ShouldNotBeNil)
+		So(res.IsError, ShouldBeFalse)
+
+		// a 的排空要等进行中的调用结束
+		select {
+		case <-drained:
+			t.Fatal("drain returned before the in-flight call finished")
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(releaseA)
+		So(<-drained, ShouldBeNil)
+		res = <-inflight
+		So(res, ShouldNotBeNil)
+		So(res.IsError, ShouldBeFalse)
+	})
+
+	Convey("drain gives up when ctx expires", t, func() {
+		started, release := make(chan struct{}, 1), make(chan struct{})
+		defer close(release)
+		core := newBlockingCore(started, release)
+		cli := connect(core)
+		defer cli.Close()
+		go callBlock(cli)
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		So(core.calls.drain(ctx), ShouldEqual, context.DeadlineExceeded)
+	})
+}
+
+func waitUntil(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package mcp_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// SSEServer [MCP规范已废弃]HTTP+SSE 传输，仅为兼容尚未支持 Streamable HTTP 的客户端：
+// GET /sse 建立事件流，POST /message 投递消息。不注册到注册中心，host 只通过 Streamable HTTP 发现服务
+type SSEServer struct {
+	addr       string
+	sse        *server.SSEServer
+	httpServer *http.Server
+	calls      *callTracker // 所属 CoreServer 的工具调用计数
+}
+
+// NewHTTPSSEServer 基于核心 Server 创建 SSE 服务器组件，通过 Run 启动并在退出时关闭
+func NewHTTPSSEServer(core *CoreServer, addr string) *SSEServer {
+	s := &SSEServer{addr: addr, calls: core.calls}
+	mux := http.NewServeMux()
+	s.httpServer = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.sse = server.NewSSEServer(core.MCPServer,
+		server.WithKeepAliveInterval(constant.MCPServerHeartbeatInterval),
+		server.WithSSEEndpoint(constant.MCPServerSSEPath),
+		server.WithMessageEndpoint(constant.MCPServerSSEMessagePath),
+		server.WithHTTPServer(s.httpServer),
+		server.WithSSEContextFunc(callerContext),
+	)
+	var handler http.Handler = s.sse
+	if config.MCP.Auth.Enable {
+		handler = bearerAuth(handler)
+		mux.HandleFunc(constant.MCPAuthProtectedResourcePath, protectedResourceMetadata)
+	}
+	mux.Handle(constant.MCPServerSSEPath, handler)
+	mux.Handle(constant.MCPServerSSEMessagePath, handler)
+	return s
+}
+
+// Run 启动监听，ctx 取消后等待进行中的工具调用结束，再关闭全部 SSE 会话与 http.Server
+func (s *SSEServer) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("mcp_server: listen %s: %w", s.addr, err)
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.httpServer.Serve(ln)
+	}()
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), constant.MCPServerShutdownTimeout)
+	defer cancel()
+	if err := s.calls.drain(shutdownCtx); err != nil {
+		logger.Warnf("mcp_server: drain in-flight tool calls: %v", err)
+	}
+	if err := s.sse.Shutdown(shutdownCtx); err != nil {
+		_ = s.httpServer.Close()
+		return fmt.Errorf("shutdown sse: %w", err)
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
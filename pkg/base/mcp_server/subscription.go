@@ -0,0 +1,201 @@
+package mcp_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	methodResourcesSubscribe   = "resources/subscribe"
+	methodResourcesUnsubscribe = "resources/unsubscribe"
+)
+
+// subscriptionRegistry 记录各会话订阅的资源 URI。
+// mcp-go 未实现 resources/subscribe，由 httpMiddleware 在 Streamable HTTP 入口处拦截处理，
+// stdio 传输下不支持订阅
+type subscriptionRegistry struct {
+	owner resource_set.Owner // 订阅前校验调用方是否为资源所属用户，为 nil 时拒绝订阅
+
+	mu       sync.RWMutex
+	live     map[string]struct{}            // 已注册的会话
+	sessions map[string]map[string]struct{} // sessionID -> uri 集合
+}
+
+func newSubscriptionRegistry() *subscriptionRegistry {
+	return &subscriptionRegistry{
+		live:     make(map[string]struct{}),
+		sessions: make(map[string]map[string]struct{}),
+	}
+}
+
+func (r *subscriptionRegistry) onRegister(_ context.Context, session server.ClientSession) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.live[session.SessionID()] = struct{}{}
+}
+
+func (r *subscriptionRegistry) onUnregister(_ context.Context, session server.ClientSession) {
+	r.drop(session.SessionID())
+}
+
+func (r *subscriptionRegistry) drop(sessionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.live, sessionID)
+	delete(r.sessions, sessionID)
+}
+
+func (r *subscriptionRegistry) subscribe(sessionID, uri string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.live[sessionID]; !ok {
+		return false
+	}
+	set, ok := r.sessions[sessionID]
+	if !ok {
+		set = make(map[string]struct{})
+		r.sessions[sessionID] = set
+	}
+	set[uri] = struct{}{}
+	return true
+}
+
+func (r *subscriptionRegistry) unsubscribe(sessionID, uri string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if set, ok := r.sessions[sessionID]; ok {
+		delete(set, uri)
+		if len(set) == 0 {
+			delete(r.sessions, sessionID)
+		}
+	}
+}
+
+// subscribers 返回订阅了 uri 或其上级资源（如 todo://u1 之于 todo://u1/42）的会话
+func (r *subscriptionRegistry) subscribers(uri string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []string
+	for sessionID, set := range r.sessions {
+		for sub := range set {
+			if sub == uri || strings.HasPrefix(uri, sub+"/") {
+				out = append(out, sessionID)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// httpMiddleware 拦截 resources/subscribe 与 resources/unsubscribe，其余请求原样交给 next；
+// DELETE 结束会话时一并清理订阅
+func (r *subscriptionRegistry) httpMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		sessionID := req.Header.Get(server.HeaderKeySessionID)
+		if req.Method == http.MethodDelete && sessionID != "" {
+			r.drop(sessionID)
+		}
+		if req.Method != http.MethodPost {
+			next.ServeHTTP(w, req)
+			return
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var msg struct {
+			ID     mcp.RequestId `json:"id"`
+			Method string        `json:"method"`
+			Params struct {
+				URI string `json:"uri"`
+			} `json:"params"`
+		}
+		// 批量请求或非法 JSON 交给 mcp-go 处理
+		if json.Unmarshal(body, &msg) != nil ||
+			(msg.Method != methodResourcesSubscribe && msg.Method != methodResourcesUnsubscribe) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		switch {
+		case msg.Params.URI == "":
+			writeJSONRPC(w, mcp.NewJSONRPCError(msg.ID, mcp.INVALID_PARAMS, "missing required param: uri", nil))
+		case msg.Method == methodResourcesUnsubscribe:
+			r.unsubscribe(sessionID, msg.Params.URI)
+			writeJSONRPC(w, mcp.NewJSONRPCResponse(msg.ID, mcp.Result{}))
+		case !r.owns(req.Context(), req.Header.Get(constant.MCPHeaderUserID), msg.Params.URI):
+			writeJSONRPC(w, mcp.NewJSONRPCError(msg.ID, mcp.INVALID_PARAMS, "resource not found or access denied: "+msg.Params.URI, nil))
+		case sessionID == "" || !r.subscribe(sessionID, msg.Params.URI):
+			writeJSONRPC(w, mcp.NewJSONRPCError(msg.ID, mcp.INVALID_REQUEST, "subscription requires an initialized session", nil))
+		default:
+			writeJSONRPC(w, mcp.NewJSONRPCResponse(msg.ID, mcp.Result{}))
+		}
+	})
+}
+
+// owns 调用方是否为 uri 的所属用户；解析失败、资源不存在或缺少调用方身份时均视为否
+func (r *subscriptionRegistry) owns(ctx context.Context, caller, uri string) bool {
+	if r.owner == nil || caller == "" {
+		return false
+	}
+	owner, err := r.owner(ctx, uri)
+	if err != nil {
+		logger.Warnf("mcp_server: resolve owner of %s: %v", uri, err)
+		return false
+	}
+	return owner == caller
+}
+
+func writeJSONRPC(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// NotifyResourceUpdated 向订阅了 uri（或其上级资源）的会话发送 notifications/resources/updated
+func NotifyResourceUpdated(core *CoreServer, uri string) {
+	for _, sessionID := range core.subs.subscribers(uri) {
+		err := core.SendNotificationToSpecificClient(sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": uri})
+		switch {
+		case errors.Is(err, server.ErrSessionNotFound):
+			core.subs.drop(sessionID)
+		case err != nil:
+			logger.Warnf("mcp_server: notify %s of %s: %v", sessionID, uri, err)
+		}
+	}
+}
+
+// ListenResourceUpdates 订阅 Redis 频道 constant.MCPResourceUpdatedChannel，
+// 把 host 发布的资源变更转发给订阅方，直到 ctx 取消
+func ListenResourceUpdates(ctx context.Context, core *CoreServer, rdb *redis.Client) {
+	pubsub := rdb.Subscribe(ctx, constant.MCPResourceUpdatedChannel)
+	defer pubsub.Close()
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m, ok := <-ch:
+			if !ok {
+				return
+			}
+			NotifyResourceUpdated(core, m.Payload)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package mcp_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newTodoResources todo://{user_id} 模板，内容为 ctx 中的调用方，资源归属取 URI 首段
+func newTodoResources() *resource_set.ResourceSet {
+	return resource_set.NewResourceSet(func(rs *resource_set.ResourceSet) {
+		tpl := mcp.NewResourceTemplate("todo://{user_id}", "todos")
+		rs.Templates = append(rs.Templates, &tpl)
+		rs.HandlerFunc[tpl.URITemplate.Raw()] = func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			caller, _ := utils.ExtractUserID(ctx)
+			return []mcp.ResourceContents{mcp.TextResourceContents{URI: req.Params.URI, Text: "caller=" + caller}}, nil
+		}
+		rs.Owner = func(_ context.Context, uri string) (string, error) {
+			_, segments, err := utils.ParseResourceURI(uri)
+			if err != nil {
+				return "", err
+			}
+			return segments[0], nil
+		}
+	})
+}
+
+func TestSubscriptions(t *testing.T) {
+	loadConfig(t)
+
+	core := NewCoreServer("test", "0.0.1", nil, nil, newTodoResources())
+	srv := httptest.NewServer(newHTTPServer(core, "test", "127.0.0.1:0").httpServer.Handler)
+	defer srv.Close()
+
+	connect := func(userID string, notifications chan<- string) *client.Client {
+		cli, err := client.NewStreamableHttpClient(srv.URL+constant.RegistryMCPDefaultPath,
+			transport.WithHTTPHeaders(map[string]string{constant.MCPHeaderUserID: userID}),
+			transport.WithContinuousListening(),
+		)
+		So(err, ShouldBeNil)
+		cli.OnNotification(func(n mcp.JSONRPCNotification) {
+			if n.Method == mcp.MethodNotificationResourceUpdated {
+				notifications <- n.Params.AdditionalFields["uri"].(string)
+			}
+		})
+		So(cli.Start(context.Background()), ShouldBeNil)
+		_, err = cli.Initialize(context.Background(), mcp.InitializeRequest{})
+		So(err, ShouldBeNil)
+		return cli
+	}
+	subscribe := func(cli *client.Client, uri string) error {
+		return cli.Subscribe(context.Background(), mcp.SubscribeRequest{Params: mcp.SubscribeParams{URI: uri}})
+	}
+
+	Convey("subscriptions", t, func() {
+		notesU1, notesU2 := make(chan string, 8), make(chan string, 8)
+		u1, u2 := connect("u1", notesU1), connect("u2", notesU2)
+		defer u1.Close()
+		defer u2.Close()
+
+		Convey("the caller header reaches resource handlers through ctx", func() {
+			res, err := u1.ReadResource(context.Background(), mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: "todo://u1"}})
+			So(err, ShouldBeNil)
+			So(res.Contents[0].(mcp.TextResourceContents).Text, ShouldEqual, "caller=u1")
+		})
+
+		Convey("only the owner may subscribe", func() {
+			So(subscribe(u1, "todo://u1"), ShouldBeNil)
+			err := subscribe(u2, "todo://u1")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "access denied")
+			So(subscribe(u2, "bogus"), ShouldNotBeNil)
+			So(core.subs.subscribers("todo://u1"), ShouldHaveLength, 1)
+		})
+
+		Convey("updates fan out to subscribers of the resource and its parents", func() {
+			So(subscribe(u1, "todo://u1"), ShouldBeNil)
+			So(subscribe(u2, "todo://u2/42"), ShouldBeNil)
+			// 等待 GET 事件流建立后再推送
+			time.Sleep(100 * time.Millisecond)
+
+			NotifyResourceUpdated(core, "todo://u1/42")
+			NotifyResourceUpdated(core, "todo://u2/42")
+			NotifyResourceUpdated(core, "todo://u10/1")
+			So(receive(notesU1), ShouldEqual, "todo://u1/42")
+			So(receive(notesU2), ShouldEqual, "todo://u2/42")
+			So(receive(notesU1), ShouldBeEmpty)
+			So(receive(notesU2), ShouldBeEmpty)
+		})
+	})
+}
+
+func receive(ch <-chan string) string {
+	select {
+	case uri := <-ch:
+		return uri
+	case <-time.After(300 * time.Millisecond):
+		return ""
+	}
+}
+
+func TestSubscriptionRegistry(t *testing.T) {
+	Convey("subscriptionRegistry", t, func() {
+		r := newSubscriptionRegistry()
+		r.owner = func(_ context.Context, uri string) (string, error) {
+			_, segments, err := utils.ParseResourceURI(uri)
+			if err != nil {
+				return "", err
+			}
+			return segments[0], nil
+		}
+		r.live["s1"], r.live["s2"] = struct{}{}, struct{}{}
+
+		post := func(session, caller, body string) string {
+			req := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(body))
+			req.Header.Set("Mcp-Session-Id", session)
+			if caller != "" {
+				req.Header.Set(constant.MCPHeaderUserID, caller)
+			}
+			rec := httptest.NewRecorder()
+			r.httpMiddleware(http.NotFoundHandler()).ServeHTTP(rec, req)
+			return rec.Body.String()
+		}
+		sub := func(uri string) string {
+			return `{"jsonrpc":"2.0","id":1,"method":"resources/subscribe","params":{"uri":"` + uri + `"}}`
+		}
+
+		Convey("checks ownership before subscribing", func() {
+			So(post("s1", "u1", sub("todo://u1")), ShouldContainSubstring, `"result"`)
+			So(post("s2", "u2", sub("todo://u1")), ShouldContainSubstring, "access denied")
+			So(post("s2", "", sub("todo://u2")), ShouldContainSubstring, "access denied")
+			So(post("s3", "u3", sub("todo://u3")), ShouldContainSubstring, "initialized session")
+			So(r.subscribers("todo://u1/1"), ShouldResemble, []string{"s1"})
+		})
+
+		Convey("matches parents by path segment", func() {
+			So(r.subscribe("s1", "todo://u1"), ShouldBeTrue)
+			So(r.subscribers("todo://u1"), ShouldResemble, []string{"s1"})
+			So(r.subscribers("todo://u1/42"), ShouldResemble, []string{"s1"})
+			So(r.subscribers("todo://u10/42"), ShouldBeEmpty)
+		})
+
+		Convey("drops subscriptions with the session", func() {
+			So(r.subscribe("s1", "todo://u1"), ShouldBeTrue)
+			r.unsubscribe("s1", "todo://u1")
+			So(r.subscribers("todo://u1"), ShouldBeEmpty)
+			So(r.subscribe("s1", "todo://u1"), ShouldBeTrue)
+			r.drop("s1")
+			So(r.subscribers("todo://u1"), ShouldBeEmpty)
+			So(r.subscribe("s1", "todo://u1"), ShouldBeFalse)
+		})
+
+		Convey("refuses subscriptions without an owner resolver", func() {
+			r.owner = nil
+			So(post("s1", "u1", sub("todo://u1")), ShouldContainSubstring, "access denied")
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/db"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/consul"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/etcd"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"log"
 )
@@ -14,7 +15,8 @@ import (
 // WithMCPClient 通过配置手动注入初始化 ClientSet.MCPCli。
 // - stdio: 直接创建单连接客户端（本地进程/stdio）
 // - none(单点): 使用 config.MCP.HTTP.BaseURL 创建单连接客户端
-// - consul: 创建聚合客户端（基于 Consul Resolver 定时刷新，自动发现多实例）
+// - consul: 创建聚合客户端（基于 Consul 阻塞查询感知实例变化，失败时退化为定时刷新）
+// - etcd: 创建聚合客户端（基于 etcd watch 实时感知实例上下线）
 func WithMCPClient(services []string) Option {
 	return func(clientSet *ClientSet) {
 		switch {
@@ -37,26 +39,49 @@ func WithMCPClient(services []string) Option {
 			}
 			clientSet.MCPCli = mcpCli
 
-		// 服务发现（Consul）：使用聚合客户端，多路连接 + 定时刷新
+		// 服务发现（Consul）：使用聚合客户端，多路连接 + 阻塞查询推送
 		case config.Registry.Provider == constant.RegistryProviderConsul:
 			resolver := consul.NewResolver()
 			if resolver == nil {
 				log.Fatalf("consul config invalid, can't create MCP client")
 			}
-			ac := mcp_client.NewAggregatedClient(resolver, services) // 可按需调整刷新周期
+			ac := mcp_client.NewAggregatedClient(resolver, services) // 刷新周期仅在 watch 不可用时生效
 			clientSet.RegistryResolver = resolver
 			clientSet.MCPCli = ac
 			clientSet.cleanups = append(clientSet.cleanups, ac.Close)
 
+		// 服务发现（etcd）：使用聚合客户端，基于 watch 实时推送实例变更
+		case config.Registry.Provider == constant.RegistryProviderEtcd:
+			resolver := etcd.NewResolver()
+			if resolver == nil {
+				log.Fatalf("etcd config invalid, can't create MCP client")
+			}
+			ac := mcp_client.NewAggregatedClient(resolver, services)
+			clientSet.RegistryResolver = resolver
+			clientSet.MCPCli = ac
+			clientSet.cleanups = append(clientSet.cleanups, ac.Close, func() { _ = resolver.Close() })
+
 		default:
 			log.Fatalf("unknown registry provider: %s, can't create MCP client", config.Registry.Provider)
 		}
 	}
 }
 
+// WithUserMCPPool 初始化用户自定义 MCP 服务的连接池
+func WithUserMCPPool() Option {
+	return func(clientSet *ClientSet) {
+		pool := mcp_client.NewUserClientPool()
+		clientSet.UserMCPPool = pool
+		clientSet.cleanups = append(clientSet.cleanups, pool.Close)
+	}
+}
+
 func WithAiProviderClient() Option {
 	return func(clientSet *ClientSet) {
-		cli := ai_provider.NewAiProviderClient()
+		cli, err := ai_provider.NewAiProviderClient()
+		if err != nil {
+			log.Fatalf("failed to initialize ai provider client: %s", err)
+		}
 		clientSet.AiProviderCli = cli
 	}
 }
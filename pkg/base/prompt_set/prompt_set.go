@@ -3,12 +3,6 @@ package prompt_set
 import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"sync"
-)
-
-var (
-	instance *PromptSet
-	once     sync.Once
 )
 
 type PromptSet struct {
@@ -18,15 +12,12 @@ type PromptSet struct {
 
 type Option func(promptSet *PromptSet)
 
+// NewPromptSet 每次调用返回独立的实例
 func NewPromptSet(opt ...Option) *PromptSet {
-	once.Do(func() {
-		var options []Option
-		instance = new(PromptSet)
-		instance.HandlerFunc = make(map[string]server.PromptHandlerFunc)
-		options = append(options, opt...)
-		for _, opt := range options {
-			opt(instance)
-		}
-	})
+	instance := new(PromptSet)
+	instance.HandlerFunc = make(map[string]server.PromptHandlerFunc)
+	for _, o := range opt {
+		o(instance)
+	}
 	return instance
 }
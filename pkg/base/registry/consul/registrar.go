@@ -1,6 +1,7 @@
 package consul
 
 import (
+	"context"
 	"fmt"
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
@@ -29,7 +30,7 @@ func NewRegistrar(serviceName string) *Registrar {
 }
 
 // Register 注册服务实例，用于mcp_server将自己注册到consul
-func (r *Registrar) Register(reg *registry.Registration) (func() error, error) {
+func (r *Registrar) Register(ctx context.Context, reg *registry.Registration) (func() error, error) {
 	// 创建 Consul 客户端配置
 	conf := api.DefaultConfig()
 	conf.Address = r.cfg.Address
@@ -55,6 +56,25 @@ func (r *Registrar) Register(reg *registry.Registration) (func() error, error) {
 		deregister = constant.RegistryDeregisterAfter
 	}
 
+	check := &api.AgentServiceCheck{
+		CheckID: reg.ID,
+		// 心跳间隔
+		Interval: interval.String(),
+		// 自动注销时间
+		DeregisterCriticalServiceAfter: deregister.String(),
+	}
+	if reg.CheckPath != "" {
+		// HTTP 检查：由 /healthz 汇报依赖（DB、Redis）健康状况，非 2xx 即视为不健康
+		scheme := reg.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		check.HTTP = fmt.Sprintf("%s://%s%s", scheme, reg.Address, reg.CheckPath)
+		check.Timeout = constant.RegistryCheckTimeout.String()
+	} else {
+		check.TCP = reg.Address
+	}
+
 	asr := &api.AgentServiceRegistration{
 		ID:      reg.ID,      // 唯一ID
 		Name:    reg.Service, // 服务名称（同一服务下可能有多个实例）
@@ -62,16 +82,9 @@ func (r *Registrar) Register(reg *registry.Registration) (func() error, error) {
 		Port:    reg.Port,
 		Tags:    reg.Tags, // 标签，用于标识环境/版本/分区等
 		Meta:    reg.Meta, // 元信息，可存储额外属性，这里存储了url
-		Check: &api.AgentServiceCheck{
-			TCP:     fmt.Sprintf("%s", reg.Address),
-			CheckID: reg.ID,
-			// 心跳间隔
-			Interval: interval.String(),
-			// 自动注销时间
-			DeregisterCriticalServiceAfter: deregister.String(),
-		},
+		Check:   check,
 	}
-	if err := cl.Agent().ServiceRegister(asr); err != nil {
+	if err := cl.Agent().ServiceRegisterOpts(asr, api.ServiceRegisterOpts{}.WithContext(ctx)); err != nil {
 		return nil, fmt.Errorf("consul register: %w", err)
 	}
 	return func() error {
@@ -1,9 +1,12 @@
 package consul
 
 import (
+	"context"
 	"fmt"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"strings"
+	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/hashicorp/consul/api"
@@ -11,6 +14,7 @@ import (
 
 type Resolver struct {
 	cfg *ConsulConfig
+	cli *api.Client
 }
 
 func NewResolver() *Resolver {
@@ -25,8 +29,24 @@ func NewResolver() *Resolver {
 	if cfg.Address == "" {
 		return nil
 	}
+
+	// 构造 Consul 客户端，Resolve/Watch 共用
+	conf := api.DefaultConfig()
+	conf.Address = cfg.Address
+	if cfg.Datacenter != "" {
+		conf.Datacenter = cfg.Datacenter
+	}
+	if cfg.Token != "" {
+		conf.Token = cfg.Token
+	}
+	cl, err := api.NewClient(conf)
+	if err != nil {
+		logger.Errorf("consul client: %v", err)
+		return nil
+	}
 	return &Resolver{
 		cfg: cfg,
+		cli: cl,
 	}
 }
 
@@ -40,24 +60,6 @@ func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
 		return nil, fmt.Errorf("consul: empty address")
 	}
 
-	// 构造 Consul 客户端
-	conf := api.DefaultConfig()
-	conf.Address = r.cfg.Address
-	if r.cfg.Datacenter != "" {
-		conf.Datacenter = r.cfg.Datacenter
-	}
-	if r.cfg.Token != "" {
-		conf.Token = r.cfg.Token
-	}
-	cl, err := api.NewClient(conf)
-	if err != nil {
-		return nil, fmt.Errorf("consul client: %w", err)
-	}
-
-	q := &api.QueryOptions{
-		Datacenter: r.cfg.Datacenter,
-		Token:      r.cfg.Token,
-	}
 	// 结果集合与去重
 	out := make(map[string][]string)
 
@@ -66,20 +68,13 @@ func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
 		if svc == "" {
 			continue
 		}
-		entries, _, err := cl.Health().Service(svc, r.cfg.Tag, true, q)
+		entries, _, err := r.cli.Health().Service(svc, r.cfg.Tag, true, r.queryOptions())
 		if err != nil {
 			return nil, fmt.Errorf("consul discover %q: %w", svc, err)
 		}
 		// 没有健康实例不视为整体错误，继续查下一个服务
-		for _, inst := range entries {
-			// 使用注册时写入的完整 addr
-			if inst.Service != nil && inst.Service.Meta != nil {
-				if u := strings.TrimSpace(inst.Service.Meta["addr"]); u != "" {
-					out[svc] = append(out[svc], u)
-				} else {
-					logger.Errorf("consul: service %s no metadata", inst.Service.Service)
-				}
-			}
+		if urls := entryURLs(entries); len(urls) > 0 {
+			out[svc] = urls
 		}
 	}
 	if len(out) == 0 {
@@ -87,3 +82,133 @@ func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
 	}
 	return out, nil
 }
+
+// Watch 基于 consul 阻塞查询（WaitIndex 长轮询）监听服务健康实例变化。
+// 所有服务完成首次查询后回调一次全量实例，此后任一服务变化都会回调最新全量实例；
+// 某个服务连续失败超过 constant.RegistryWatchMaxRetries 次时返回错误，由调用方退化为轮询
+func (r *Resolver) Watch(ctx context.Context, services []string, onChange func(map[string][]string)) error {
+	if len(services) == 0 {
+		return fmt.Errorf("no Services provided")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type update struct {
+		svc  string
+		urls []string
+	}
+	updates := make(chan update)
+	errCh := make(chan error, len(services))
+
+	watching := 0
+	for _, svc := range services {
+		if svc == "" {
+			continue
+		}
+		watching++
+		go func(svc string) {
+			err := r.watchService(ctx, svc, func(urls []string) {
+				select {
+				case updates <- update{svc: svc, urls: urls}:
+				case <-ctx.Done():
+				}
+			})
+			if err != nil {
+				errCh <- err
+			}
+		}(svc)
+	}
+
+	state := make(map[string][]string)
+	reported := make(map[string]struct{})
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errCh:
+			return err
+		case u := <-updates:
+			reported[u.svc] = struct{}{}
+			if len(u.urls) == 0 {
+				delete(state, u.svc)
+			} else {
+				state[u.svc] = u.urls
+			}
+			// 首轮未收齐时不回调，避免调用方误以为其余服务已全部下线
+			if len(reported) < watching {
+				continue
+			}
+			snapshot := make(map[string][]string, len(state))
+			for k, v := range state {
+				snapshot[k] = v
+			}
+			onChange(snapshot)
+		}
+	}
+}
+
+// watchService 对单个服务做阻塞查询，index 变化时回调该服务的健康实例
+func (r *Resolver) watchService(ctx context.Context, svc string, onChange func([]string)) error {
+	var (
+		index    uint64
+		failures int
+	)
+	for ctx.Err() == nil {
+		q := r.queryOptions()
+		q.WaitIndex = index
+		q.WaitTime = constant.RegistryConsulWaitTime
+		entries, meta, err := r.cli.Health().Service(svc, r.cfg.Tag, true, q.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			failures++
+			if failures > constant.RegistryWatchMaxRetries {
+				return fmt.Errorf("consul watch %q: %w", svc, err)
+			}
+			logger.Warnf("consul watch %q (retry %d): %v", svc, failures, err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Duration(failures) * time.Second):
+			}
+			continue
+		}
+		failures = 0
+
+		// 等待超时且无变化
+		if index != 0 && meta.LastIndex == index {
+			continue
+		}
+		// index 回退（如 consul 重启）时按官方建议重置
+		if meta.LastIndex < index {
+			index = 0
+		} else {
+			index = meta.LastIndex
+		}
+		onChange(entryURLs(entries))
+	}
+	return nil
+}
+
+func (r *Resolver) queryOptions() *api.QueryOptions {
+	return &api.QueryOptions{
+		Datacenter: r.cfg.Datacenter,
+		Token:      r.cfg.Token,
+	}
+}
+
+// entryURLs 提取注册时写入 Meta 的完整 addr
+func entryURLs(entries []*api.ServiceEntry) []string {
+	var urls []string
+	for _, inst := range entries {
+		if inst.Service != nil && inst.Service.Meta != nil {
+			if u := strings.TrimSpace(inst.Service.Meta["addr"]); u != "" {
+				urls = append(urls, u)
+			} else {
+				logger.Errorf("consul: service %s no metadata", inst.Service.Service)
+			}
+		}
+	}
+	return urls
+}
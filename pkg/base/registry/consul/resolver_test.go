@@ -0,0 +1,108 @@
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// healthResponse 阻塞查询的一次返回：X-Consul-Index 与健康实例的 addr
+type healthResponse struct {
+	index uint64
+	addrs []string
+}
+
+// newFakeHealth 按顺序返回 responses，用完后挂起直到请求取消；记录每次请求携带的 index
+func newFakeHealth(responses []healthResponse) (*httptest.Server, func() []string) {
+	var (
+		mu      sync.Mutex
+		indexes []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/v1/health/service/") {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		n := len(indexes)
+		indexes = append(indexes, r.URL.Query().Get("index"))
+		mu.Unlock()
+		if n >= len(responses) {
+			<-r.Context().Done()
+			return
+		}
+		entries := make([]*api.ServiceEntry, 0, len(responses[n].addrs))
+		for _, addr := range responses[n].addrs {
+			entries = append(entries, &api.ServiceEntry{Service: &api.AgentService{Service: "mcp", Meta: map[string]string{"addr": addr}}})
+		}
+		w.Header().Set("X-Consul-Index", strconv.FormatUint(responses[n].index, 10))
+		_ = json.NewEncoder(w).Encode(entries)
+	}))
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), indexes...)
+	}
+}
+
+func TestResolverWatch(t *testing.T) {
+	srv, indexes := newFakeHealth([]healthResponse{
+		{index: 5, addrs: []string{"a:1"}},
+		{index: 5, addrs: []string{"a:1"}},        // 等待超时，index 未变
+		{index: 7, addrs: []string{"a:1", "b:1"}}, // 实例变化
+		{index: 3, addrs: []string{"b:1"}},        // index 回退，如 consul 重启
+	})
+	defer srv.Close()
+
+	conf := api.DefaultConfig()
+	conf.Address = strings.TrimPrefix(srv.URL, "http://")
+	cl, err := api.NewClient(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Resolver{cfg: &ConsulConfig{Address: conf.Address}, cli: cl}
+
+	Convey("Resolver.Watch", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		changes := make(chan map[string][]string, 8)
+		done := make(chan error, 1)
+		go func() {
+			done <- r.Watch(ctx, []string{"mcp"}, func(m map[string][]string) { changes <- m })
+		}()
+
+		var got []map[string][]string
+		for len(got) < 3 {
+			select {
+			case m := <-changes:
+				got = append(got, m)
+			case <-time.After(2 * time.Second):
+				t.Fatalf("only %d changes reported", len(got))
+			}
+		}
+		So(got, ShouldResemble, []map[string][]string{
+			{"mcp": {"a:1"}},
+			{"mcp": {"a:1", "b:1"}},
+			{"mcp": {"b:1"}},
+		})
+
+		// 后续请求带上次的 index 阻塞等待，回退后从 0 重新开始
+		deadline := time.Now().Add(time.Second)
+		for len(indexes()) < 5 && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		So(indexes(), ShouldResemble, []string{"", "5", "5", "7", ""})
+
+		cancel()
+		So(<-done, ShouldEqual, context.Canceled)
+		So(changes, ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,92 @@
+package etcd
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type EtcdConfig struct {
+	Endpoints   []string      // 127.0.0.1:2379
+	Username    string        // 可空
+	Password    string        // 可空
+	DialTimeout time.Duration // 默认 5s
+	Prefix      string        // key 前缀，默认 /go-mcp-demo/services
+	Service     string        // 注册的服务名（仅 Registrar 使用）
+	LeaseTTL    time.Duration // 租约 TTL，默认 constant.RegistryDeregisterAfter
+}
+
+// instance 写入 etcd 的实例信息，key 为 {prefix}/{service}/{id}
+type instance struct {
+	ID      string            `json:"id"`
+	Service string            `json:"service"`
+	Address string            `json:"address"`
+	Port    int               `json:"port"`
+	Tags    []string          `json:"tags,omitempty"`
+	Meta    map[string]string `json:"meta,omitempty"`
+	Scheme  string            `json:"scheme,omitempty"`
+	Path    string            `json:"path,omitempty"`
+}
+
+// addr 返回实例的访问地址，优先使用注册时写入的 Meta["addr"]（与 consul 保持一致）
+func (i *instance) addr() string {
+	if i.Meta != nil {
+		if u := strings.TrimSpace(i.Meta["addr"]); u != "" {
+			return u
+		}
+	}
+	return i.Address
+}
+
+func decodeInstance(b []byte) (*instance, error) {
+	var inst instance
+	if err := json.Unmarshal(b, &inst); err != nil {
+		return nil, err
+	}
+	return &inst, nil
+}
+
+func loadConfig(serviceName string) *EtcdConfig {
+	cfg := &EtcdConfig{
+		Endpoints:   config.Registry.Etcd.Endpoints,
+		Username:    config.Registry.Etcd.Username,
+		Password:    config.Registry.Etcd.Password,
+		DialTimeout: config.Registry.Etcd.DialTimeout,
+		Prefix:      config.Registry.Etcd.Prefix,
+		Service:     serviceName,
+		LeaseTTL:    config.Registry.Etcd.LeaseTTL,
+	}
+	if len(cfg.Endpoints) == 0 {
+		return nil
+	}
+	return withDefaults(cfg)
+}
+
+func withDefaults(cfg *EtcdConfig) *EtcdConfig {
+	if cfg.DialTimeout <= 0 {
+		cfg.DialTimeout = constant.RegistryEtcdDialTimeout
+	}
+	if cfg.Prefix == "" {
+		cfg.Prefix = constant.RegistryEtcdDefaultPrefix
+	}
+	cfg.Prefix = strings.TrimRight(cfg.Prefix, "/")
+	return cfg
+}
+
+func newClient(cfg *EtcdConfig) (*clientv3.Client, error) {
+	return clientv3.New(clientv3.Config{
+		Endpoints:   cfg.Endpoints,
+		Username:    cfg.Username,
+		Password:    cfg.Password,
+		DialTimeout: cfg.DialTimeout,
+	})
+}
+
+// serviceKey 返回服务下所有实例的 key 前缀
+func serviceKey(prefix, service string) string {
+	return prefix + "/" + service + "/"
+}
@@ -0,0 +1,118 @@
+package etcd
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.etcd.io/etcd/server/v3/embed"
+)
+
+func freeURL(t *testing.T) url.URL {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return url.URL{Scheme: "http", Host: l.Addr().String()}
+}
+
+// startEmbedEtcd 启动一个嵌入式 etcd，返回指向它的客户端配置
+func startEmbedEtcd(t *testing.T) *EtcdConfig {
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	clientURL, peerURL := freeURL(t), freeURL(t)
+	cfg.ListenClientUrls = []url.URL{clientURL}
+	cfg.AdvertiseClientUrls = []url.URL{clientURL}
+	cfg.ListenPeerUrls = []url.URL{peerURL}
+	cfg.AdvertisePeerUrls = []url.URL{peerURL}
+	cfg.InitialCluster = cfg.Name + "=" + peerURL.String()
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(e.Close)
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("embed etcd not ready")
+	}
+
+	return withDefaults(&EtcdConfig{
+		Endpoints: []string{clientURL.Host},
+		Prefix:    "/test/services",
+		LeaseTTL:  2 * time.Second,
+	})
+}
+
+func TestEtcdRegistry(t *testing.T) {
+	cfg := startEmbedEtcd(t)
+
+	Convey("etcd registrar and resolver", t, func() {
+		registrar := newRegistrar(cfg)
+		So(registrar, ShouldNotBeNil)
+		defer registrar.Close()
+		resolver := newResolver(cfg)
+		So(resolver, ShouldNotBeNil)
+		defer resolver.Close()
+
+		// 编译期校验接口实现
+		var _ registry.Registrar = registrar
+		var _ registry.Resolver = resolver
+		var _ registry.Watcher = resolver
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := make(chan map[string][]string, 16)
+		go func() {
+			_ = resolver.Watch(ctx, []string{"mcp_local"}, func(m map[string][]string) { updates <- m })
+		}()
+		So(len(waitUpdate(updates)["mcp_local"]), ShouldEqual, 0)
+
+		deregister, err := registrar.Register(ctx, &registry.Registration{
+			Service: "mcp_local",
+			ID:      "instance-1",
+			Address: "127.0.0.1:10002",
+			Port:    10002,
+			Meta:    map[string]string{"addr": "127.0.0.1:10002"},
+		})
+		So(err, ShouldBeNil)
+
+		Convey("watch pushes the new instance", func() {
+			So(waitUpdate(updates)["mcp_local"], ShouldResemble, []string{"127.0.0.1:10002"})
+
+			out, err := resolver.Resolve([]string{"mcp_local"})
+			So(err, ShouldBeNil)
+			So(out["mcp_local"], ShouldResemble, []string{"127.0.0.1:10002"})
+
+			// 超过租约 TTL 仍然存在，说明 keepalive 生效
+			time.Sleep(3 * time.Second)
+			out, err = resolver.Resolve([]string{"mcp_local"})
+			So(err, ShouldBeNil)
+			So(len(out["mcp_local"]), ShouldEqual, 1)
+
+			Convey("deregister revokes the lease and watch pushes removal", func() {
+				So(deregister(), ShouldBeNil)
+				So(len(waitUpdate(updates)["mcp_local"]), ShouldEqual, 0)
+
+				_, err := resolver.Resolve([]string{"mcp_local"})
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func waitUpdate(ch <-chan map[string][]string) map[string][]string {
+	select {
+	case m := <-ch:
+		return m
+	case <-time.After(5 * time.Second):
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type Registrar struct {
+	cfg *EtcdConfig
+	cli *clientv3.Client
+}
+
+func NewRegistrar(serviceName string) *Registrar {
+	cfg := loadConfig(serviceName)
+	if cfg == nil {
+		return nil
+	}
+	return newRegistrar(cfg)
+}
+
+func newRegistrar(cfg *EtcdConfig) *Registrar {
+	cli, err := newClient(cfg)
+	if err != nil {
+		logger.Errorf("etcd: create client failed: %v", err)
+		return nil
+	}
+	return &Registrar{cfg: cfg, cli: cli}
+}
+
+// Register 基于租约注册服务实例：实例 key 绑定租约并持续 KeepAlive，
+// 进程退出或网络中断导致租约过期后 key 自动删除，即自动注销
+func (r *Registrar) Register(ctx context.Context, reg *registry.Registration) (func() error, error) {
+	// 租约 TTL，对应 consul 的 DeregisterCriticalServiceAfter
+	ttl := r.cfg.LeaseTTL
+	if ttl <= 0 {
+		ttl = reg.DeregisterAfter
+	}
+	if ttl <= 0 {
+		ttl = constant.RegistryDeregisterAfter
+	}
+
+	val, err := json.Marshal(&instance{
+		ID:      reg.ID,
+		Service: reg.Service,
+		Address: reg.Address,
+		Port:    reg.Port,
+		Tags:    reg.Tags,
+		Meta:    reg.Meta,
+		Scheme:  reg.Scheme,
+		Path:    reg.Path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("etcd marshal instance: %w", err)
+	}
+	key := serviceKey(r.cfg.Prefix, reg.Service) + reg.ID
+
+	leaseID, err := r.put(ctx, key, string(val), ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	// keepalive 的生命周期与注册绑定，不跟随调用方 ctx
+	kaCtx, cancel := context.WithCancel(context.Background())
+	var (
+		mu      sync.Mutex
+		current = leaseID
+	)
+	go func() {
+		for {
+			mu.Lock()
+			id := current
+			mu.Unlock()
+			r.keepAlive(kaCtx, id)
+			if kaCtx.Err() != nil {
+				return
+			}
+			// 租约丢失（过期或 etcd 不可达），重新申请租约并写入
+			logger.Warnf("etcd: lease %x of %s lost, re-registering", id, key)
+			for {
+				newID, err := r.put(kaCtx, key, string(val), ttl)
+				if err == nil {
+					mu.Lock()
+					current = newID
+					mu.Unlock()
+					break
+				}
+				if kaCtx.Err() != nil {
+					return
+				}
+				logger.Errorf("etcd: re-register %s failed: %v", key, err)
+				select {
+				case <-kaCtx.Done():
+					return
+				case <-time.After(constant.RegistryCheckInterval):
+				}
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+		mu.Lock()
+		id := current
+		mu.Unlock()
+		rctx, rcancel := context.WithTimeout(context.Background(), r.cfg.DialTimeout)
+		defer rcancel()
+		// 撤销租约会同时删除绑定的 key
+		if _, err := r.cli.Revoke(rctx, id); err != nil {
+			return fmt.Errorf("etcd revoke lease: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+// put 申请租约并写入实例 key
+func (r *Registrar) put(ctx context.Context, key, val string, ttl time.Duration) (clientv3.LeaseID, error) {
+	seconds := int64(ttl / time.Second)
+	if seconds <= 0 {
+		seconds = 1
+	}
+	lease, err := r.cli.Grant(ctx, seconds)
+	if err != nil {
+		return 0, fmt.Errorf("etcd grant lease: %w", err)
+	}
+	if _, err := r.cli.Put(ctx, key, val, clientv3.WithLease(lease.ID)); err != nil {
+		return 0, fmt.Errorf("etcd register: %w", err)
+	}
+	return lease.ID, nil
+}
+
+// keepAlive 持续续约直到 ctx 取消或租约失效
+func (r *Registrar) keepAlive(ctx context.Context, id clientv3.LeaseID) {
+	ch, err := r.cli.KeepAlive(ctx, id)
+	if err != nil {
+		logger.Errorf("etcd: keepalive lease %x: %v", id, err)
+		return
+	}
+	// 必须消费应答，否则 channel 写满后 client 会告警
+	for range ch {
+	}
+}
+
+// Close 关闭 etcd 客户端，应在 deregister 之后调用
+func (r *Registrar) Close() error {
+	return r.cli.Close()
+}
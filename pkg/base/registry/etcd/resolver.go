@@ -0,0 +1,154 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type Resolver struct {
+	cfg *EtcdConfig
+	cli *clientv3.Client
+}
+
+func NewResolver() *Resolver {
+	cfg := loadConfig("")
+	if cfg == nil {
+		return nil
+	}
+	return newResolver(cfg)
+}
+
+func newResolver(cfg *EtcdConfig) *Resolver {
+	cli, err := newClient(cfg)
+	if err != nil {
+		logger.Errorf("etcd: create client failed: %v", err)
+		return nil
+	}
+	return &Resolver{cfg: cfg, cli: cli}
+}
+
+// Resolve 返回所有租约仍有效的实例地址
+func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no Services provided")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.cfg.DialTimeout)
+	defer cancel()
+
+	instances, _, err := r.snapshot(ctx, services)
+	if err != nil {
+		return nil, err
+	}
+	out := group(instances)
+	if len(out) == 0 {
+		return nil, fmt.Errorf("etcd: no instances for %v", services)
+	}
+	return out, nil
+}
+
+// Watch 先全量拉取一次，随后监听服务前缀的变更，每次变更都会回调最新的全量实例（可能为空）。
+// watch 通道异常关闭（如 revision 被压缩）时会重新全量拉取并继续监听，直到 ctx 取消
+func (r *Resolver) Watch(ctx context.Context, services []string, onChange func(map[string][]string)) error {
+	if len(services) == 0 {
+		return fmt.Errorf("no Services provided")
+	}
+	for ctx.Err() == nil {
+		instances, rev, err := r.snapshot(ctx, services)
+		if err != nil {
+			return err
+		}
+		onChange(group(instances))
+
+		wctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+		wch := r.cli.Watch(wctx, r.cfg.Prefix+"/", clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+		for resp := range wch {
+			if err := resp.Err(); err != nil {
+				logger.Warnf("etcd: watch %s: %v", r.cfg.Prefix, err)
+				break
+			}
+			changed := false
+			for _, ev := range resp.Events {
+				key := string(ev.Kv.Key)
+				svc, ok := matchService(r.cfg.Prefix, key, services)
+				if !ok {
+					continue
+				}
+				changed = true
+				switch ev.Type {
+				case clientv3.EventTypePut:
+					inst, err := decodeInstance(ev.Kv.Value)
+					if err != nil {
+						logger.Errorf("etcd: decode instance %s: %v", key, err)
+						delete(instances, key)
+						continue
+					}
+					instances[key] = svcAddr{svc: svc, addr: inst.addr()}
+				case clientv3.EventTypeDelete:
+					delete(instances, key)
+				}
+			}
+			if changed {
+				onChange(group(instances))
+			}
+		}
+		cancel()
+	}
+	return ctx.Err()
+}
+
+type svcAddr struct {
+	svc  string
+	addr string
+}
+
+// snapshot 一次性拉取前缀下的全部实例，返回 key -> 实例地址 以及对应的 revision，
+// 单次 Get 保证快照与后续 watch 的起始 revision 一致
+func (r *Resolver) snapshot(ctx context.Context, services []string) (map[string]svcAddr, int64, error) {
+	resp, err := r.cli.Get(ctx, r.cfg.Prefix+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, fmt.Errorf("etcd discover %v: %w", services, err)
+	}
+	instances := make(map[string]svcAddr)
+	for _, kv := range resp.Kvs {
+		svc, ok := matchService(r.cfg.Prefix, string(kv.Key), services)
+		if !ok {
+			continue
+		}
+		inst, err := decodeInstance(kv.Value)
+		if err != nil {
+			logger.Errorf("etcd: decode instance %s: %v", kv.Key, err)
+			continue
+		}
+		if u := inst.addr(); u != "" {
+			instances[string(kv.Key)] = svcAddr{svc: svc, addr: u}
+		}
+	}
+	return instances, resp.Header.Revision, nil
+}
+
+// matchService 判断 key 属于哪个被监听的服务
+func matchService(prefix, key string, services []string) (string, bool) {
+	for _, svc := range services {
+		if svc != "" && strings.HasPrefix(key, serviceKey(prefix, svc)) {
+			return svc, true
+		}
+	}
+	return "", false
+}
+
+func group(instances map[string]svcAddr) map[string][]string {
+	out := make(map[string][]string)
+	for _, v := range instances {
+		out[v.svc] = append(out[v.svc], v.addr)
+	}
+	return out
+}
+
+// Close 关闭 etcd 客户端
+func (r *Resolver) Close() error {
+	return r.cli.Close()
+}
@@ -12,6 +12,13 @@ type Resolver interface {
 	Resolve(services []string) (map[string][]string, error)
 }
 
+// Watcher 可选接口：支持推送式服务发现的 Resolver 额外实现该接口，
+// 调用方可据此替代定时轮询
+type Watcher interface {
+	// Watch 阻塞监听服务实例变化，每次变化都回调最新的全量实例（可能为空），ctx 取消后返回
+	Watch(ctx context.Context, services []string, onChange func(map[string][]string)) error
+}
+
 // Registrar 抽象服务注册
 type Registrar interface {
 	// Register 注册服务实例
@@ -30,6 +37,7 @@ type Registration struct {
 	Path    string            // 例如 /mcp（可选）
 
 	// 健康检查（可选）
+	CheckPath       string // HTTP 健康检查路径，例如 /healthz；为空时使用 TCP 检查
 	CheckInterval   time.Duration
 	DeregisterAfter time.Duration
 }
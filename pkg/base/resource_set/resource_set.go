@@ -0,0 +1,33 @@
+package resource_set
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Lister 列出指定用户当前拥有的具体资源，供 resources/list 使用
+type Lister func(ctx context.Context, userID string) ([]mcp.Resource, error)
+
+// Owner 解析资源 URI 所属的用户 ID，资源不存在时返回空串
+type Owner func(ctx context.Context, uri string) (string, error)
+
+type ResourceSet struct {
+	Templates   []*mcp.ResourceTemplate
+	HandlerFunc map[string]server.ResourceTemplateHandlerFunc // uriTemplate -> handler
+	Listers     []Lister
+	Owner       Owner // 读取与订阅资源前用于校验调用方是否为资源所属用户
+}
+
+type Option func(resourceSet *ResourceSet)
+
+// NewResourceSet 每次调用返回独立的实例
+func NewResourceSet(opt ...Option) *ResourceSet {
+	instance := new(ResourceSet)
+	instance.HandlerFunc = make(map[string]server.ResourceTemplateHandlerFunc)
+	for _, o := range opt {
+		o(instance)
+	}
+	return instance
+}
@@ -0,0 +1,61 @@
+package sandbox
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// cgroup 每次运行独立的 cgroup v2 子组，进程数只统计沙箱内的进程
+type cgroup struct {
+	dir string
+	fd  int
+}
+
+// newCgroup 在 parent（需已委派给服务运行用户，且 cgroup.subtree_control 启用了 pids）下创建子组并设置 pids.max
+func newCgroup(parent string, maxPids int) (*cgroup, error) {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	dir := filepath.Join(parent, "run-"+hex.EncodeToString(b[:]))
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("sandbox: create cgroup: %w", err)
+	}
+	c := &cgroup{dir: dir, fd: -1}
+	if err := os.WriteFile(filepath.Join(dir, "pids.max"), []byte(strconv.Itoa(maxPids)), 0); err != nil {
+		c.remove()
+		return nil, fmt.Errorf("sandbox: set pids.max (is the pids controller enabled in %s/cgroup.subtree_control?): %w", parent, err)
+	}
+	fd, err := unix.Open(dir, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		c.remove()
+		return nil, fmt.Errorf("sandbox: open cgroup: %w", err)
+	}
+	c.fd = fd
+	return c, nil
+}
+
+// kill 杀死组内全部进程（含已脱离进程组的子进程）
+func (c *cgroup) kill() error {
+	return os.WriteFile(filepath.Join(c.dir, "cgroup.kill"), []byte("1"), 0)
+}
+
+// remove 杀死残留进程后删除子组；进程退出是异步的，短暂重试直到组为空
+func (c *cgroup) remove() {
+	if c.fd >= 0 {
+		unix.Close(c.fd)
+		c.fd = -1
+	}
+	_ = c.kill()
+	for i := 0; i < 50; i++ {
+		if err := os.Remove(c.dir); err == nil || os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
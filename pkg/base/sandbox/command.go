@@ -0,0 +1,76 @@
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+)
+
+// shellMeta 引号外出现即视为 shell 语法（管道、重定向、命令串联、变量/命令替换等）
+const shellMeta = ";&|<>`$(){}\n"
+
+// ParseCommand 按 shell 的单/双引号与反斜杠规则把命令拆成 argv，但不做任何展开。
+// 命令直接 exec 而不经过 shell，因此引号外的 shell 语法会被拒绝，而不是被悄悄当作普通参数
+func ParseCommand(s string) ([]string, error) {
+	var (
+		argv    []string
+		cur     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range s {
+		switch {
+		case escaped:
+			// 双引号内的反斜杠只转义 $ ` " \，其余保持原样
+			if quote == '"' && !strings.ContainsRune("$`\"\\", c) {
+				cur.WriteRune('\\')
+			}
+			cur.WriteRune(c)
+			escaped = false
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case quote == '"':
+			switch c {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			case '$', '`':
+				return nil, fmt.Errorf("%w: %q inside double quotes", ErrShellSyntax, c)
+			default:
+				cur.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == '\\':
+			escaped = true
+			inArg = true
+		case c == ' ' || c == '\t':
+			if inArg {
+				argv = append(argv, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		case strings.ContainsRune(shellMeta, c):
+			return nil, fmt.Errorf("%w: %q (run a single command, pipes/redirects/&& are not allowed)", ErrShellSyntax, c)
+		default:
+			cur.WriteRune(c)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("%w: unterminated quote or escape", ErrShellSyntax)
+	}
+	if inArg {
+		argv = append(argv, cur.String())
+	}
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("%w: empty command", ErrShellSyntax)
+	}
+	return argv, nil
+}
@@ -0,0 +1,158 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrLandlockUnsupported 内核未启用 Landlock（需要 5.13+ 且 lsm 含 landlock），无法限制文件系统访问
+var ErrLandlockUnsupported = errors.New("sandbox: landlock is not supported by the kernel")
+
+const (
+	fsRead      = unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_READ_DIR
+	fsReadExec  = fsRead | unix.LANDLOCK_ACCESS_FS_EXECUTE
+	fsFileWrite = unix.LANDLOCK_ACCESS_FS_WRITE_FILE | unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	// fsReadWrite 工作区内可读写、执行、创建删除文件与目录，不允许创建设备文件
+	fsReadWrite = fsReadExec | fsFileWrite |
+		unix.LANDLOCK_ACCESS_FS_REMOVE_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_DIR | unix.LANDLOCK_ACCESS_FS_MAKE_REG | unix.LANDLOCK_ACCESS_FS_MAKE_SOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_FIFO | unix.LANDLOCK_ACCESS_FS_MAKE_SYM | unix.LANDLOCK_ACCESS_FS_REFER
+)
+
+// fsPolicy 沙箱进程可访问的文件系统范围，未列出的路径一律拒绝
+type fsPolicy struct {
+	readExec  []string // 只读与执行（系统目录、工具链）
+	readWrite []string // 读写（工作区）
+	devices   []string // 可读写的字符设备文件（/dev/null 等）
+}
+
+// landlockABI 内核支持的 Landlock ABI 版本，不支持时为 0
+func landlockABI() int {
+	v, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION)
+	if errno != 0 {
+		return 0
+	}
+	return int(v)
+}
+
+// handledFS 按 ABI 版本声明需要管控的全部文件系统权限，未被规则放行的即被拒绝
+func handledFS(abi int) uint64 {
+	access := uint64(unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_WRITE_FILE | unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_CHAR | unix.LANDLOCK_ACCESS_FS_MAKE_DIR | unix.LANDLOCK_ACCESS_FS_MAKE_REG |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SOCK | unix.LANDLOCK_ACCESS_FS_MAKE_FIFO | unix.LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SYM)
+	if abi >= 2 {
+		access |= unix.LANDLOCK_ACCESS_FS_REFER
+	}
+	if abi >= 3 {
+		access |= unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	}
+	if abi >= 5 {
+		access |= unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+	}
+	return access
+}
+
+// restrictSelf 对当前线程启用 Landlock，之后由该线程 fork 的进程继承同一限制且无法解除
+func restrictSelf(p fsPolicy) error {
+	abi := landlockABI()
+	if abi < 1 {
+		return ErrLandlockUnsupported
+	}
+	handled := handledFS(abi)
+	attr := unix.LandlockRulesetAttr{Access_fs: handled}
+	if abi >= 6 {
+		// 禁止向域外进程发信号、连接域外的抽象 unix socket
+		attr.Scoped = unix.LANDLOCK_SCOPE_ABSTRACT_UNIX_SOCKET | unix.LANDLOCK_SCOPE_SIGNAL
+	}
+	fd, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return fmt.Errorf("sandbox: landlock create ruleset: %w", errno)
+	}
+	ruleset := int(fd)
+	defer unix.Close(ruleset)
+
+	add := func(paths []string, access uint64) error {
+		for _, path := range paths {
+			if err := addPathRule(ruleset, path, access&handled); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	devAccess := uint64(fsRead | fsFileWrite | unix.LANDLOCK_ACCESS_FS_IOCTL_DEV)
+	if err := add(p.readExec, fsReadExec); err != nil {
+		return err
+	}
+	if err := add(p.readWrite, fsReadWrite); err != nil {
+		return err
+	}
+	if err := add(p.devices, devAccess); err != nil {
+		return err
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("sandbox: set no_new_privs: %w", err)
+	}
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_RESTRICT_SELF, uintptr(ruleset), 0, 0); errno != 0 {
+		return fmt.Errorf("sandbox: landlock restrict self: %w", errno)
+	}
+	return nil
+}
+
+// addPathRule 放行 path 及其子树，不存在的路径跳过；文件只能授予文件类权限
+func addPathRule(ruleset int, path string, access uint64) error {
+	fd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		if errors.Is(err, unix.ENOENT) {
+			return nil
+		}
+		return fmt.Errorf("sandbox: landlock open %s: %w", path, err)
+	}
+	defer unix.Close(fd)
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		return fmt.Errorf("sandbox: landlock stat %s: %w", path, err)
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+		access &= unix.LANDLOCK_ACCESS_FS_EXECUTE | fsRead | fsFileWrite | unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+		access &^= unix.LANDLOCK_ACCESS_FS_READ_DIR
+	}
+	rule := unix.LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(fd)}
+	if _, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, uintptr(ruleset), unix.LANDLOCK_RULE_PATH_BENEATH,
+		uintptr(unsafe.Pointer(&rule)), 0, 0, 0); errno != 0 {
+		return fmt.Errorf("sandbox: landlock add rule %s: %w", path, errno)
+	}
+	return nil
+}
+
+// startConfined 在独占的 OS 线程上启用 Landlock 后启动 cmd，子进程从该线程 fork 因而继承限制。
+// Pdeathsig 绑定的是 fork 出子进程的线程，因此 Wait 也在该线程上完成，返回的 wait 取其结果；
+// 线程始终不解锁，goroutine 退出时随之销毁，受限线程不会回到调度器中执行其他 goroutine
+func startConfined(cmd *exec.Cmd, p fsPolicy) (wait func() error, err error) {
+	started := make(chan error, 1)
+	done := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		if err := restrictSelf(p); err != nil {
+			started <- err
+			return
+		}
+		if err := cmd.Start(); err != nil {
+			started <- err
+			return
+		}
+		started <- nil
+		done <- cmd.Wait()
+	}()
+	if err := <-started; err != nil {
+		return nil, err
+	}
+	return func() error { return <-done }, nil
+}
@@ -0,0 +1,295 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/workspace"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+var (
+	ErrCommandNotAllowed = errors.New("sandbox: command is not in the allowlist")
+	ErrShellSyntax       = errors.New("sandbox: shell syntax is not supported")
+)
+
+// Options 沙箱限制，零值字段使用 constant.Sandbox* 默认值
+type Options struct {
+	Isolation       string
+	Network         bool
+	AllowedCommands []string
+	Timeout         time.Duration
+	CPUTime         time.Duration
+	MemoryMB        int
+	MaxPids         int
+	MaxFileSizeMB   int
+	MaxOutputBytes  int
+	ReadOnlyPaths   []string // 除 constant.SandboxReadOnlyPaths 外额外允许只读访问的路径
+	Cgroup          string   // 委派给服务用户的 cgroup v2 目录，为空时不限制进程数
+}
+
+// Runner 在受限环境中执行命令：
+// 命令不经过 shell 直接 exec，且必须在白名单内；工作目录为白名单根目录或每次调用独立的临时目录；
+// 通过 ulimit 限制 CPU/内存/文件大小，配置 cgroup 时以 pids.max 限制进程数；
+// Linux 下额外以命名空间隔离（默认断网），并以 Landlock 限制文件系统：
+// 只能读写工作目录，系统目录只读，其余路径（家目录、配置文件、其他工作区）一律不可访问；
+// 超时后杀死整个进程组
+type Runner struct {
+	opts    Options
+	jail    *workspace.Jail
+	allowed map[string]struct{}
+}
+
+// Request 一次运行请求，Root 为空时使用临时工作区，否则必须位于 Jail 的工作区内
+type Request struct {
+	Root    string
+	Command string
+	Stdin   string
+	Timeout time.Duration
+}
+
+// Result 运行结果，ExitCode 在超时时为 constant.SandboxTimeoutExitCode，被信号杀死时为 128+signal
+type Result struct {
+	Dir       string
+	Argv      []string
+	Stdout    string
+	Stderr    string
+	ExitCode  int
+	TimedOut  bool
+	Truncated bool
+	Duration  time.Duration
+}
+
+// NewRunner 按 mcp.dev_runner.sandbox 配置创建 Runner，工作目录受 jail 约束
+func NewRunner(jail *workspace.Jail) *Runner {
+	dr := config.MCP.DevRunner
+	return newRunner(Options{
+		Isolation:       dr.Sandbox.Isolation,
+		Network:         dr.Sandbox.Network,
+		AllowedCommands: dr.Sandbox.AllowedCommands,
+		Timeout:         dr.Sandbox.Timeout,
+		CPUTime:         dr.Sandbox.CPUTime,
+		MemoryMB:        dr.Sandbox.MemoryMB,
+		MaxPids:         dr.Sandbox.MaxPids,
+		MaxFileSizeMB:   dr.Sandbox.MaxFileSizeMB,
+		MaxOutputBytes:  dr.Sandbox.MaxOutputBytes,
+		ReadOnlyPaths:   dr.Sandbox.ReadOnlyPaths,
+		Cgroup:          dr.Sandbox.Cgroup,
+	}, jail)
+}
+
+func newRunner(opts Options, jail *workspace.Jail) *Runner {
+	if opts.Isolation == "" {
+		opts.Isolation = constant.SandboxIsolationNamespace
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = constant.SandboxDefaultTimeout
+	}
+	if opts.CPUTime <= 0 {
+		opts.CPUTime = opts.Timeout
+	}
+	if opts.MemoryMB <= 0 {
+		opts.MemoryMB = constant.SandboxDefaultMemoryMB
+	}
+	if opts.MaxPids <= 0 {
+		opts.MaxPids = constant.SandboxDefaultMaxPids
+	}
+	if opts.MaxFileSizeMB <= 0 {
+		opts.MaxFileSizeMB = constant.SandboxDefaultMaxFileSizeMB
+	}
+	if opts.MaxOutputBytes <= 0 {
+		opts.MaxOutputBytes = constant.SandboxDefaultMaxOutputBytes
+	}
+	if len(opts.AllowedCommands) == 0 {
+		opts.AllowedCommands = constant.SandboxDefaultCommands
+	}
+	if opts.Isolation == constant.SandboxIsolationNone {
+		logger.Warnf("sandbox: isolation is none, code_run can access the whole filesystem and network")
+	}
+	if opts.Cgroup == "" {
+		logger.Warnf("sandbox: cgroup is not configured, max_pids is not enforced")
+	}
+
+	r := &Runner{opts: opts, jail: jail, allowed: make(map[string]struct{}, len(opts.AllowedCommands))}
+	for _, c := range opts.AllowedCommands {
+		r.allowed[c] = struct{}{}
+	}
+	return r
+}
+
+// AllowedCommands 允许执行的命令名
+func (r *Runner) AllowedCommands() []string {
+	return r.opts.AllowedCommands
+}
+
+// Run 校验命令与工作目录后在沙箱内执行。策略校验失败返回 error，命令本身失败体现在 Result.ExitCode
+func (r *Runner) Run(ctx context.Context, req Request) (*Result, error) {
+	argv, err := ParseCommand(req.Command)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCommand(argv[0]); err != nil {
+		return nil, err
+	}
+
+	dir := ""
+	if req.Root == "" {
+		tmp, err := os.MkdirTemp("", "mcp-sandbox-*")
+		if err != nil {
+			return nil, fmt.Errorf("sandbox: create workspace: %w", err)
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	} else if dir, err = r.jail.ResolveDir(req.Root); err != nil {
+		return nil, err
+	}
+
+	timeout := r.opts.Timeout
+	if req.Timeout > 0 && req.Timeout < timeout {
+		timeout = req.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "bash", append([]string{"-c", r.limitScript(), "sandbox"}, argv...)...)
+	cmd.Dir = dir
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"LANG=C.UTF-8",
+	}
+	var cg *cgroup
+	if r.opts.Cgroup != "" {
+		if cg, err = newCgroup(r.opts.Cgroup, r.opts.MaxPids); err != nil {
+			return nil, err
+		}
+		defer cg.remove()
+	}
+	isolate := r.opts.Isolation == constant.SandboxIsolationNamespace
+	cmd.SysProcAttr = sysProcAttr(isolate, r.opts.Network, cg)
+	// 超时杀死整个进程组，避免子进程逃逸继续运行；cgroup 内脱离进程组的进程一并杀死
+	cmd.Cancel = func() error {
+		if cg != nil {
+			_ = cg.kill()
+		}
+		return killProcessGroup(cmd.Process)
+	}
+	cmd.WaitDelay = constant.SandboxKillWaitDelay
+
+	stdout := &limitedBuffer{max: r.opts.MaxOutputBytes}
+	stderr := &limitedBuffer{max: r.opts.MaxOutputBytes}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if req.Stdin != "" {
+		cmd.Stdin = strings.NewReader(req.Stdin)
+	}
+
+	start := time.Now()
+	wait := cmd.Wait
+	if isolate {
+		wait, err = startConfined(cmd, r.fsPolicy(dir))
+	} else {
+		err = cmd.Start()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sandbox: start %s: %w", argv[0], err)
+	}
+	runErr := wait()
+	res := &Result{
+		Dir:       dir,
+		Argv:      argv,
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+		Truncated: stdout.truncated || stderr.truncated,
+		Duration:  time.Since(start),
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		res.TimedOut = true
+		res.ExitCode = constant.SandboxTimeoutExitCode
+		return res, nil
+	}
+	if runErr != nil {
+		var ee *exec.ExitError
+		if !errors.As(runErr, &ee) {
+			return nil, fmt.Errorf("sandbox: start %s: %w", argv[0], runErr)
+		}
+		res.ExitCode = exitCode(ee)
+	}
+	return res, nil
+}
+
+// checkCommand 只允许白名单内的命令名，不允许带路径，防止以同名可执行文件绕过
+func (r *Runner) checkCommand(name string) error {
+	if strings.ContainsRune(name, '/') {
+		return fmt.Errorf("%w: %s (use the command name without a path)", ErrCommandNotAllowed, name)
+	}
+	if _, ok := r.allowed[name]; !ok {
+		return fmt.Errorf("%w: %s (allowed: %s)", ErrCommandNotAllowed, name, strings.Join(r.opts.AllowedCommands, ", "))
+	}
+	return nil
+}
+
+// fsPolicy 工作目录可读写，系统目录与配置的只读路径可读可执行，常用设备文件可读写
+func (r *Runner) fsPolicy(dir string) fsPolicy {
+	return fsPolicy{
+		readExec:  append(slices.Clone(constant.SandboxReadOnlyPaths), r.opts.ReadOnlyPaths...),
+		readWrite: []string{dir},
+		devices:   constant.SandboxDevicePaths,
+	}
+}
+
+// limitScript 在 exec 目标命令前用 ulimit 设置资源限制，限制随 exec 继承且不存在竞态。
+// 进程数不用 ulimit -u：它统计的是该 uid 在宿主上的全部进程，服务繁忙时会误伤正常运行
+func (r *Runner) limitScript() string {
+	limits := []string{
+		"ulimit -t " + strconv.Itoa(int((r.opts.CPUTime+time.Second-1)/time.Second)),
+		"ulimit -v " + strconv.Itoa(r.opts.MemoryMB*1024),
+		"ulimit -f " + strconv.Itoa(r.opts.MaxFileSizeMB*1024),
+		"ulimit -c 0",
+	}
+	return strings.Join(limits, " && ") + ` && exec "$@"`
+}
+
+func exitCode(ee *exec.ExitError) int {
+	if status, ok := ee.Sys().(syscall.WaitStatus); ok {
+		if status.Signaled() {
+			return 128 + int(status.Signal())
+		}
+		return status.ExitStatus()
+	}
+	return 1
+}
+
+// limitedBuffer 只保留前 max 字节，超出部分丢弃但不向进程报错
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remain := b.max - b.buf.Len(); remain < len(p) {
+		b.truncated = true
+		if remain > 0 {
+			b.buf.Write(p[:remain])
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
@@ -0,0 +1,33 @@
+package sandbox
+
+import (
+	"os"
+	"syscall"
+)
+
+// sysProcAttr 新建进程组；启用隔离时进入新的 user/pid/ipc/uts 命名空间，
+// 不允许联网时再进入只有 lo 的 net 命名空间。不设置 uid/gid 映射（启用 Landlock 的线程无法写 /proc/<pid>/uid_map），
+// 子进程在命名空间内以 nobody 身份运行，不获得任何额外权限。
+// 文件系统访问由 startConfined 的 Landlock 限制；cg 非空时直接在该 cgroup 中创建进程
+func sysProcAttr(isolate, network bool, cg *cgroup) *syscall.SysProcAttr {
+	attr := &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	if cg != nil {
+		attr.UseCgroupFD = true
+		attr.CgroupFD = cg.fd
+	}
+	if !isolate {
+		return attr
+	}
+	attr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWPID | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS
+	if !network {
+		attr.Cloneflags |= syscall.CLONE_NEWNET
+	}
+	return attr
+}
+
+func killProcessGroup(p *os.Process) error {
+	return syscall.Kill(-p.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,75 @@
+package sandbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/workspace"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfinement(t *testing.T) {
+	if landlockABI() < 1 {
+		t.Skip("landlock is not available")
+	}
+	root := t.TempDir()
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "config.yaml")
+	if err := os.WriteFile(secret, []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	r := newRunner(Options{
+		Isolation:       constant.SandboxIsolationNamespace,
+		AllowedCommands: []string{"cat", "touch", "bash", "ls"},
+	}, workspace.New([]string{root}, nil))
+
+	run := func(req Request) *Result {
+		res, err := r.Run(context.Background(), req)
+		So(err, ShouldBeNil)
+		return res
+	}
+
+	Convey("namespace isolation confines the filesystem to the working directory", t, func() {
+		Convey("files outside the workspace can't be read or listed", func() {
+			So(run(Request{Root: root, Command: "cat " + secret}).ExitCode, ShouldNotEqual, 0)
+			So(run(Request{Command: "ls " + outside}).ExitCode, ShouldNotEqual, 0)
+			home, _ := os.UserHomeDir()
+			So(run(Request{Command: "ls " + home}).ExitCode, ShouldNotEqual, 0)
+		})
+		Convey("nothing outside the workspace can be written", func() {
+			So(run(Request{Root: root, Command: "touch " + filepath.Join(outside, "x")}).ExitCode, ShouldNotEqual, 0)
+			_, err := os.Stat(filepath.Join(outside, "x"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+		Convey("the workspace is readable and writable and system binaries run", func() {
+			res := run(Request{Root: root, Command: "bash -c 'echo hi > f && cat f'"})
+			So(res.ExitCode, ShouldEqual, 0)
+			So(res.Stdout, ShouldEqual, "hi\n")
+			So(run(Request{Command: "touch tmp-file"}).ExitCode, ShouldEqual, 0)
+		})
+	})
+
+	Convey("a cgroup limits the number of processes in the sandbox only", t, func() {
+		parent := os.Getenv("SANDBOX_TEST_CGROUP") // 委派的 cgroup v2 目录，需启用 pids 控制器
+		if parent == "" {
+			SkipSo("SANDBOX_TEST_CGROUP is not set")
+			return
+		}
+		cr := newRunner(Options{
+			Isolation:       constant.SandboxIsolationNamespace,
+			AllowedCommands: []string{"bash"},
+			MaxPids:         4,
+			Cgroup:          parent,
+		}, workspace.New([]string{root}, nil))
+		res, err := cr.Run(context.Background(), Request{Command: "bash -c 'for i in 1 2 3 4 5 6 7 8; do sleep 1 & done; wait'"})
+		So(err, ShouldBeNil)
+		So(res.Stderr, ShouldContainSubstring, "fork")
+		entries, _ := os.ReadDir(parent)
+		for _, e := range entries {
+			So(e.Name(), ShouldNotStartWith, "run-")
+		}
+	})
+}
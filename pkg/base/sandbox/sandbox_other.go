@@ -0,0 +1,42 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+var errIsolationUnsupported = errors.New("sandbox: namespace isolation requires Linux, set mcp.dev_runner.sandbox.isolation to \"none\" explicitly")
+
+type cgroup struct{}
+
+type fsPolicy struct {
+	readExec  []string
+	readWrite []string
+	devices   []string
+}
+
+// sysProcAttr 非 Linux 平台不支持命名空间隔离，仅依赖 ulimit 与超时
+func sysProcAttr(isolate, network bool, cg *cgroup) *syscall.SysProcAttr {
+	return nil
+}
+
+// startConfined 非 Linux 平台无法限制文件系统访问，拒绝以隔离模式运行
+func startConfined(cmd *exec.Cmd, p fsPolicy) (wait func() error, err error) {
+	return nil, errIsolationUnsupported
+}
+
+func newCgroup(parent string, maxPids int) (*cgroup, error) {
+	return nil, errors.New("sandbox: cgroup requires Linux")
+}
+
+func (c *cgroup) kill() error { return nil }
+
+func (c *cgroup) remove() {}
+
+func killProcessGroup(p *os.Process) error {
+	return p.Kill()
+}
@@ -0,0 +1,102 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/workspace"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseCommand(t *testing.T) {
+	Convey("ParseCommand", t, func() {
+		Convey("splits arguments and honours quotes", func() {
+			argv, err := ParseCommand(`python3 -c 'print("a b")' "x\ny" z\ w`)
+			So(err, ShouldBeNil)
+			So(argv, ShouldResemble, []string{"python3", "-c", `print("a b")`, `x\ny`, "z w"})
+		})
+		Convey("rejects shell syntax outside quotes", func() {
+			for _, cmd := range []string{"ls; rm -rf /", "cat a | sh", "echo $HOME", "go run . && curl x", "cat < /etc/passwd", `echo "$(id)"`} {
+				_, err := ParseCommand(cmd)
+				So(errors.Is(err, ErrShellSyntax), ShouldBeTrue)
+			}
+		})
+		Convey("allows shell characters inside single quotes", func() {
+			argv, err := ParseCommand(`python3 -c 'import os; print(1 | 2)'`)
+			So(err, ShouldBeNil)
+			So(argv[2], ShouldEqual, "import os; print(1 | 2)")
+		})
+		Convey("rejects unterminated quotes and empty commands", func() {
+			_, err := ParseCommand(`echo 'abc`)
+			So(errors.Is(err, ErrShellSyntax), ShouldBeTrue)
+			_, err = ParseCommand("   ")
+			So(errors.Is(err, ErrShellSyntax), ShouldBeTrue)
+		})
+	})
+}
+
+func TestRunner(t *testing.T) {
+	root := t.TempDir()
+	jail := workspace.New([]string{root}, nil)
+	r := newRunner(Options{
+		Isolation:       constant.SandboxIsolationNone,
+		AllowedCommands: []string{"echo", "sleep", "bash", "pwd"},
+		MaxOutputBytes:  64,
+	}, jail)
+
+	Convey("Runner", t, func() {
+		Convey("runs allowlisted commands in a temporary workspace", func() {
+			res, err := r.Run(context.Background(), Request{Command: "pwd"})
+			So(err, ShouldBeNil)
+			So(res.ExitCode, ShouldEqual, 0)
+			So(res.Stdout, ShouldEqual, res.Dir+"\n")
+			So(res.Dir, ShouldStartWith, filepath.Join(os.TempDir(), "mcp-sandbox-"))
+			_, statErr := os.Stat(res.Dir)
+			So(os.IsNotExist(statErr), ShouldBeTrue)
+		})
+		Convey("rejects commands outside the allowlist or given by path", func() {
+			_, err := r.Run(context.Background(), Request{Command: "rm -rf x"})
+			So(errors.Is(err, ErrCommandNotAllowed), ShouldBeTrue)
+			_, err = r.Run(context.Background(), Request{Command: "/tmp/echo hi"})
+			So(errors.Is(err, ErrCommandNotAllowed), ShouldBeTrue)
+		})
+		Convey("confines root to the workspace roots", func() {
+			sub := filepath.Join(root, "proj")
+			So(os.Mkdir(sub, 0o755), ShouldBeNil)
+			res, err := r.Run(context.Background(), Request{Root: sub, Command: "pwd"})
+			So(err, ShouldBeNil)
+			So(res.Dir, ShouldEqual, filepath.Join(jail.Roots()[0], "proj"))
+
+			_, err = r.Run(context.Background(), Request{Root: "/", Command: "pwd"})
+			So(errors.Is(err, workspace.ErrOutsideRoots), ShouldBeTrue)
+		})
+		Convey("truncates output", func() {
+			res, err := r.Run(context.Background(), Request{Command: "echo " + strings.Repeat("x", 100)})
+			So(err, ShouldBeNil)
+			So(res.Truncated, ShouldBeTrue)
+			So(res.Stdout, ShouldEqual, strings.Repeat("x", 64))
+		})
+		Convey("kills the whole process group on timeout", func() {
+			marker := filepath.Join(root, "marker")
+			start := time.Now()
+			res, err := r.Run(context.Background(), Request{
+				Root:    root,
+				Command: "bash -c 'sleep 2 && touch " + marker + "'",
+				Timeout: 200 * time.Millisecond,
+			})
+			So(err, ShouldBeNil)
+			So(res.TimedOut, ShouldBeTrue)
+			So(res.ExitCode, ShouldEqual, constant.SandboxTimeoutExitCode)
+			So(time.Since(start), ShouldBeLessThan, 2*time.Second)
+			time.Sleep(2500 * time.Millisecond)
+			_, statErr := os.Stat(marker)
+			So(os.IsNotExist(statErr), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,56 @@
+package tool_set
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	statusOK          = "ok"
+	statusToolError   = "tool_error"   // 工具返回 isError
+	statusError       = "error"        // handler 返回 error
+	statusRateLimited = "rate_limited" // 被 RateLimit 拒绝，未进入 handler
+)
+
+var (
+	toolCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mcp",
+		Name:      "tool_calls_total",
+		Help:      "Number of MCP tool calls by tool and status.",
+	}, []string{"tool", "status"})
+
+	toolDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "mcp",
+		Name:      "tool_call_duration_seconds",
+		Help:      "Latency of MCP tool calls.",
+		Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"tool"})
+)
+
+// Metrics 记录调用次数（按结果状态）与耗时，由 mcp_server 在 constant.MCPMetricsPath 暴露。
+// 每次调用只计一次，内层中间件返回的错误按 structuredContent.error.type 归类
+func Metrics() Middleware {
+	return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			toolDuration.WithLabelValues(tool.Name).Observe(time.Since(start).Seconds())
+			status := statusOK
+			switch {
+			case err != nil:
+				status = statusError
+			case res != nil && res.IsError && errorKind(res) == statusRateLimited:
+				status = statusRateLimited
+			case res != nil && res.IsError:
+				status = statusToolError
+			}
+			toolCalls.WithLabelValues(tool.Name, status).Inc()
+			return res, err
+		}
+	}
+}
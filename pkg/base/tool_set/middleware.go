@@ -0,0 +1,273 @@
+package tool_set
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"runtime/debug"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/jwt"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Middleware 包装单个工具的 handler，tool 为被包装工具的定义
+type Middleware func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc
+
+// WithMiddleware 为所有工具追加中间件，先追加的在外层；
+// 包装在全部 Option 执行完后进行，与注册工具的 Option 先后无关
+func WithMiddleware(mw ...Middleware) Option {
+	return func(ts *ToolSet) {
+		ts.Middlewares = append(ts.Middlewares, mw...)
+	}
+}
+
+// WithConfiguredMiddlewares 按 mcp.tools 配置挂载默认中间件链：
+// panic 恢复 -> 指标 -> 审计日志 -> 按用户限流 -> 超时。rdb 为 nil 时不限流
+func WithConfiguredMiddlewares(rdb *redis.Client) Option {
+	var limiter Limiter
+	if rdb != nil {
+		limiter = NewRedisLimiter(rdb)
+	}
+	return WithMiddleware(Recovery(), Metrics(), Audit(), RateLimit(limiter), Timeout())
+}
+
+// applyMiddlewares 用 Middlewares 包装所有已注册的 handler
+func (ts *ToolSet) applyMiddlewares() {
+	for _, t := range ts.Tools {
+		h, ok := ts.HandlerFunc[t.Name]
+		if !ok {
+			continue
+		}
+		for i := len(ts.Middlewares) - 1; i >= 0; i-- {
+			h = ts.Middlewares[i](*t, h)
+		}
+		ts.HandlerFunc[t.Name] = h
+	}
+}
+
+// Policy 单个工具生效的调用策略
+type Policy struct {
+	Timeout   time.Duration
+	RateLimit float64
+	Burst     int
+	Audit     bool
+}
+
+// PolicyFor 合并 mcp.tools.default 与名称匹配的 rules，后出现的规则覆盖先出现的
+func PolicyFor(name string) Policy {
+	var p Policy
+	if config.MCP == nil {
+		return p
+	}
+	merge := func(timeout time.Duration, rate float64, burst int, audit *bool) {
+		if timeout != 0 {
+			p.Timeout = timeout
+		}
+		if rate != 0 {
+			p.RateLimit = rate
+		}
+		if burst != 0 {
+			p.Burst = burst
+		}
+		if audit != nil {
+			p.Audit = *audit
+		}
+	}
+	d := config.MCP.Tools.Default
+	merge(d.Timeout, d.RateLimit, d.Burst, d.Audit)
+	for _, r := range config.MCP.Tools.Rules {
+		if ok, _ := path.Match(r.Name, name); ok {
+			merge(r.Timeout, r.RateLimit, r.Burst, r.Audit)
+		}
+	}
+	if p.RateLimit > 0 && p.Burst <= 0 {
+		p.Burst = int(math.Ceil(p.RateLimit))
+	}
+	return p
+}
+
+// policyFor 中间件查询策略的入口，测试中替换以绕开配置文件
+var policyFor = PolicyFor
+
+// CallerID 调用方标识，用于限流与审计：
+// host 在 _meta 中携带的用户 ID > 参数中的 user_id > 令牌的 client_id > 会话 ID
+func CallerID(ctx context.Context, req mcp.CallToolRequest) string {
+	if req.Params.Meta != nil {
+		if id, ok := req.Params.Meta.AdditionalFields[constant.MCPMetaUserID].(string); ok && id != "" {
+			return id
+		}
+	}
+	if id, ok := req.GetArguments()["user_id"].(string); ok && id != "" {
+		return id
+	}
+	if claims, ok := jwt.MCPClaimsFrom(ctx); ok && claims.ClientID != "" {
+		return "client:" + claims.ClientID
+	}
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return "session:" + session.SessionID()
+	}
+	return "anonymous"
+}
+
+// Recovery 把 handler 中的 panic 转成带结构化内容的工具错误，不让单个工具拖垮会话
+func Recovery() Middleware {
+	return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (res *mcp.CallToolResult, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Errorf("tool_set: panic in tool %s: %v\n%s", tool.Name, r, debug.Stack())
+					res = toolError(tool.Name, "panic", fmt.Sprintf("internal error in tool %s", tool.Name))
+					err = nil
+				}
+			}()
+			return next(ctx, req)
+		}
+	}
+}
+
+// Timeout 按策略限制单次调用时长。handler 不响应 ctx 取消时也会按时返回超时错误，
+// 其 goroutine 在后台自行结束；handler 中的 panic 会转交给外层的 Recovery
+func Timeout() Middleware {
+	return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		timeout := policyFor(tool.Name).Timeout
+		if timeout <= 0 {
+			return next
+		}
+		type result struct {
+			res   *mcp.CallToolResult
+			err   error
+			panic any
+		}
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			done := make(chan result, 1)
+			go func() {
+				defer func() {
+					if r := recover(); r != nil {
+						done <- result{panic: r}
+					}
+				}()
+				res, err := next(ctx, req)
+				done <- result{res: res, err: err}
+			}()
+			select {
+			case r := <-done:
+				if r.panic != nil {
+					panic(r.panic)
+				}
+				return r.res, r.err
+			case <-ctx.Done():
+				if ctx.Err() == context.DeadlineExceeded {
+					return toolError(tool.Name, "timeout", fmt.Sprintf("tool %s timed out after %s", tool.Name, timeout)), nil
+				}
+				return nil, ctx.Err()
+			}
+		}
+	}
+}
+
+// RateLimit 按 调用方+工具 限流，超限时返回带重试时间的工具错误（由外层 Metrics 计为 rate_limited）；限流器故障时放行
+func RateLimit(limiter Limiter) Middleware {
+	return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		p := policyFor(tool.Name)
+		if limiter == nil || p.RateLimit <= 0 {
+			return next
+		}
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key := constant.MCPToolRateLimitKeyPrefix + tool.Name + ":" + CallerID(ctx, req)
+			ok, retryAfter, err := limiter.Allow(ctx, key, p.RateLimit, p.Burst)
+			if err != nil {
+				logger.Warnf("tool_set: rate limit %s: %v", key, err)
+				return next(ctx, req)
+			}
+			if !ok {
+				return toolError(tool.Name, "rate_limited",
+					fmt.Sprintf("rate limit exceeded for tool %s, retry after %s", tool.Name, retryAfter.Round(time.Millisecond))), nil
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// Audit 记录调用方、参数（截断）、耗时与结果大小
+func Audit() Middleware {
+	return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if !policyFor(tool.Name).Audit {
+			return next
+		}
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			args, _ := json.Marshal(req.GetArguments())
+			if len(args) > constant.MCPToolAuditMaxArgBytes {
+				args = append(args[:constant.MCPToolAuditMaxArgBytes:constant.MCPToolAuditMaxArgBytes], "..."...)
+			}
+			fields := []zap.Field{
+				zap.String("tool", tool.Name),
+				zap.String("caller", CallerID(ctx, req)),
+				zap.ByteString("args", args),
+				zap.Duration("duration", time.Since(start)),
+			}
+			if res != nil {
+				fields = append(fields, zap.Int("result_bytes", resultSize(res)), zap.Bool("is_error", res.IsError))
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+			}
+			logger.Info("tool audit", fields...)
+			return res, err
+		}
+	}
+}
+
+// toolError 统一的工具错误：文本给模型看，structuredContent 供程序判断错误类型
+func toolError(tool, kind, message string) *mcp.CallToolResult {
+	res := mcp.NewToolResultError(message)
+	res.StructuredContent = map[string]any{
+		"error": map[string]any{"type": kind, "tool": tool, "message": message},
+	}
+	return res
+}
+
+// errorKind toolError 写入的错误类型，其他结果返回空串
+func errorKind(res *mcp.CallToolResult) string {
+	sc, _ := res.StructuredContent.(map[string]any)
+	e, _ := sc["error"].(map[string]any)
+	kind, _ := e["type"].(string)
+	return kind
+}
+
+func resultSize(res *mcp.CallToolResult) int {
+	n := 0
+	for _, c := range res.Content {
+		switch x := c.(type) {
+		case mcp.TextContent:
+			n += len(x.Text)
+		case mcp.ImageContent:
+			n += len(x.Data)
+		case mcp.AudioContent:
+			n += len(x.Data)
+		case mcp.EmbeddedResource:
+			if r, ok := x.Resource.(mcp.TextResourceContents); ok {
+				n += len(r.Text)
+			} else if r, ok := x.Resource.(mcp.BlobResourceContents); ok {
+				n += len(r.Blob)
+			}
+		}
+	}
+	if res.StructuredContent != nil {
+		data, _ := json.Marshal(res.StructuredContent)
+		n += len(data)
+	}
+	return n
+}
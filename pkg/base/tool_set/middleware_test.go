@@ -0,0 +1,118 @@
+package tool_set
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type fakeLimiter struct {
+	keys  []string
+	allow bool
+}
+
+func (f *fakeLimiter) Allow(_ context.Context, key string, _ float64, _ int) (bool, time.Duration, error) {
+	f.keys = append(f.keys, key)
+	return f.allow, time.Second, nil
+}
+
+func TestMiddlewares(t *testing.T) {
+	policies := map[string]Policy{
+		"slow":    {Timeout: 20 * time.Millisecond},
+		"limited": {RateLimit: 1, Burst: 1},
+	}
+	origin := policyFor
+	policyFor = func(name string) Policy { return policies[name] }
+	defer func() { policyFor = origin }()
+
+	call := func(h server.ToolHandlerFunc, meta map[string]any) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		if meta != nil {
+			req.Params.Meta = &mcp.Meta{AdditionalFields: meta}
+		}
+		res, err := h(context.Background(), req)
+		So(err, ShouldBeNil)
+		return res
+	}
+	Convey("middlewares", t, func() {
+		Convey("applies in order, first is outermost", func() {
+			var trace []string
+			mark := func(name string) Middleware {
+				return func(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+					return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+						trace = append(trace, name)
+						return next(ctx, req)
+					}
+				}
+			}
+			tool := mcp.NewTool("echo")
+			ts := &ToolSet{
+				Tools: []*mcp.Tool{&tool},
+				HandlerFunc: map[string]server.ToolHandlerFunc{"echo": func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+					trace = append(trace, "handler")
+					return mcp.NewToolResultText("ok"), nil
+				}},
+			}
+			WithMiddleware(mark("a"), mark("b"))(ts)
+			ts.applyMiddlewares()
+			call(ts.HandlerFunc["echo"], nil)
+			So(trace, ShouldResemble, []string{"a", "b", "handler"})
+		})
+
+		Convey("recovers from panics", func() {
+			h := Recovery()(mcp.NewTool("boom"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				panic("oops")
+			})
+			res := call(h, nil)
+			So(res.IsError, ShouldBeTrue)
+			So(errorKind(res), ShouldEqual, "panic")
+		})
+
+		Convey("times out slow handlers", func() {
+			h := Timeout()(mcp.NewTool("slow"), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				<-ctx.Done()
+				return mcp.NewToolResultText("late"), nil
+			})
+			res := call(h, nil)
+			So(res.IsError, ShouldBeTrue)
+			So(errorKind(res), ShouldEqual, "timeout")
+		})
+
+		Convey("rate limits by tool and caller", func() {
+			limiter := &fakeLimiter{}
+			h := RateLimit(limiter)(mcp.NewTool("limited"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText("ok"), nil
+			})
+			res := call(h, map[string]any{"user_id": "u1"})
+			So(res.IsError, ShouldBeTrue)
+			So(errorKind(res), ShouldEqual, "rate_limited")
+			So(limiter.keys, ShouldResemble, []string{"mcp:ratelimit:limited:u1"})
+
+			limiter.allow = true
+			So(call(h, nil).IsError, ShouldBeFalse)
+		})
+
+		Convey("counts a rate-limited call once", func() {
+			h := Metrics()(mcp.NewTool("limited"), RateLimit(&fakeLimiter{})(mcp.NewTool("limited"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText("ok"), nil
+			}))
+			limited, failed := testutil.ToFloat64(toolCalls.WithLabelValues("limited", statusRateLimited)), testutil.ToFloat64(toolCalls.WithLabelValues("limited", statusToolError))
+			So(call(h, nil).IsError, ShouldBeTrue)
+			So(testutil.ToFloat64(toolCalls.WithLabelValues("limited", statusRateLimited)), ShouldEqual, limited+1)
+			So(testutil.ToFloat64(toolCalls.WithLabelValues("limited", statusToolError)), ShouldEqual, failed)
+		})
+
+		Convey("skips tools without a policy", func() {
+			limiter := &fakeLimiter{}
+			RateLimit(limiter)(mcp.NewTool("free"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText("ok"), nil
+			})
+			So(limiter.keys, ShouldBeEmpty)
+		})
+	})
+}
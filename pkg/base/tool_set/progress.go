@@ -0,0 +1,106 @@
+package tool_set
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Progress 工具处理函数向调用方汇报进度（notifications/progress）。
+// 调用方未在 _meta 中携带 progressToken 时所有方法都是空操作，工具无需区分。
+// Streamable HTTP 下通知与结果共用一条响应流，紧贴在返回前发送的进度可能被丢弃，调用方应以结果为准
+type Progress struct {
+	ctx   context.Context
+	srv   *server.MCPServer
+	token mcp.ProgressToken
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewProgress 从工具调用请求中取出 progressToken
+func NewProgress(ctx context.Context, req mcp.CallToolRequest) *Progress {
+	p := &Progress{ctx: ctx, srv: server.ServerFromContext(ctx)}
+	if req.Params.Meta != nil {
+		p.token = req.Params.Meta.ProgressToken
+	}
+	return p
+}
+
+// Enabled 调用方是否需要进度通知
+func (p *Progress) Enabled() bool {
+	return p.token != nil && p.srv != nil
+}
+
+// Report 发送一次进度。progress 须单调递增；total 未知时传 0
+func (p *Progress) Report(progress, total float64, message string) {
+	if !p.Enabled() {
+		return
+	}
+	p.mu.Lock()
+	p.last = time.Now()
+	p.mu.Unlock()
+	params := map[string]any{
+		"progressToken": p.token,
+		"progress":      progress,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	if err := p.srv.SendNotificationToClient(p.ctx, constant.MCPProgressMethod, params); err != nil {
+		logger.Warnf("tool_set: send progress: %v", err)
+	}
+}
+
+// Throttled 同 Report，但距上次发送不足 constant.MCPProgressThrottle 时直接丢弃，适合在流式回调中调用
+func (p *Progress) Throttled(progress, total float64, message string) {
+	if !p.Enabled() {
+		return
+	}
+	p.mu.Lock()
+	skip := time.Since(p.last) < constant.MCPProgressThrottle
+	p.mu.Unlock()
+	if !skip {
+		p.Report(progress, total, message)
+	}
+}
+
+// Heartbeat 适用于无法度量进度的阻塞操作：每隔 constant.MCPProgressInterval 汇报一次已耗时（秒），
+// message 根据已耗时生成。返回的 stop 必须在操作结束时调用
+func (p *Progress) Heartbeat(message func(elapsed time.Duration) string) (stop func()) {
+	if !p.Enabled() {
+		return func() {}
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		start := time.Now()
+		ticker := time.NewTicker(constant.MCPProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				elapsed := time.Since(start)
+				p.Report(elapsed.Round(time.Second).Seconds(), 0, message(elapsed))
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
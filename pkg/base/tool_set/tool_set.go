@@ -3,12 +3,6 @@ package tool_set
 import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"sync"
-)
-
-var (
-	instance *ToolSet
-	once     sync.Once
 )
 
 type ToolSet struct {
@@ -16,20 +10,20 @@ type ToolSet struct {
 	Tools []*mcp.Tool
 	// map[t.Name]HandlerFunc
 	HandlerFunc map[string]server.ToolHandlerFunc
+	// 包装所有 handler 的中间件，先追加的在外层
+	Middlewares []Middleware
 }
 
 // Option 定义了一个参数为toolSet的函数，具体实现为在函数内对toolSet进行append
 type Option func(toolSet *ToolSet)
 
+// NewToolSet 每次调用返回独立的实例，同一进程内可为不同的 MCP 服务分别构造工具集
 func NewToolSet(opt ...Option) *ToolSet {
-	once.Do(func() {
-		var options []Option
-		instance = new(ToolSet)
-		instance.HandlerFunc = make(map[string]server.ToolHandlerFunc)
-		options = append(options, opt...)
-		for _, opt := range options {
-			opt(instance)
-		}
-	})
+	instance := new(ToolSet)
+	instance.HandlerFunc = make(map[string]server.ToolHandlerFunc)
+	for _, o := range opt {
+		o(instance)
+	}
+	instance.applyMiddlewares()
 	return instance
 }
@@ -0,0 +1,31 @@
+package tool_set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func withEcho(name string) Option {
+	return func(ts *ToolSet) {
+		tool := mcp.NewTool(name)
+		ts.Tools = append(ts.Tools, &tool)
+		ts.HandlerFunc[name] = func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText(name), nil
+		}
+	}
+}
+
+func TestNewToolSet(t *testing.T) {
+	Convey("NewToolSet builds independent instances", t, func() {
+		local := NewToolSet(withEcho("local"))
+		remote := NewToolSet(withEcho("remote"))
+		So(local, ShouldNotEqual, remote)
+		So(local.Tools, ShouldHaveLength, 1)
+		So(local.HandlerFunc, ShouldContainKey, "local")
+		So(local.HandlerFunc, ShouldNotContainKey, "remote")
+		So(remote.HandlerFunc, ShouldContainKey, "remote")
+	})
+}
@@ -0,0 +1,28 @@
+package tool_set
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TypedHandler 参数已解码为 T 的工具处理函数
+type TypedHandler[T any] func(ctx context.Context, req mcp.CallToolRequest, args T) (*mcp.CallToolResult, error)
+
+// Typed 把参数按 json tag 解码进 T 后再调用 fn，解码失败返回统一的工具错误。
+// 与 WithValidation 配合使用时，T 的字段类型只需与 schema 声明一致
+func Typed[T any](fn TypedHandler[T]) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args T
+		data, err := json.Marshal(req.GetArguments())
+		if err == nil {
+			err = json.Unmarshal(data, &args)
+		}
+		if err != nil {
+			return mcp.NewToolResultError((&ValidationError{Problems: []string{err.Error()}}).Error()), nil
+		}
+		return fn(ctx, req, args)
+	}
+}
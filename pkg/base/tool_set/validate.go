@@ -0,0 +1,327 @@
+package tool_set
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ValidationError 参数不符合工具声明的 InputSchema，Problems 按参数路径排序
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid arguments: " + strings.Join(e.Problems, "; ")
+}
+
+// WithValidation 在调用 next 之前按 tool.InputSchema 校验并纠正参数，不通过时返回统一的工具错误。
+// 纠正后的参数写回 req.Params.Arguments，handler 拿到的类型与 schema 一致（number 为 float64）。
+// 只声明了 RawInputSchema 的工具不做校验
+func WithValidation(tool mcp.Tool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	if tool.RawInputSchema != nil {
+		return next
+	}
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, err := Validate(tool.InputSchema, req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		req.Params.Arguments = args
+		return next(ctx, req)
+	}
+}
+
+// Validate 校验 args 的类型、必填、枚举与取值范围，并纠正模型常见的错误：
+// 数字/布尔写成字符串、字符串参数传了数字、数组或对象被整体序列化成字符串、单个值未包成数组；
+// 缺省参数按 schema 中的 default 补齐，值为 null 的可选参数视为未传。返回纠正后的新 map
+func Validate(schema mcp.ToolInputSchema, args any) (map[string]any, error) {
+	var in map[string]any
+	switch x := args.(type) {
+	case nil:
+		in = map[string]any{}
+	case map[string]any:
+		in = x
+	case json.RawMessage:
+		if err := json.Unmarshal(x, &in); err != nil {
+			return nil, &ValidationError{Problems: []string{"arguments must be a JSON object"}}
+		}
+	default:
+		data, err := json.Marshal(x)
+		if err != nil || json.Unmarshal(data, &in) != nil {
+			return nil, &ValidationError{Problems: []string{"arguments must be a JSON object"}}
+		}
+	}
+	v := &validator{}
+	out := v.object("", map[string]any{"properties": schema.Properties, "required": schema.Required}, in)
+	if len(v.problems) > 0 {
+		sort.Strings(v.problems)
+		return nil, &ValidationError{Problems: v.problems}
+	}
+	return out, nil
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) fail(path, format string, a ...any) {
+	if path == "" {
+		path = "arguments"
+	}
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, a...))
+}
+
+func (v *validator) object(path string, schema map[string]any, in map[string]any) map[string]any {
+	props, _ := schema["properties"].(map[string]any)
+	out := make(map[string]any, len(in))
+	for k, val := range in {
+		if val == nil {
+			continue
+		}
+		prop, ok := props[k].(map[string]any)
+		if !ok {
+			if schema["additionalProperties"] == false {
+				v.fail(join(path, k), "unknown argument")
+				continue
+			}
+			out[k] = val
+			continue
+		}
+		if val, ok := v.value(join(path, k), prop, val); ok {
+			out[k] = val
+		}
+	}
+	for k, p := range props {
+		if _, ok := out[k]; ok {
+			continue
+		}
+		if prop, ok := p.(map[string]any); ok {
+			if def, ok := prop["default"]; ok {
+				out[k] = def
+			}
+		}
+	}
+	for _, k := range stringList(schema["required"]) {
+		// 传了但不合法的参数已经记录过错误
+		if _, ok := out[k]; !ok && in[k] == nil {
+			v.fail(join(path, k), "missing required argument")
+		}
+	}
+	return out
+}
+
+// value 校验单个值，ok=false 表示已记录错误
+func (v *validator) value(path string, schema map[string]any, val any) (any, bool) {
+	typ, _ := schema["type"].(string)
+	coerced, ok := coerce(typ, val)
+	if !ok {
+		v.fail(path, "expected %s, got %s", typ, jsonType(val))
+		return nil, false
+	}
+	val = coerced
+	if enum, ok := schema["enum"]; ok && !inEnum(enum, val) {
+		v.fail(path, "must be one of %v", enum)
+		return nil, false
+	}
+	before := len(v.problems)
+	switch x := val.(type) {
+	case string:
+		n := len([]rune(x))
+		if limit, ok := number(schema["minLength"]); ok && float64(n) < limit {
+			v.fail(path, "length must be >= %v", limit)
+		}
+		if limit, ok := number(schema["maxLength"]); ok && float64(n) > limit {
+			v.fail(path, "length must be <= %v", limit)
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(x) {
+				v.fail(path, "must match pattern %s", pattern)
+			}
+		}
+	case float64:
+		if limit, ok := number(schema["minimum"]); ok && x < limit {
+			v.fail(path, "must be >= %v", limit)
+		}
+		if limit, ok := number(schema["maximum"]); ok && x > limit {
+			v.fail(path, "must be <= %v", limit)
+		}
+	case []any:
+		if limit, ok := number(schema["minItems"]); ok && float64(len(x)) < limit {
+			v.fail(path, "must contain at least %v items", limit)
+		}
+		if limit, ok := number(schema["maxItems"]); ok && float64(len(x)) > limit {
+			v.fail(path, "must contain at most %v items", limit)
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			out := make([]any, 0, len(x))
+			for i, item := range x {
+				if item, ok := v.value(fmt.Sprintf("%s[%d]", path, i), items, item); ok {
+					out = append(out, item)
+				}
+			}
+			val = out
+		}
+	case map[string]any:
+		if _, ok := schema["properties"]; ok {
+			val = v.object(path, schema, x)
+		}
+	}
+	return val, len(v.problems) == before
+}
+
+// coerce 把 val 转成 typ 对应的 JSON 类型；未声明类型时原样返回
+func coerce(typ string, val any) (any, bool) {
+	switch typ {
+	case "string":
+		switch x := val.(type) {
+		case string:
+			return x, true
+		case float64:
+			return strconv.FormatFloat(x, 'f', -1, 64), true
+		case bool:
+			return strconv.FormatBool(x), true
+		}
+	case "number", "integer":
+		var f float64
+		switch x := val.(type) {
+		case float64:
+			f = x
+		case int:
+			f = float64(x)
+		case int64:
+			f = float64(x)
+		case json.Number:
+			n, err := x.Float64()
+			if err != nil {
+				return val, false
+			}
+			f = n
+		case string:
+			n, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+			if err != nil {
+				return val, false
+			}
+			f = n
+		default:
+			return val, false
+		}
+		if typ == "integer" && f != math.Trunc(f) {
+			return val, false
+		}
+		return f, true
+	case "boolean":
+		switch x := val.(type) {
+		case bool:
+			return x, true
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(x))
+			return b, err == nil
+		}
+	case "array":
+		switch x := val.(type) {
+		case []any:
+			return x, true
+		case string:
+			var arr []any
+			if s := strings.TrimSpace(x); strings.HasPrefix(s, "[") && json.Unmarshal([]byte(s), &arr) == nil {
+				return arr, true
+			}
+		}
+		// 单个值未包成数组
+		return []any{val}, true
+	case "object":
+		switch x := val.(type) {
+		case map[string]any:
+			return x, true
+		case string:
+			var obj map[string]any
+			if s := strings.TrimSpace(x); strings.HasPrefix(s, "{") && json.Unmarshal([]byte(s), &obj) == nil {
+				return obj, true
+			}
+		}
+	default:
+		return val, true
+	}
+	return val, false
+}
+
+func inEnum(enum any, val any) bool {
+	switch list := enum.(type) {
+	case []string:
+		s, ok := val.(string)
+		for _, e := range list {
+			if ok && e == s {
+				return true
+			}
+		}
+		return false
+	case []any:
+		for _, e := range list {
+			if fmt.Sprint(e) == fmt.Sprint(val) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func number(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	}
+	return 0, false
+}
+
+func stringList(v any) []string {
+	switch x := v.(type) {
+	case []string:
+		return x
+	case []any:
+		out := make([]string, 0, len(x))
+		for _, s := range x {
+			if s, ok := s.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case float64, int, int64, json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
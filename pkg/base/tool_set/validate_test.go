@@ -0,0 +1,109 @@
+package tool_set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidate(t *testing.T) {
+	tool := mcp.NewTool("demo",
+		mcp.WithString("user_id", mcp.Required(), mcp.MinLength(1)),
+		mcp.WithString("term", mcp.Pattern(`^\d{4}0[12]$`)),
+		mcp.WithString("mode", mcp.Enum("overwrite", "append"), mcp.DefaultString("overwrite")),
+		mcp.WithNumber("count", mcp.Min(1), mcp.Max(10)),
+		mcp.WithBoolean("dry_run"),
+		mcp.WithArray("tags", mcp.WithStringItems()),
+		mcp.WithObject("vars"),
+	)
+	tool.InputSchema.Properties["order"] = map[string]any{"type": "integer"}
+
+	Convey("Validate", t, func() {
+		Convey("coerces common model mistakes", func() {
+			out, err := Validate(tool.InputSchema, map[string]any{
+				"user_id": float64(42),
+				"count":   "3",
+				"dry_run": "true",
+				"tags":    `["a","b"]`,
+				"vars":    `{"a": 2}`,
+				"order":   "2",
+				"term":    nil,
+			})
+			So(err, ShouldBeNil)
+			So(out["user_id"], ShouldEqual, "42")
+			So(out["count"], ShouldEqual, 3.0)
+			So(out["dry_run"], ShouldBeTrue)
+			So(out["tags"], ShouldResemble, []any{"a", "b"})
+			So(out["vars"], ShouldResemble, map[string]any{"a": 2.0})
+			So(out["order"], ShouldEqual, 2.0)
+			So(out["mode"], ShouldEqual, "overwrite")
+			So(out, ShouldNotContainKey, "term")
+		})
+
+		Convey("wraps a single value into an array", func() {
+			out, err := Validate(tool.InputSchema, map[string]any{"user_id": "u", "tags": "solo"})
+			So(err, ShouldBeNil)
+			So(out["tags"], ShouldResemble, []any{"solo"})
+		})
+
+		Convey("reports every problem", func() {
+			_, err := Validate(tool.InputSchema, map[string]any{
+				"user_id": "",
+				"term":    "2025",
+				"mode":    "delete",
+				"count":   float64(11),
+				"dry_run": "maybe",
+				"order":   1.5,
+			})
+			So(err, ShouldHaveSameTypeAs, &ValidationError{})
+			problems := err.(*ValidationError).Problems
+			So(problems, ShouldHaveLength, 6)
+			So(err.Error(), ShouldContainSubstring, "count: must be <= 10")
+			So(err.Error(), ShouldContainSubstring, "mode: must be one of [overwrite append]")
+			So(err.Error(), ShouldContainSubstring, "order: expected integer, got number")
+			So(err.Error(), ShouldContainSubstring, "user_id: length must be >= 1")
+		})
+
+		Convey("requires required arguments", func() {
+			_, err := Validate(tool.InputSchema, nil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "invalid arguments: user_id: missing required argument")
+		})
+
+		Convey("validates array items with their index", func() {
+			schema := mcp.NewTool("n", mcp.WithArray("xs", mcp.Items(map[string]any{"type": "number"}))).InputSchema
+			_, err := Validate(schema, map[string]any{"xs": []any{1.0, "two"}})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "xs[1]: expected number, got string")
+		})
+	})
+
+	Convey("WithValidation and Typed", t, func() {
+		type args struct {
+			UserID string  `json:"user_id"`
+			Count  float64 `json:"count"`
+		}
+		var got args
+		handler := WithValidation(tool, Typed(func(ctx context.Context, req mcp.CallToolRequest, a args) (*mcp.CallToolResult, error) {
+			got = a
+			return mcp.NewToolResultText("ok"), nil
+		}))
+		call := func(a map[string]any) *mcp.CallToolResult {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = a
+			res, err := handler(context.Background(), req)
+			So(err, ShouldBeNil)
+			return res
+		}
+
+		res := call(map[string]any{"user_id": "u1", "count": "5"})
+		So(res.IsError, ShouldBeFalse)
+		So(got, ShouldResemble, args{UserID: "u1", Count: 5})
+
+		res = call(map[string]any{"count": "five"})
+		So(res.IsError, ShouldBeTrue)
+		So(res.Content[0].(mcp.TextContent).Text, ShouldStartWith, "invalid arguments: ")
+	})
+}
@@ -0,0 +1,51 @@
+package websearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// bing 调用 Bing Web Search v7 或兼容其响应格式的接口
+type bing struct {
+	opts Options
+}
+
+func (b *bing) Name() string { return "bing" }
+
+func (b *bing) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	limit = clampLimit(limit, b.opts.MaxResults)
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("count", strconv.Itoa(limit))
+	params.Set("textFormat", "Raw")
+	header := http.Header{}
+	header.Set("Ocp-Apim-Subscription-Key", b.opts.APIKey)
+	body, err := doGet(ctx, b.opts.Client, b.opts.Endpoint+"?"+params.Encode(), header)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		WebPages struct {
+			Value []struct {
+				Name    string `json:"name"`
+				URL     string `json:"url"`
+				Snippet string `json:"snippet"`
+			} `json:"value"`
+		} `json:"webPages"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("websearch: decode bing response: %w", err)
+	}
+	out := make([]Result, 0, limit)
+	for _, r := range data.WebPages.Value {
+		if len(out) >= limit {
+			break
+		}
+		out = append(out, Result{Title: r.Name, URL: r.URL, Snippet: r.Snippet})
+	}
+	return out, nil
+}
@@ -0,0 +1,90 @@
+package websearch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// duckDuckGo 解析 DuckDuckGo 的 HTML 版结果页。Instant Answer API 只覆盖百科类词条，大部分查询没有结果
+type duckDuckGo struct {
+	opts Options
+}
+
+func (d *duckDuckGo) Name() string { return "duckduckgo" }
+
+func (d *duckDuckGo) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	limit = clampLimit(limit, d.opts.MaxResults)
+	params := url.Values{}
+	params.Set("q", query)
+	body, err := doGet(ctx, d.opts.Client, d.opts.Endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("websearch: parse duckduckgo page: %w", err)
+	}
+
+	out := make([]Result, 0, limit)
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if len(out) >= limit {
+			return
+		}
+		// 每条结果是 div.result，广告带 result--ad
+		if n.Type == html.ElementNode && hasClass(n, "result") && !hasClass(n, "result--ad") {
+			if r, ok := parseDuckDuckGoResult(n); ok {
+				out = append(out, r)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return out, nil
+}
+
+func parseDuckDuckGoResult(n *html.Node) (Result, bool) {
+	var r Result
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch {
+			case hasClass(n, "result__a") && r.URL == "":
+				r.Title = collapseSpace(textOf(n))
+				r.URL = unwrapDuckDuckGoURL(attr(n, "href"))
+				return
+			case hasClass(n, "result__snippet") && r.Snippet == "":
+				r.Snippet = collapseSpace(textOf(n))
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return r, r.URL != ""
+}
+
+// unwrapDuckDuckGoURL 结果链接形如 //duckduckgo.com/l/?uddg=<目标地址>，取出真实地址
+func unwrapDuckDuckGoURL(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	if target := u.Query().Get("uddg"); target != "" && strings.HasSuffix(u.Path, "/l/") {
+		return target
+	}
+	if u.Scheme == "" && strings.HasPrefix(href, "//") {
+		return "https:" + href
+	}
+	return href
+}
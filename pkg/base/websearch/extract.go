@@ -0,0 +1,389 @@
+package websearch
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	// 与 readability 相同思路：class/id 命中 positive 的容器加分，命中 negative 的减分或直接丢弃
+	positiveHint = regexp.MustCompile(`(?i)article|body|content|entry|main|page|post|text|blog|story`)
+	negativeHint = regexp.MustCompile(`(?i)comment|sidebar|footer|footnote|nav|menu|banner|breadcrumb|share|social|sponsor|promo|popup|cookie|related|advert|\bads?\b`)
+)
+
+// noiseTags 提取正文前整体移除的元素
+var noiseTags = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Svg: true, atom.Iframe: true,
+	atom.Nav: true, atom.Header: true, atom.Footer: true, atom.Aside: true, atom.Form: true,
+	atom.Button: true, atom.Template: true, atom.Canvas: true, atom.Select: true, atom.Object: true,
+}
+
+// blockTags 渲染为文本时前后换行的元素
+var blockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Main: true,
+	atom.Blockquote: true, atom.Pre: true, atom.Ul: true, atom.Ol: true, atom.Table: true,
+	atom.Tr: true, atom.Dl: true, atom.Dt: true, atom.Dd: true, atom.Figure: true, atom.Figcaption: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true, atom.Hr: true,
+}
+
+// Extract 从 HTML 文档中提取标题与正文：移除脚本、导航等噪声后，按段落文本量为容器打分，
+// 取得分最高（并按链接密度折算）的容器渲染为纯文本，标题、列表与代码块保留基本结构
+func Extract(doc *html.Node) (title, text string) {
+	title = pageTitle(doc)
+	body := findFirst(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+	removeNoise(body)
+	best := bestCandidate(body)
+	if best == nil {
+		best = body
+	}
+	return title, renderText(best)
+}
+
+// pageTitle 依次取 og:title、<title>、第一个 <h1>
+func pageTitle(doc *html.Node) string {
+	var ogTitle, titleTag string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.Meta:
+				if ogTitle == "" && (attr(n, "property") == "og:title" || attr(n, "name") == "og:title") {
+					ogTitle = collapseSpace(attr(n, "content"))
+				}
+			case atom.Title:
+				if titleTag == "" {
+					titleTag = collapseSpace(textOf(n))
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	switch {
+	case ogTitle != "":
+		return ogTitle
+	case titleTag != "":
+		return titleTag
+	}
+	if h1 := findFirst(doc, atom.H1); h1 != nil {
+		return collapseSpace(textOf(h1))
+	}
+	return ""
+}
+
+func removeNoise(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		switch {
+		case c.Type == html.CommentNode:
+			n.RemoveChild(c)
+		case c.Type == html.ElementNode && (noiseTags[c.DataAtom] || isHidden(c) || isNegative(c)):
+			n.RemoveChild(c)
+		default:
+			removeNoise(c)
+		}
+		c = next
+	}
+}
+
+func isHidden(n *html.Node) bool {
+	if _, ok := attrOK(n, "hidden"); ok || attr(n, "aria-hidden") == "true" {
+		return true
+	}
+	style := strings.ReplaceAll(strings.ToLower(attr(n, "style")), " ", "")
+	return strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden")
+}
+
+// isNegative class/id 明显是非正文区域且不带正文特征的容器
+func isNegative(n *html.Node) bool {
+	if n.DataAtom == atom.Body || n.DataAtom == atom.Article || n.DataAtom == atom.Main {
+		return false
+	}
+	hint := attr(n, "class") + " " + attr(n, "id")
+	return negativeHint.MatchString(hint) && !positiveHint.MatchString(hint)
+}
+
+// bestCandidate 每个足够长的段落为父容器加分、为祖父容器加一半分，返回折算链接密度后得分最高的容器
+func bestCandidate(root *html.Node) *html.Node {
+	scores := make(map[*html.Node]float64)
+	addScore := func(n *html.Node, s float64) {
+		if n == nil || n.Type != html.ElementNode {
+			return
+		}
+		if _, ok := scores[n]; !ok {
+			scores[n] = initialScore(n)
+		}
+		scores[n] += s
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.P, atom.Pre, atom.Td, atom.Blockquote, atom.Li:
+				text := collapseSpace(textOf(n))
+				if runes := utf8.RuneCountInString(text); runes >= constant.WebFetchMinParagraphRune {
+					// 基础分 + 逗号数 + 每 100 字 1 分（最多 3 分）
+					score := 1 + float64(strings.Count(text, ",")+strings.Count(text, "，")) + min(float64(runes)/100, 3)
+					addScore(n.Parent, score)
+					if n.Parent != nil {
+						addScore(n.Parent.Parent, score/2)
+					}
+				}
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+
+	var (
+		best      *html.Node
+		bestScore float64
+	)
+	for n, s := range scores {
+		s *= 1 - linkDensity(n)
+		if best == nil || s > bestScore {
+			best, bestScore = n, s
+		}
+	}
+	return best
+}
+
+func initialScore(n *html.Node) float64 {
+	var s float64
+	switch n.DataAtom {
+	case atom.Article, atom.Main:
+		s = 10
+	case atom.Div:
+		s = 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		s = 3
+	case atom.Ol, atom.Ul, atom.Dl, atom.Form:
+		s = -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		s = -5
+	}
+	hint := attr(n, "class") + " " + attr(n, "id")
+	if positiveHint.MatchString(hint) {
+		s += 25
+	}
+	if negativeHint.MatchString(hint) {
+		s -= 25
+	}
+	return s
+}
+
+// linkDensity 链接文字占全部文字的比例，导航、目录类容器接近 1
+func linkDensity(n *html.Node) float64 {
+	total := utf8.RuneCountInString(collapseSpace(textOf(n)))
+	if total == 0 {
+		return 0
+	}
+	var linked int
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.A {
+			linked += utf8.RuneCountInString(collapseSpace(textOf(n)))
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return float64(linked) / float64(total)
+}
+
+// textWriter 把 DOM 渲染为纯文本：行内空白合并为一个空格，块级元素之间换行
+type textWriter struct {
+	b        strings.Builder
+	newlines int  // 末尾连续的换行数
+	space    bool // 末尾有待输出的空格
+}
+
+func (w *textWriter) text(s string) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		if s != "" {
+			w.space = true
+		}
+		return
+	}
+	if (w.space || startsWithSpace(s)) && w.newlines == 0 && w.b.Len() > 0 {
+		w.b.WriteByte(' ')
+	}
+	w.b.WriteString(strings.Join(fields, " "))
+	w.newlines = 0
+	w.space = endsWithSpace(s)
+}
+
+func (w *textWriter) raw(s string) {
+	if s == "" {
+		return
+	}
+	w.b.WriteString(s)
+	w.newlines = len(s) - len(strings.TrimRight(s, "\n"))
+	w.space = false
+}
+
+func (w *textWriter) lineBreak(n int) {
+	w.space = false
+	if w.b.Len() == 0 {
+		return
+	}
+	for ; w.newlines < n; w.newlines++ {
+		w.b.WriteByte('\n')
+	}
+}
+
+func renderText(root *html.Node) string {
+	w := &textWriter{}
+	var walk func(n *html.Node, pre bool)
+	walk = func(n *html.Node, pre bool) {
+		switch n.Type {
+		case html.TextNode:
+			if pre {
+				w.raw(n.Data)
+			} else {
+				w.text(n.Data)
+			}
+			return
+		case html.ElementNode:
+		default:
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				walk(c, pre)
+			}
+			return
+		}
+
+		switch n.DataAtom {
+		case atom.Br:
+			w.lineBreak(1)
+			return
+		case atom.Img:
+			if alt := collapseSpace(attr(n, "alt")); alt != "" {
+				w.text("[image: " + alt + "]")
+			}
+			return
+		case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+			w.lineBreak(2)
+			w.raw(strings.Repeat("#", int(n.Data[1]-'0')) + " ")
+			w.text(textOf(n))
+			w.lineBreak(2)
+			return
+		case atom.Li:
+			w.lineBreak(1)
+			w.raw("- ")
+		case atom.Pre:
+			w.lineBreak(2)
+			w.raw("```\n")
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				walk(c, true)
+			}
+			w.lineBreak(1)
+			w.raw("```")
+			w.lineBreak(2)
+			return
+		case atom.Td, atom.Th:
+			w.text(" | ")
+		}
+
+		block := blockTags[n.DataAtom]
+		if block {
+			w.lineBreak(blockGap(n))
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, pre)
+		}
+		if block || n.DataAtom == atom.Li {
+			w.lineBreak(blockGap(n))
+		}
+	}
+	walk(root, false)
+	return strings.TrimSpace(w.b.String())
+}
+
+// blockGap 段落级元素之间空一行，列表项、表格行等只换行
+func blockGap(n *html.Node) int {
+	switch n.DataAtom {
+	case atom.Li, atom.Tr, atom.Dt, atom.Dd, atom.Div:
+		return 1
+	}
+	return 2
+}
+
+func findFirst(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findFirst(c, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func textOf(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func attrOK(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attr(n *html.Node, key string) string {
+	v, _ := attrOK(n, key)
+	return v
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func startsWithSpace(s string) bool {
+	return s != "" && strings.TrimLeft(s, " \t\r\n\f") != s
+}
+
+func endsWithSpace(s string) bool {
+	return s != "" && strings.TrimRight(s, " \t\r\n\f") != s
+}
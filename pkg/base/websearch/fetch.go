@@ -0,0 +1,272 @@
+package websearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+var (
+	ErrBadURL             = errors.New("websearch: invalid url")
+	ErrDomainNotAllowed   = errors.New("websearch: domain is not allowed")
+	ErrPrivateAddress     = errors.New("websearch: refusing to fetch a private or loopback address")
+	ErrUnsupportedContent = errors.New("websearch: unsupported content type")
+)
+
+// Page 抓取并提取正文后的网页
+type Page struct {
+	URL         string `json:"url"` // 跟随重定向后的最终地址
+	Title       string `json:"title"`
+	Text        string `json:"text"`
+	ContentType string `json:"content_type"`
+	Truncated   bool   `json:"truncated"` // 响应体超过 MaxBytes 或正文超过 MaxChars
+	Cached      bool   `json:"-"`
+}
+
+// FetchOptions 抓取限制，零值字段使用默认值
+type FetchOptions struct {
+	Timeout        time.Duration
+	MaxBytes       int64
+	MaxChars       int
+	AllowedDomains []string
+	BlockedDomains []string
+	AllowPrivate   bool
+	CacheTTL       time.Duration // 负数表示不缓存
+}
+
+// Fetcher 下载网页并提取可读正文，结果按 URL 缓存在 Redis 中
+type Fetcher struct {
+	opts   FetchOptions
+	client *http.Client
+	cache  *redis.Client
+}
+
+// NewFetcher 按 mcp.web_search.fetch 配置创建 Fetcher，cache 为 nil 时不缓存
+func NewFetcher(cache *redis.Client) *Fetcher {
+	fc := config.MCP.WebSearch.Fetch
+	return newFetcher(FetchOptions{
+		Timeout:        fc.Timeout,
+		MaxBytes:       fc.MaxBytes,
+		MaxChars:       fc.MaxChars,
+		AllowedDomains: fc.AllowedDomains,
+		BlockedDomains: fc.BlockedDomains,
+		AllowPrivate:   fc.AllowPrivate,
+		CacheTTL:       fc.CacheTTL,
+	}, cache)
+}
+
+func newFetcher(opts FetchOptions, cache *redis.Client) *Fetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = constant.WebFetchDefaultTimeout
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = constant.WebFetchDefaultMaxBytes
+	}
+	if opts.MaxChars <= 0 {
+		opts.MaxChars = constant.WebFetchDefaultMaxChars
+	}
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = constant.WebFetchDefaultCacheTTL
+	}
+	f := &Fetcher{opts: opts, cache: cache}
+
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivate {
+		// 在建立连接时校验解析后的 IP，域名解析到内网（含 DNS rebinding）同样会被拒绝
+		dialer.Control = utils.PublicDialControl(ErrPrivateAddress)
+	}
+	f.client = &http.Client{
+		Timeout: opts.Timeout,
+		Transport: &http.Transport{
+			// 不走环境代理，否则连接的是代理地址，无法校验目标地址
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: opts.Timeout,
+			MaxIdleConns:        16,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= constant.WebFetchMaxRedirects {
+				return fmt.Errorf("websearch: stopped after %d redirects", constant.WebFetchMaxRedirects)
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+// MaxChars 单次返回正文的字符数上限
+func (f *Fetcher) MaxChars() int {
+	return f.opts.MaxChars
+}
+
+// Fetch 抓取 rawURL 并提取正文。HTML 做正文提取，text/*、JSON 等文本原样返回，其余类型拒绝
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Page, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadURL, err)
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+	key := constant.WebFetchCacheKeyPrefix + hashURL(u.String())
+	if page, ok := f.cached(ctx, key); ok {
+		return page, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadURL, err)
+	}
+	req.Header.Set("User-Agent", constant.WebSearchUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain;q=0.9,*/*;q=0.1")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("websearch: %s returned %s", resp.Request.URL, resp.Status)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	isHTML := mediaType == "text/html" || mediaType == "application/xhtml+xml"
+	if !isHTML && !isTextual(mediaType) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContent, contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.opts.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	page := &Page{URL: resp.Request.URL.String(), ContentType: mediaType}
+	if int64(len(body)) > f.opts.MaxBytes {
+		body = body[:f.opts.MaxBytes]
+		page.Truncated = true
+	}
+	// 按 Content-Type / <meta charset> 转为 UTF-8（如 GBK 页面）
+	if r, err := charset.NewReader(bytes.NewReader(body), contentType); err == nil {
+		if decoded, err := io.ReadAll(r); err == nil {
+			body = decoded
+		}
+	}
+
+	if isHTML {
+		doc, err := html.Parse(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("websearch: parse html: %w", err)
+		}
+		page.Title, page.Text = Extract(doc)
+	} else {
+		page.Text = strings.TrimSpace(strings.ToValidUTF8(string(body), ""))
+	}
+	if utf8.RuneCountInString(page.Text) > f.opts.MaxChars {
+		page.Text = string([]rune(page.Text)[:f.opts.MaxChars])
+		page.Truncated = true
+	}
+
+	f.store(ctx, key, page)
+	return page, nil
+}
+
+// checkURL 校验协议、域名白/黑名单，以及字面量 IP 是否为内网地址
+func (f *Fetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: only http and https are supported", ErrBadURL)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("%w: missing host", ErrBadURL)
+	}
+	if matchDomain(host, f.opts.BlockedDomains) {
+		return fmt.Errorf("%w: %s is blocked", ErrDomainNotAllowed, host)
+	}
+	if len(f.opts.AllowedDomains) > 0 && !matchDomain(host, f.opts.AllowedDomains) {
+		return fmt.Errorf("%w: %s is not in the allow list", ErrDomainNotAllowed, host)
+	}
+	if !f.opts.AllowPrivate {
+		if ip := net.ParseIP(host); ip != nil && utils.IsPrivateIP(ip) {
+			return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+		}
+		if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+			return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+		}
+	}
+	return nil
+}
+
+func (f *Fetcher) cached(ctx context.Context, key string) (*Page, bool) {
+	if f.cache == nil || f.opts.CacheTTL < 0 {
+		return nil, false
+	}
+	data, err := f.cache.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.Warnf("websearch: read fetch cache: %v", err)
+		}
+		return nil, false
+	}
+	var page Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, false
+	}
+	page.Cached = true
+	return &page, true
+}
+
+func (f *Fetcher) store(ctx context.Context, key string, page *Page) {
+	if f.cache == nil || f.opts.CacheTTL < 0 {
+		return
+	}
+	data, _ := json.Marshal(page)
+	if err := f.cache.Set(ctx, key, data, f.opts.CacheTTL).Err(); err != nil {
+		logger.Warnf("websearch: write fetch cache: %v", err)
+	}
+}
+
+// matchDomain host 等于某个域名或是其子域名
+func matchDomain(host string, domains []string) bool {
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(d), "*"), "."))
+		if d != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTextual(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"):
+		return true
+	case mediaType == "application/json", strings.HasSuffix(mediaType, "+json"),
+		mediaType == "application/xml", strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+	return false
+}
+
+func hashURL(u string) string {
+	sum := sha256.Sum256([]byte(u))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,45 @@
+package websearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// searXNG 调用 SearXNG 实例的 /search?format=json（实例需在 settings.yml 中启用 json 格式）
+type searXNG struct {
+	opts Options
+}
+
+func (s *searXNG) Name() string { return "searxng" }
+
+func (s *searXNG) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	limit = clampLimit(limit, s.opts.MaxResults)
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("format", "json")
+	body, err := doGet(ctx, s.opts.Client, strings.TrimSuffix(s.opts.Endpoint, "/")+"/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Results []struct {
+			Title   string `json:"title"`
+			URL     string `json:"url"`
+			Content string `json:"content"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("websearch: decode searxng response: %w", err)
+	}
+	out := make([]Result, 0, limit)
+	for _, r := range data.Results {
+		if len(out) >= limit {
+			break
+		}
+		out = append(out, Result{Title: r.Title, URL: r.URL, Snippet: r.Content})
+	}
+	return out, nil
+}
@@ -0,0 +1,117 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// Result 一条搜索结果
+type Result struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Snippet string `json:"snippet"`
+}
+
+// SearchProvider 抽象搜索后端（可扩展 duckduckgo/searxng/bing/...）
+type SearchProvider interface {
+	// Name provider 名称，用于日志与工具输出
+	Name() string
+	// Search 返回最多 limit 条结果，没有结果时返回空切片而不是错误
+	Search(ctx context.Context, query string, limit int) ([]Result, error)
+}
+
+// Options 搜索后端配置，零值字段使用默认值
+type Options struct {
+	Provider   string
+	Endpoint   string
+	APIKey     string
+	MaxResults int
+	Timeout    time.Duration
+	Client     *http.Client // 为空时按 Timeout 创建
+}
+
+// NewProvider 按 mcp.web_search 配置创建搜索后端
+func NewProvider() (SearchProvider, error) {
+	ws := config.MCP.WebSearch
+	return newProvider(Options{
+		Provider:   ws.Provider,
+		Endpoint:   ws.Endpoint,
+		APIKey:     ws.APIKey,
+		MaxResults: ws.MaxResults,
+		Timeout:    ws.Timeout,
+	})
+}
+
+func newProvider(opts Options) (SearchProvider, error) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = constant.WebSearchDefaultTimeout
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: opts.Timeout}
+	}
+	switch strings.ToLower(opts.Provider) {
+	case constant.WebSearchProviderDuckDuckGo, "":
+		if opts.Endpoint == "" {
+			opts.Endpoint = constant.WebSearchDuckDuckGoEndpoint
+		}
+		return &duckDuckGo{opts: opts}, nil
+	case constant.WebSearchProviderSearXNG:
+		if opts.Endpoint == "" {
+			return nil, fmt.Errorf("websearch: searxng requires an endpoint")
+		}
+		return &searXNG{opts: opts}, nil
+	case constant.WebSearchProviderBing:
+		if opts.Endpoint == "" {
+			opts.Endpoint = constant.WebSearchBingEndpoint
+		}
+		if opts.APIKey == "" {
+			return nil, fmt.Errorf("websearch: bing requires an api_key")
+		}
+		return &bing{opts: opts}, nil
+	default:
+		return nil, fmt.Errorf("websearch: unknown provider %q", opts.Provider)
+	}
+}
+
+// clampLimit 把调用方给出的条数限制在 [1, constant.WebSearchMaxResultsLimit]，未给出时取配置值
+func clampLimit(limit, configured int) int {
+	if limit <= 0 {
+		limit = configured
+	}
+	if limit <= 0 {
+		limit = constant.WebSearchDefaultMaxResults
+	}
+	return min(limit, constant.WebSearchMaxResultsLimit)
+}
+
+// doGet 发送 GET 请求并返回响应体，非 2xx 状态码作为错误返回
+func doGet(ctx context.Context, client *http.Client, rawURL string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", constant.WebSearchUserAgent)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, constant.WebFetchDefaultMaxBytes))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("websearch: %s returned %s", req.URL.Host, resp.Status)
+	}
+	return body, nil
+}
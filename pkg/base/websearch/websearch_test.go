@@ -0,0 +1,192 @@
+package websearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const ddgPage = `<html><body>
+<div class="result results_links result--ad"><a class="result__a" href="https://ads.example.com">Ad</a></div>
+<div class="result results_links">
+  <h2><a class="result__a" href="//duckduckgo.com/l/?uddg=https%3A%2F%2Fgo.dev%2Fdoc%2F&rut=x">The <b>Go</b> Programming Language</a></h2>
+  <a class="result__snippet" href="#">Documentation for   the Go language.</a>
+</div>
+<div class="result results_links">
+  <h2><a class="result__a" href="https://pkg.go.dev/">Go Packages</a></h2>
+  <a class="result__snippet" href="#">Search packages.</a>
+</div>
+</body></html>`
+
+const articlePage = `<html><head><title>Plain title</title><meta property="og:title" content="Readable Title"></head>
+<body>
+<nav><a href="/">Home</a> <a href="/a">About</a></nav>
+<div class="sidebar"><p>Subscribe to our newsletter, it is great, really, you will love it.</p></div>
+<div class="menu-links"><a href="/1">One link that is long enough to count</a></div>
+<article class="post">
+  <h2>Getting started</h2>
+  <p>Go is an open source programming language that makes it simple to build secure, scalable systems.</p>
+  <p>It was designed at Google, and it is used by many companies, large and small, around the world.</p>
+  <ul><li>Fast builds</li><li>Static binaries</li></ul>
+  <pre>func main() {
+	fmt.Println("hi")
+}</pre>
+  <script>alert("x")</script>
+</article>
+<footer>Copyright 2026, all rights reserved, do not copy this text anywhere.</footer>
+</body></html>`
+
+func TestSearchProviders(t *testing.T) {
+	Convey("SearchProvider implementations", t, func() {
+		var gotQuery url.Values
+		var gotHeader http.Header
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotQuery, gotHeader = r.URL.Query(), r.Header
+			switch r.URL.Path {
+			case "/html/":
+				fmt.Fprint(w, ddgPage)
+			case "/search":
+				fmt.Fprint(w, `{"results":[{"title":"A","url":"https://a.example","content":"alpha"},{"title":"B","url":"https://b.example","content":"beta"}]}`)
+			case "/v7.0/search":
+				fmt.Fprint(w, `{"webPages":{"value":[{"name":"Bing A","url":"https://a.example","snippet":"first"}]}}`)
+			default:
+				http.Error(w, "nope", http.StatusTeapot)
+			}
+		}))
+		defer srv.Close()
+		ctx := context.Background()
+
+		Convey("duckduckgo parses the HTML page, skips ads and unwraps redirect links", func() {
+			p, err := newProvider(Options{Provider: "duckduckgo", Endpoint: srv.URL + "/html/"})
+			So(err, ShouldBeNil)
+			res, err := p.Search(ctx, "golang", 5)
+			So(err, ShouldBeNil)
+			So(gotQuery.Get("q"), ShouldEqual, "golang")
+			So(res, ShouldResemble, []Result{
+				{Title: "The Go Programming Language", URL: "https://go.dev/doc/", Snippet: "Documentation for the Go language."},
+				{Title: "Go Packages", URL: "https://pkg.go.dev/", Snippet: "Search packages."},
+			})
+			res, err = p.Search(ctx, "golang", 1)
+			So(err, ShouldBeNil)
+			So(len(res), ShouldEqual, 1)
+		})
+
+		Convey("searxng reads the JSON API", func() {
+			p, err := newProvider(Options{Provider: "searxng", Endpoint: srv.URL + "/", MaxResults: 1})
+			So(err, ShouldBeNil)
+			res, err := p.Search(ctx, "q", 0)
+			So(err, ShouldBeNil)
+			So(gotQuery.Get("format"), ShouldEqual, "json")
+			So(res, ShouldResemble, []Result{{Title: "A", URL: "https://a.example", Snippet: "alpha"}})
+		})
+
+		Convey("bing sends the subscription key", func() {
+			p, err := newProvider(Options{Provider: "bing", Endpoint: srv.URL + "/v7.0/search", APIKey: "k"})
+			So(err, ShouldBeNil)
+			res, err := p.Search(ctx, "q", 3)
+			So(err, ShouldBeNil)
+			So(gotHeader.Get("Ocp-Apim-Subscription-Key"), ShouldEqual, "k")
+			So(gotQuery.Get("count"), ShouldEqual, "3")
+			So(res[0].Title, ShouldEqual, "Bing A")
+		})
+
+		Convey("misconfiguration and HTTP errors are reported", func() {
+			_, err := newProvider(Options{Provider: "searxng"})
+			So(err, ShouldNotBeNil)
+			_, err = newProvider(Options{Provider: "bing"})
+			So(err, ShouldNotBeNil)
+			_, err = newProvider(Options{Provider: "altavista"})
+			So(err, ShouldNotBeNil)
+			p, _ := newProvider(Options{Provider: "searxng", Endpoint: srv.URL + "/missing"})
+			_, err = p.Search(ctx, "q", 1)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFetcher(t *testing.T) {
+	Convey("Fetcher", t, func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprint(w, articlePage)
+		})
+		mux.HandleFunc("/gbk", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=gbk")
+			// "<p>中文</p>" 的 GBK 编码
+			_, _ = w.Write([]byte("<html><body><p>\xd6\xd0\xce\xc4</p></body></html>"))
+		})
+		mux.HandleFunc("/big.txt", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, strings.Repeat("a", 1000))
+		})
+		mux.HandleFunc("/image.png", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte{0x89, 'P', 'N', 'G'})
+		})
+		mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://blocked.example/", http.StatusFound)
+		})
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+		ctx := context.Background()
+		f := newFetcher(FetchOptions{AllowPrivate: true, BlockedDomains: []string{"blocked.example"}}, nil)
+
+		Convey("extracts the article and drops navigation, sidebars and scripts", func() {
+			page, err := f.Fetch(ctx, srv.URL+"/article")
+			So(err, ShouldBeNil)
+			So(page.Title, ShouldEqual, "Readable Title")
+			So(page.ContentType, ShouldEqual, "text/html")
+			So(page.Text, ShouldStartWith, "## Getting started")
+			So(page.Text, ShouldContainSubstring, "Go is an open source programming language")
+			So(page.Text, ShouldContainSubstring, "- Fast builds\n- Static binaries")
+			So(page.Text, ShouldContainSubstring, "```\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n```")
+			for _, noise := range []string{"Home", "newsletter", "alert", "Copyright", "One link"} {
+				So(page.Text, ShouldNotContainSubstring, noise)
+			}
+		})
+
+		Convey("decodes legacy charsets", func() {
+			page, err := f.Fetch(ctx, srv.URL+"/gbk")
+			So(err, ShouldBeNil)
+			So(page.Text, ShouldEqual, "中文")
+		})
+
+		Convey("enforces size limits", func() {
+			small := newFetcher(FetchOptions{AllowPrivate: true, MaxBytes: 100, MaxChars: 10}, nil)
+			page, err := small.Fetch(ctx, srv.URL+"/big.txt")
+			So(err, ShouldBeNil)
+			So(page.Truncated, ShouldBeTrue)
+			So(page.Text, ShouldEqual, strings.Repeat("a", 10))
+		})
+
+		Convey("rejects unsupported content, private addresses and disallowed domains", func() {
+			_, err := f.Fetch(ctx, srv.URL+"/image.png")
+			So(errors.Is(err, ErrUnsupportedContent), ShouldBeTrue)
+
+			_, err = f.Fetch(ctx, srv.URL+"/redirect")
+			So(errors.Is(err, ErrDomainNotAllowed), ShouldBeTrue)
+
+			_, err = f.Fetch(ctx, "ftp://example.com/x")
+			So(errors.Is(err, ErrBadURL), ShouldBeTrue)
+
+			strict := newFetcher(FetchOptions{}, nil)
+			_, err = strict.Fetch(ctx, srv.URL+"/article")
+			So(errors.Is(err, ErrPrivateAddress), ShouldBeTrue)
+			_, err = strict.Fetch(ctx, "http://localhost/")
+			So(errors.Is(err, ErrPrivateAddress), ShouldBeTrue)
+
+			allowList := newFetcher(FetchOptions{AllowPrivate: true, AllowedDomains: []string{"go.dev"}}, nil)
+			_, err = allowList.Fetch(ctx, srv.URL+"/article")
+			So(errors.Is(err, ErrDomainNotAllowed), ShouldBeTrue)
+			So(allowList.checkURL(&url.URL{Scheme: "https", Host: "pkg.go.dev"}), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,167 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var ErrTooLarge = errors.New("workspace: content exceeds the write size limit")
+
+// ResolveForWrite 解析待写入的路径，文件可以尚不存在：
+// 最深的已存在祖先目录解析符号链接后必须位于工作区内，已存在的目标文件按 Resolve 的规则校验
+func (j *Jail) ResolveForWrite(p string) (string, error) {
+	if len(j.roots) == 0 {
+		return "", ErrNoRoots
+	}
+	if p == "" {
+		return "", fmt.Errorf("%w: empty path", ErrOutsideRoots)
+	}
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(j.roots[0], p)
+	}
+	p = filepath.Clean(p)
+	if _, err := os.Lstat(p); err == nil {
+		return j.Resolve(p)
+	}
+
+	// 目标不存在：找到最深的已存在祖先，其余部分原样拼接
+	existing, rest := p, []string{}
+	for {
+		if _, err := os.Lstat(existing); err == nil {
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return "", fmt.Errorf("%w: %s", ErrOutsideRoots, p)
+		}
+		rest = append([]string{filepath.Base(existing)}, rest...)
+		existing = parent
+	}
+	realDir, err := j.ResolveDir(existing)
+	if err != nil {
+		return "", err
+	}
+	abs := filepath.Join(append([]string{realDir}, rest...)...)
+	root, rel, ok := j.within(abs)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrOutsideRoots, p)
+	}
+	if err := j.checkDenied(root, rel); err != nil {
+		return "", err
+	}
+	return abs, nil
+}
+
+// Rel 返回相对所在工作区根目录的路径，用于展示与 diff 文件头
+func (j *Jail) Rel(abs string) string {
+	if _, rel, ok := j.within(abs); ok {
+		return rel
+	}
+	return abs
+}
+
+// Snapshot 写入前的文件状态，用于撤销
+type Snapshot struct {
+	Path    string
+	Existed bool
+	Content []byte
+	Mode    os.FileMode
+}
+
+// TakeSnapshot 记录 path 当前内容，文件不存在时 Existed 为 false
+func TakeSnapshot(path string) (Snapshot, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Snapshot{Path: path}, nil
+	}
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if info.IsDir() {
+		return Snapshot{}, fmt.Errorf("%w: %s is a directory", ErrNotADirectory, path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return Snapshot{Path: path, Existed: true, Content: content, Mode: info.Mode().Perm()}, nil
+}
+
+// Restore 恢复到快照时的状态：原本不存在的文件会被删除
+func (s Snapshot) Restore() error {
+	if !s.Existed {
+		if err := os.Remove(s.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return WriteFileAtomic(s.Path, s.Content, s.Mode)
+}
+
+// WriteFileAtomic 先写同目录下的临时文件再 rename，避免写到一半的文件被读到；必要时创建父目录
+func WriteFileAtomic(path string, content []byte, mode os.FileMode) error {
+	if len(content) > constant.WorkspaceMaxWriteBytes {
+		return fmt.Errorf("%w: %d > %d bytes", ErrTooLarge, len(content), constant.WorkspaceMaxWriteBytes)
+	}
+	if mode == 0 {
+		mode = 0o644
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// UnifiedDiff 生成 before -> after 的 unified diff，name 为展示用的相对路径；内容相同时返回空串
+func UnifiedDiff(name string, before, after string, existed bool) string {
+	from := "a/" + name
+	if !existed {
+		from = "/dev/null"
+	}
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: from,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	return diff
+}
+
+// splitLines 按行切分并保留换行符。difflib.SplitLines 会在末尾多出一个空行，这里不使用；
+// 缺少结尾换行的最后一行补上换行，并不输出 "\ No newline at end of file"
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] += "\n"
+	}
+	return lines
+}
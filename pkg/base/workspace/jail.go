@@ -0,0 +1,180 @@
+package workspace
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+var (
+	ErrNoRoots       = errors.New("workspace: no workspace roots configured")
+	ErrOutsideRoots  = errors.New("workspace: path is outside the workspace roots")
+	ErrDenied        = errors.New("workspace: path matches a denied pattern")
+	ErrBinaryFile    = errors.New("workspace: binary file")
+	ErrNotFound      = errors.New("workspace: path does not exist")
+	ErrNotADirectory = errors.New("workspace: not a directory")
+)
+
+// Jail 把开发工具的文件访问限制在若干工作区根目录内：
+// 路径解析符号链接后必须仍位于某个根目录下，且任何一级路径都不能命中敏感文件的 deny 规则
+type Jail struct {
+	roots []string
+	deny  []string
+}
+
+// NewJail 按 mcp.dev_runner 配置创建 Jail，deny 规则为默认规则与配置规则的并集
+func NewJail() *Jail {
+	return New(config.MCP.DevRunner.Roots, config.MCP.DevRunner.DenyPatterns)
+}
+
+// New 创建 Jail，无法解析的根目录会被忽略
+func New(roots []string, deny []string) *Jail {
+	j := &Jail{deny: append(append([]string{}, constant.WorkspaceDefaultDenyPatterns...), deny...)}
+	for _, root := range roots {
+		abs, err := realPath(root)
+		if err != nil {
+			logger.Warnf("workspace: skip root %s: %v", root, err)
+			continue
+		}
+		j.roots = append(j.roots, abs)
+	}
+	return j
+}
+
+// Roots 生效的工作区根目录（已解析为真实路径）
+func (j *Jail) Roots() []string {
+	return j.roots
+}
+
+// Resolve 把 p 解析为工作区内的真实路径。相对路径以第一个根目录为基准；
+// 符号链接逃逸、越出根目录或命中 deny 规则时返回错误，错误信息可直接展示给模型
+func (j *Jail) Resolve(p string) (string, error) {
+	if len(j.roots) == 0 {
+		return "", ErrNoRoots
+	}
+	if p == "" {
+		return "", fmt.Errorf("%w: empty path", ErrOutsideRoots)
+	}
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(j.roots[0], p)
+	}
+	// 先按字面路径检查 deny，避免通过符号链接改名绕过
+	if root, rel, ok := j.within(filepath.Clean(p)); ok {
+		if err := j.checkDenied(root, rel); err != nil {
+			return "", err
+		}
+	}
+	abs, err := realPath(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, p)
+		}
+		return "", err
+	}
+	root, rel, ok := j.within(abs)
+	if !ok {
+		return "", fmt.Errorf("%w: %s (roots: %s)", ErrOutsideRoots, p, strings.Join(j.roots, ", "))
+	}
+	if err := j.checkDenied(root, rel); err != nil {
+		return "", err
+	}
+	return abs, nil
+}
+
+// ResolveDir 同 Resolve，并要求结果是目录
+func (j *Jail) ResolveDir(p string) (string, error) {
+	abs, err := j.Resolve(p)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%w: %s", ErrNotADirectory, p)
+	}
+	return abs, nil
+}
+
+// Denied 目录遍历时判断某个条目是否应被隐藏
+func (j *Jail) Denied(abs string) bool {
+	root, rel, ok := j.within(abs)
+	if !ok {
+		return true
+	}
+	return j.checkDenied(root, rel) != nil
+}
+
+// within 返回 abs 所在的根目录及相对路径
+func (j *Jail) within(abs string) (string, string, bool) {
+	for _, root := range j.roots {
+		if abs == root {
+			return root, ".", true
+		}
+		if strings.HasPrefix(abs, root+string(filepath.Separator)) {
+			rel, _ := filepath.Rel(root, abs)
+			return root, rel, true
+		}
+	}
+	return "", "", false
+}
+
+// checkDenied 相对路径的每一级名称以及完整相对路径都不能命中 deny 规则
+func (j *Jail) checkDenied(root, rel string) error {
+	if rel == "." {
+		return nil
+	}
+	slashed := filepath.ToSlash(rel)
+	for _, pattern := range j.deny {
+		if ok, _ := filepath.Match(pattern, slashed); ok {
+			return fmt.Errorf("%w: %s (%s)", ErrDenied, filepath.Join(root, rel), pattern)
+		}
+		for _, name := range strings.Split(slashed, "/") {
+			if ok, _ := filepath.Match(pattern, name); ok {
+				return fmt.Errorf("%w: %s (%s)", ErrDenied, filepath.Join(root, rel), pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// IsBinary 读取文件头部判断是否为二进制文件：包含 NUL 字节或不是合法 UTF-8 即视为二进制
+func IsBinary(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	buf := make([]byte, constant.WorkspaceBinarySniffBytes)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false, err
+	}
+	buf = buf[:n]
+	if bytes.IndexByte(buf, 0) >= 0 {
+		return true, nil
+	}
+	// 截断处可能落在多字节字符中间，去掉末尾不完整的字符再校验
+	for i := 0; i < utf8.UTFMax && len(buf) > 0 && !utf8.Valid(buf); i++ {
+		buf = buf[:len(buf)-1]
+	}
+	return !utf8.Valid(buf), nil
+}
+
+func realPath(p string) (string, error) {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(abs)
+}
@@ -0,0 +1,82 @@
+package workspace
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJail(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	mustWrite := func(p string, data []byte) {
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustWrite(filepath.Join(root, "src", "main.go"), []byte("package main\n"))
+	mustWrite(filepath.Join(root, ".env"), []byte("SECRET=1\n"))
+	mustWrite(filepath.Join(root, "config", "config.yaml"), []byte("secret: 1\n"))
+	mustWrite(filepath.Join(root, "certs", "server.pem"), []byte("-----BEGIN-----\n"))
+	mustWrite(filepath.Join(root, "bin", "app"), []byte{0x7f, 'E', 'L', 'F', 0, 0, 1})
+	mustWrite(filepath.Join(root, "zh.txt"), []byte("你好，世界"))
+	mustWrite(filepath.Join(outside, "passwd"), []byte("root:x:0:0\n"))
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, ".env"), filepath.Join(root, "innocent.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	j := New([]string{root}, []string{"*.secret"})
+	realRoot := j.Roots()[0]
+
+	Convey("Jail.Resolve", t, func() {
+		Convey("resolves relative and absolute paths inside the roots", func() {
+			p, err := j.Resolve("src/main.go")
+			So(err, ShouldBeNil)
+			So(p, ShouldEqual, filepath.Join(realRoot, "src", "main.go"))
+			p, err = j.Resolve(filepath.Join(root, "src"))
+			So(err, ShouldBeNil)
+			So(p, ShouldEqual, filepath.Join(realRoot, "src"))
+		})
+		Convey("rejects paths outside the roots, including .. and symlink escapes", func() {
+			for _, p := range []string{"/etc/passwd", "../" + filepath.Base(outside) + "/passwd", "escape/passwd"} {
+				_, err := j.Resolve(p)
+				So(errors.Is(err, ErrOutsideRoots), ShouldBeTrue)
+			}
+		})
+		Convey("rejects sensitive files, also through symlinks and configured patterns", func() {
+			for _, p := range []string{".env", "config/config.yaml", "certs/server.pem", "innocent.txt"} {
+				_, err := j.Resolve(p)
+				So(errors.Is(err, ErrDenied), ShouldBeTrue)
+			}
+			mustWrite(filepath.Join(root, "db.secret"), []byte("x"))
+			_, err := j.Resolve("db.secret")
+			So(errors.Is(err, ErrDenied), ShouldBeTrue)
+			So(j.Denied(filepath.Join(realRoot, ".env")), ShouldBeTrue)
+			So(j.Denied(filepath.Join(realRoot, "src")), ShouldBeFalse)
+		})
+		Convey("reports missing paths and rejects everything without roots", func() {
+			_, err := j.Resolve("nope.go")
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+			_, err = New(nil, nil).Resolve(filepath.Join(root, "src"))
+			So(errors.Is(err, ErrNoRoots), ShouldBeTrue)
+		})
+	})
+
+	Convey("IsBinary", t, func() {
+		bin, err := IsBinary(filepath.Join(root, "bin", "app"))
+		So(err, ShouldBeNil)
+		So(bin, ShouldBeTrue)
+		bin, err = IsBinary(filepath.Join(root, "zh.txt"))
+		So(err, ShouldBeNil)
+		So(bin, ShouldBeFalse)
+	})
+}
@@ -0,0 +1,271 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrBadPatch = errors.New("workspace: malformed patch")
+
+// FilePatch unified diff 中单个文件的改动
+type FilePatch struct {
+	OldPath string // "/dev/null" 表示新建
+	NewPath string // "/dev/null" 表示删除
+	Hunks   []Hunk
+}
+
+// Hunk 一段改动，Lines 保留行首的 ' '、'-'、'+' 标记
+type Hunk struct {
+	OldStart int
+	Lines    []string
+	// 原文件/新文件在本段结尾处没有换行（"\ No newline at end of file"）
+	OldNoEOL bool
+	NewNoEOL bool
+}
+
+// Path 改动作用的文件（删除时为旧路径）
+func (fp *FilePatch) Path() string {
+	if fp.NewPath == devNull {
+		return fp.OldPath
+	}
+	return fp.NewPath
+}
+
+func (fp *FilePatch) IsCreate() bool { return fp.OldPath == devNull }
+func (fp *FilePatch) IsDelete() bool { return fp.NewPath == devNull }
+
+const devNull = "/dev/null"
+
+// ParsePatch 解析 unified diff（兼容 git diff 输出），可包含多个文件
+func ParsePatch(text string) ([]*FilePatch, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	var (
+		patches []*FilePatch
+		cur     *FilePatch
+	)
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "--- ") && i+1 < len(lines) && strings.HasPrefix(lines[i+1], "+++ "):
+			cur = &FilePatch{OldPath: patchPath(line[4:]), NewPath: patchPath(lines[i+1][4:])}
+			patches = append(patches, cur)
+			i++
+		case strings.HasPrefix(line, "@@"):
+			if cur == nil {
+				return nil, fmt.Errorf("%w: hunk before file header at line %d", ErrBadPatch, i+1)
+			}
+			h, next, err := parseHunk(lines, i)
+			if err != nil {
+				return nil, err
+			}
+			cur.Hunks = append(cur.Hunks, h)
+			i = next - 1
+		default:
+			// diff --git / index / new file mode 等头部信息忽略
+		}
+	}
+	if len(patches) == 0 {
+		return nil, fmt.Errorf("%w: no file header (---/+++) found", ErrBadPatch)
+	}
+	for _, p := range patches {
+		if p.OldPath == devNull && p.NewPath == devNull {
+			return nil, fmt.Errorf("%w: both paths are /dev/null", ErrBadPatch)
+		}
+		if len(p.Hunks) == 0 && !p.IsDelete() {
+			return nil, fmt.Errorf("%w: no hunks for %s", ErrBadPatch, p.Path())
+		}
+	}
+	return patches, nil
+}
+
+// patchPath 去掉 a/、b/ 前缀与可能存在的时间戳
+func patchPath(s string) string {
+	if i := strings.IndexByte(s, '\t'); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(s)
+	if s == devNull {
+		return s
+	}
+	if strings.HasPrefix(s, "a/") || strings.HasPrefix(s, "b/") {
+		return s[2:]
+	}
+	return s
+}
+
+// parseHunk 解析从 lines[start]（@@ 行）开始的一段，返回下一段的起始行号
+func parseHunk(lines []string, start int) (Hunk, int, error) {
+	oldStart, oldCount, newCount, err := parseHunkHeader(lines[start])
+	if err != nil {
+		return Hunk{}, 0, fmt.Errorf("%w: line %d: %v", ErrBadPatch, start+1, err)
+	}
+	h := Hunk{OldStart: oldStart}
+	oldSeen, newSeen := 0, 0
+	i := start + 1
+	for ; i < len(lines) && (oldSeen < oldCount || newSeen < newCount); i++ {
+		line := lines[i]
+		if line == "" {
+			// 部分编辑器会去掉空白上下文行的前导空格
+			line = " "
+		}
+		switch line[0] {
+		case ' ':
+			oldSeen++
+			newSeen++
+		case '-':
+			oldSeen++
+		case '+':
+			newSeen++
+		case '\\':
+			continue
+		default:
+			return Hunk{}, 0, fmt.Errorf("%w: line %d: unexpected %q in hunk", ErrBadPatch, i+1, line)
+		}
+		h.Lines = append(h.Lines, line)
+	}
+	if oldSeen != oldCount || newSeen != newCount {
+		return Hunk{}, 0, fmt.Errorf("%w: hunk at line %d is truncated (want -%d +%d, got -%d +%d)",
+			ErrBadPatch, start+1, oldCount, newCount, oldSeen, newSeen)
+	}
+	// 紧随其后的 "\ No newline at end of file" 作用于上一行
+	for ; i < len(lines) && strings.HasPrefix(lines[i], `\`); i++ {
+		switch h.Lines[len(h.Lines)-1][0] {
+		case '-':
+			h.OldNoEOL = true
+		case '+':
+			h.NewNoEOL = true
+		default:
+			h.OldNoEOL, h.NewNoEOL = true, true
+		}
+	}
+	return h, i, nil
+}
+
+// parseHunkHeader 解析 "@@ -l,s +l,s @@"，省略的长度为 1
+func parseHunkHeader(line string) (oldStart, oldCount, newCount int, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || fields[0] != "@@" || !strings.HasPrefix(fields[1], "-") || !strings.HasPrefix(fields[2], "+") {
+		return 0, 0, 0, fmt.Errorf("bad hunk header %q", line)
+	}
+	oldStart, oldCount, err = parseRange(fields[1][1:])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	_, newCount, err = parseRange(fields[2][1:])
+	return oldStart, oldCount, newCount, err
+}
+
+func parseRange(s string) (int, int, error) {
+	startStr, countStr, hasCount := strings.Cut(s, ",")
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad range %q", s)
+	}
+	count := 1
+	if hasCount {
+		if count, err = strconv.Atoi(countStr); err != nil {
+			return 0, 0, fmt.Errorf("bad range %q", s)
+		}
+	}
+	return start, count, nil
+}
+
+// Apply 把改动应用到原内容上。每段先在标注的行号处匹配，失败时在全文中就近查找上下文，
+// 都找不到时返回错误而不是部分应用
+func (fp *FilePatch) Apply(original string) (string, error) {
+	if fp.IsDelete() {
+		return "", nil
+	}
+	lines, eol := toLines(original)
+	offset := 0
+	for n, h := range fp.Hunks {
+		var oldLines, newLines []string
+		for _, l := range h.Lines {
+			switch l[0] {
+			case ' ':
+				oldLines = append(oldLines, l[1:])
+				newLines = append(newLines, l[1:])
+			case '-':
+				oldLines = append(oldLines, l[1:])
+			case '+':
+				newLines = append(newLines, l[1:])
+			}
+		}
+		want := h.OldStart - 1 + offset
+		if len(oldLines) == 0 {
+			// 纯新增：@@ -l,0 表示插入到第 l 行之后
+			want = h.OldStart + offset
+		}
+		pos := findLines(lines, oldLines, want)
+		if pos < 0 {
+			return "", fmt.Errorf("%w: hunk %d of %s does not apply (expected near line %d)", ErrBadPatch, n+1, fp.Path(), h.OldStart)
+		}
+		reachesEOF := pos+len(oldLines) == len(lines)
+		lines = append(lines[:pos], append(newLines, lines[pos+len(oldLines):]...)...)
+		offset += len(newLines) - len(oldLines)
+		if reachesEOF {
+			switch {
+			case h.NewNoEOL:
+				eol = false
+			case h.OldNoEOL || len(newLines) > 0:
+				eol = true
+			}
+		}
+	}
+	return fromLines(lines, eol), nil
+}
+
+// findLines 在 lines 中查找 want 出现的位置，优先 hint 处，其次离 hint 最近处
+func findLines(lines, want []string, hint int) int {
+	match := func(pos int) bool {
+		if pos < 0 || pos+len(want) > len(lines) {
+			return false
+		}
+		for i, w := range want {
+			if lines[pos+i] != w {
+				return false
+			}
+		}
+		return true
+	}
+	if hint < 0 {
+		hint = 0
+	}
+	if hint > len(lines) {
+		hint = len(lines)
+	}
+	if len(want) == 0 {
+		return hint
+	}
+	for d := 0; d <= len(lines); d++ {
+		if match(hint - d) {
+			return hint - d
+		}
+		if d > 0 && match(hint+d) {
+			return hint + d
+		}
+	}
+	return -1
+}
+
+// toLines 切分为不含换行符的行，eol 表示原内容是否以换行结尾
+func toLines(s string) ([]string, bool) {
+	if s == "" {
+		return nil, true
+	}
+	eol := strings.HasSuffix(s, "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n"), eol
+}
+
+func fromLines(lines []string, eol bool) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	s := strings.Join(lines, "\n")
+	if eol {
+		s += "\n"
+	}
+	return s
+}
@@ -0,0 +1,141 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPatch(t *testing.T) {
+	Convey("ParsePatch and Apply", t, func() {
+		original := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"
+
+		Convey("round-trips a diff produced by UnifiedDiff", func() {
+			after := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello\")\n\tfmt.Println(\"world\")\n}\n"
+			patches, err := ParsePatch(UnifiedDiff("main.go", original, after, true))
+			So(err, ShouldBeNil)
+			So(len(patches), ShouldEqual, 1)
+			So(patches[0].Path(), ShouldEqual, "main.go")
+			got, err := patches[0].Apply(original)
+			So(err, ShouldBeNil)
+			So(got, ShouldEqual, after)
+		})
+
+		Convey("finds hunks whose line numbers are off and handles multiple files", func() {
+			patch := "diff --git a/main.go b/main.go\n" +
+				"--- a/main.go\n+++ b/main.go\n" +
+				"@@ -10,3 +10,3 @@\n func main() {\n-\tfmt.Println(\"hi\")\n+\tfmt.Println(\"bye\")\n }\n" +
+				"--- /dev/null\n+++ b/README.md\n@@ -0,0 +1,2 @@\n+# demo\n+text\n"
+			patches, err := ParsePatch(patch)
+			So(err, ShouldBeNil)
+			So(len(patches), ShouldEqual, 2)
+			got, err := patches[0].Apply(original)
+			So(err, ShouldBeNil)
+			So(got, ShouldContainSubstring, "Println(\"bye\")")
+			So(patches[1].IsCreate(), ShouldBeTrue)
+			got, err = patches[1].Apply("")
+			So(err, ShouldBeNil)
+			So(got, ShouldEqual, "# demo\ntext\n")
+		})
+
+		Convey("honours missing newline at end of file", func() {
+			patch := "--- a/x\n+++ b/x\n@@ -1 +1 @@\n-a\n+b\n\\ No newline at end of file\n"
+			patches, err := ParsePatch(patch)
+			So(err, ShouldBeNil)
+			got, err := patches[0].Apply("a\n")
+			So(err, ShouldBeNil)
+			So(got, ShouldEqual, "b")
+		})
+
+		Convey("rejects hunks that do not apply and malformed patches", func() {
+			patches, err := ParsePatch("--- a/main.go\n+++ b/main.go\n@@ -1,1 +1,1 @@\n-package lib\n+package other\n")
+			So(err, ShouldBeNil)
+			_, err = patches[0].Apply(original)
+			So(errors.Is(err, ErrBadPatch), ShouldBeTrue)
+
+			_, err = ParsePatch("@@ -1 +1 @@\n-a\n+b\n")
+			So(errors.Is(err, ErrBadPatch), ShouldBeTrue)
+			_, err = ParsePatch("--- a/x\n+++ b/x\n@@ -1,3 +1,3 @@\n-a\n+b\n")
+			So(errors.Is(err, ErrBadPatch), ShouldBeTrue)
+		})
+	})
+}
+
+func TestUndoLog(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.txt")
+
+	Convey("UndoLog", t, func() {
+		l := NewUndoLog()
+		snap, err := TakeSnapshot(path)
+		So(err, ShouldBeNil)
+		So(snap.Existed, ShouldBeFalse)
+		So(WriteFileAtomic(path, []byte("v1\n"), 0), ShouldBeNil)
+		l.Record("c1", "fs_write", []Snapshot{snap})
+
+		snap, err = TakeSnapshot(path)
+		So(err, ShouldBeNil)
+		So(WriteFileAtomic(path, []byte("v2\n"), snap.Mode), ShouldBeNil)
+		id := l.Record("c1", "fs_write", []Snapshot{snap})
+		So(id, ShouldEqual, 2)
+		So(l.List("c2"), ShouldBeEmpty)
+		So(len(l.List("c1")), ShouldEqual, 2)
+
+		for _, e := range l.Pop("c1", 1) {
+			So(e.Files[0].Restore(), ShouldBeNil)
+		}
+		data, _ := os.ReadFile(path)
+		So(string(data), ShouldEqual, "v1\n")
+
+		for _, e := range l.Pop("c1", 5) {
+			So(e.Files[0].Restore(), ShouldBeNil)
+		}
+		_, err = os.Stat(path)
+		So(os.IsNotExist(err), ShouldBeTrue)
+		So(l.Pop("c1", 1), ShouldBeEmpty)
+	})
+}
+
+func TestUndoLogPrune(t *testing.T) {
+	Convey("UndoLog pruning", t, func() {
+		l := NewUndoLog()
+		// fill 写满对话数上限，c0 最久未活动
+		fill := func() {
+			for i := 0; i < constant.WorkspaceUndoMaxConversations; i++ {
+				l.Record(fmt.Sprintf("c%d", i), "fs_write", nil)
+				l.convs[fmt.Sprintf("c%d", i)].lastUsed = time.Now().Add(time.Duration(i-constant.WorkspaceUndoMaxConversations) * time.Second)
+			}
+		}
+
+		Convey("recording into a known conversation evicts nothing when full", func() {
+			fill()
+			So(l.Record("c0", "fs_write", nil), ShouldEqual, 2)
+			So(len(l.convs), ShouldEqual, constant.WorkspaceUndoMaxConversations)
+			So(len(l.List("c0")), ShouldEqual, 2)
+			So(len(l.List("c1")), ShouldEqual, 1)
+		})
+
+		Convey("a new conversation evicts the least recently used one", func() {
+			fill()
+			So(l.Record("new", "fs_write", nil), ShouldEqual, 1)
+			So(len(l.convs), ShouldEqual, constant.WorkspaceUndoMaxConversations)
+			So(l.List("c0"), ShouldBeEmpty)
+			So(len(l.List("c1")), ShouldEqual, 1)
+		})
+
+		Convey("expired conversations are dropped except the one being recorded", func() {
+			l.Record("old", "fs_write", nil)
+			l.Record("stale", "fs_write", nil)
+			l.convs["old"].lastUsed = time.Now().Add(-constant.WorkspaceUndoTTL - time.Minute)
+			l.convs["stale"].lastUsed = time.Now().Add(-constant.WorkspaceUndoTTL - time.Minute)
+			So(l.Record("old", "fs_write", nil), ShouldEqual, 2)
+			So(l.List("stale"), ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package workspace
+
+import (
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// Edit 一次写操作（可能涉及多个文件）在写入前的快照
+type Edit struct {
+	ID    int
+	Tool  string
+	At    time.Time
+	Files []Snapshot
+}
+
+type undoStack struct {
+	edits    []Edit
+	nextID   int
+	lastUsed time.Time
+}
+
+// UndoLog 按对话记录文件修改，支持按后进先出撤销。
+// 仅保存在内存中：每个对话保留最近 constant.WorkspaceUndoMaxEdits 次修改，
+// 超过 constant.WorkspaceUndoTTL 未活动或对话数超限时整段丢弃
+type UndoLog struct {
+	mu    sync.Mutex
+	convs map[string]*undoStack
+}
+
+func NewUndoLog() *UndoLog {
+	return &UndoLog{convs: make(map[string]*undoStack)}
+}
+
+// Record 记录一次修改，返回该对话内的修改编号
+func (l *UndoLog) Record(conversation, tool string, files []Snapshot) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.prune(now, conversation)
+	st, ok := l.convs[conversation]
+	if !ok {
+		st = &undoStack{}
+		l.convs[conversation] = st
+	}
+	st.nextID++
+	st.lastUsed = now
+	st.edits = append(st.edits, Edit{ID: st.nextID, Tool: tool, At: now, Files: files})
+	if len(st.edits) > constant.WorkspaceUndoMaxEdits {
+		st.edits = st.edits[len(st.edits)-constant.WorkspaceUndoMaxEdits:]
+	}
+	return st.nextID
+}
+
+// Pop 取出对话最近的 n 次修改（最新的在前），调用方负责 Restore
+func (l *UndoLog) Pop(conversation string, n int) []Edit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st, ok := l.convs[conversation]
+	if !ok || n <= 0 {
+		return nil
+	}
+	st.lastUsed = time.Now()
+	if n > len(st.edits) {
+		n = len(st.edits)
+	}
+	popped := make([]Edit, 0, n)
+	for i := 0; i < n; i++ {
+		popped = append(popped, st.edits[len(st.edits)-1-i])
+	}
+	st.edits = st.edits[:len(st.edits)-n]
+	return popped
+}
+
+// List 对话中可撤销的修改（最新的在前）
+func (l *UndoLog) List(conversation string) []Edit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st, ok := l.convs[conversation]
+	if !ok {
+		return nil
+	}
+	out := make([]Edit, 0, len(st.edits))
+	for i := len(st.edits) - 1; i >= 0; i-- {
+		out = append(out, st.edits[i])
+	}
+	return out
+}
+
+// prune 丢弃过期对话；keep 是即将写入的对话，不会被丢弃。
+// 只有 keep 需要新建撤销栈且对话数已满时，才丢弃最久未活动的对话腾出位置
+func (l *UndoLog) prune(now time.Time, keep string) {
+	for id, st := range l.convs {
+		if id != keep && now.Sub(st.lastUsed) > constant.WorkspaceUndoTTL {
+			delete(l.convs, id)
+		}
+	}
+	if _, ok := l.convs[keep]; ok {
+		return
+	}
+	for len(l.convs) >= constant.WorkspaceUndoMaxConversations {
+		var (
+			oldestID string
+			oldest   time.Time
+		)
+		for id, st := range l.convs {
+			if oldestID == "" || st.lastUsed.Before(oldest) {
+				oldestID, oldest = id, st.lastUsed
+			}
+		}
+		delete(l.convs, oldestID)
+	}
+}
@@ -0,0 +1,24 @@
+package constant
+
+import "time"
+
+const (
+	ToolRiskLow    = "low"    // 直接执行
+	ToolRiskMedium = "medium" // 直接执行，仅作标记
+	ToolRiskHigh   = "high"   // 流式对话中需用户确认后才执行，非流式对话中拒绝执行
+
+	ToolApprovalDefaultTimeout = 5 * time.Minute       // 等待用户确认的默认时长，超时视为拒绝
+	ToolApprovalKeyPrefix      = "tool_approval:"      // 待确认的工具调用，后接 approval_id
+	ToolApprovalDecisionSuffix = ":decision"           // 审批结果 key 后缀，只允许写入一次
+	ToolApprovalChannelPrefix  = "tool_approval:done:" // 审批结果通知频道，后接 approval_id
+
+	ToolApprovalApprove = "approve" // 按原参数执行
+	ToolApprovalDeny    = "deny"    // 拒绝执行
+	ToolApprovalEdit    = "edit"    // 按修改后的参数执行
+	ToolApprovalTimeout = "timeout" // 超时未确认，由 host 写入
+
+	ToolApprovalStatusApproved = "approved"
+	ToolApprovalStatusDenied   = "denied"
+	ToolApprovalStatusEdited   = "edited"
+	ToolApprovalStatusTimeout  = "timeout"
+)
@@ -0,0 +1,9 @@
+package constant
+
+const (
+	ChatTemperatureMax       = 2.0     // 请求可指定的最大采样温度
+	ChatTopKMax              = 100     // 请求可指定的最大 top_k
+	ChatMaxTokensLimit       = 8192    // 未配置 ai_provider.chat_options.max_tokens 时的 max_tokens 上限
+	ChatSystemPromptMaxChars = 4000    // 未配置 ai_provider.chat_options.system_prompt_max_chars 时系统提示词的最大字符数
+	ChatOptionTopKField      = "top_k" // OpenAI 兼容接口中 top_k 作为扩展字段透传
+)
@@ -0,0 +1,36 @@
+package constant
+
+const (
+	HtmathMaxAttempts   = 3           // 生成的 htmath 校验失败时最多生成的次数（含首次）
+	HtmathMaxBlockBytes = 256 * 1024  // 单个 <htmath> 片段的大小上限
+	HtmathResourceMIME  = "text/html" // 以嵌入资源返回时的 MIME 类型
+	HtmathResourceURI   = "htmath://" // 嵌入资源 URI 前缀，后接内容哈希
+)
+
+// HtmathAllowedTags <htmath> 片段中允许出现的标签（小写），其余标签连同内容一起移除
+var HtmathAllowedTags = []string{
+	"div", "span", "p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6",
+	"ul", "ol", "li", "dl", "dt", "dd", "table", "thead", "tbody", "tfoot", "tr", "td", "th", "caption",
+	"strong", "em", "b", "i", "u", "s", "sub", "sup", "small", "code", "pre", "blockquote",
+	"a", "img", "figure", "figcaption", "details", "summary", "label", "input", "button", "select", "option",
+	"canvas", "script", "style",
+	"svg", "g", "path", "circle", "ellipse", "rect", "line", "polyline", "polygon", "text", "tspan",
+	"defs", "marker", "lineargradient", "radialgradient", "stop", "title", "desc",
+}
+
+// HtmathAllowedScriptHosts 外链脚本允许的来源（须为 https），用于加载 Plotly、MathJax 等绘图/公式库
+var HtmathAllowedScriptHosts = []string{
+	"cdn.plot.ly",
+	"cdn.jsdelivr.net",
+	"cdnjs.cloudflare.com",
+	"unpkg.com",
+}
+
+// HtmathForbiddenScriptAPIs 内联脚本中禁止出现的 API：网络请求、存储、跳转与动态执行
+var HtmathForbiddenScriptAPIs = []string{
+	"fetch(", "XMLHttpRequest", "WebSocket", "EventSource", "sendBeacon",
+	"document.cookie", "localStorage", "sessionStorage", "indexedDB",
+	"eval(", "new Function", "import(", "importScripts",
+	"window.location", "location.href", "location.replace", "window.open",
+	"window.parent", "window.top", "postMessage",
+}
@@ -0,0 +1,23 @@
+package constant
+
+const (
+	MathMaxExpressionLen     = 2000   // 表达式最大长度
+	MathMaxDerivativeOrder   = 5      // math_derivative 最高阶数
+	MathMaxDerivativeNodes   = 5000   // 导数表达式（按树展开）的最大节点数
+	MathDeriveCheckInterval  = 256    // 求导时每访问多少个节点检查一次 ctx 是否取消
+	MathIntegrateTolerance   = 1e-10  // 自适应 Simpson 积分的绝对误差目标
+	MathIntegrateMaxDepth    = 50     // 自适应 Simpson 的最大递归深度
+	MathIntegrateMaxEvals    = 200000 // 自适应 Simpson 最多求值次数，超过时视为不收敛（如高频振荡的被积函数）
+	MathEvalCheckInterval    = 1024   // 数值计算（积分/求根/绘图）每求值多少次检查一次 ctx 是否取消
+	MathRootScanIntervals    = 2000   // 求根时把区间等分扫描变号的份数
+	MathMaxRoots             = 50     // math_roots 最多返回的根数
+	MathPlotDefaultSamples   = 600    // 每条曲线默认采样点数
+	MathPlotMaxSamples       = 5000   // 每条曲线最多采样点数
+	MathPlotMaxSeries        = 8      // 单张图最多曲线数
+	MathPlotWidth            = 640    // SVG 宽度
+	MathPlotHeight           = 420    // SVG 高度
+	MathPlotDefaultRange     = 10.0   // 未给出 x 范围时使用 [-10, 10]
+	MathPlotImageMIME        = "image/svg+xml"
+	MathDefaultVariable      = "x" // 表达式含多个变量且未指定时使用的自变量
+	MathNumberSignificantDig = 12  // 输出数值保留的有效数字
+)
@@ -9,8 +9,81 @@ const (
 	MCPClientInitTimeout       = 10 * time.Second // MCP客户端初始化超时时间
 	MCPDefaultCallTimeout      = 30 * time.Second // MCP调用默认超时时间
 	MCPServerHeartbeatInterval = 25 * time.Second // MCP服务器心跳间隔
+	MCPServerHealthPath        = "/healthz"       // MCP服务器健康检查路径
+	MCPServerHealthTimeout     = 3 * time.Second  // 健康检查单次依赖探测超时时间
+	MCPServerShutdownTimeout   = 30 * time.Second // 优雅退出时等待进行中工具调用的最长时间
+	MCPServerSSEPath           = "/sse"           // [已废弃的 HTTP+SSE 传输] SSE 流路径
+	MCPServerSSEMessagePath    = "/message"       // [已废弃的 HTTP+SSE 传输] 客户端消息投递路径
+
+	MCPToolGroupTodo   = "todo"   // 待办事项工具与 todo/course/summary 资源
+	MCPToolGroupCourse = "course" // 课表工具
+	MCPToolGroupWeb    = "web"    // 联网搜索与网页抓取
+	MCPToolGroupDev    = "dev"    // 本地开发工具（fs_tree/fs_cat/code_run 等）
+	MCPToolGroupTime   = "time"   // 时间与联调用示例工具
+	MCPToolGroupSTEM   = "stem"   // 数学计算与理工科 htmath 页面生成
+
+	UserMCPMaxServersPerUser = 5                // 单用户 MCP 服务数默认上限
+	UserMCPMaxConnections    = 200              // 用户 MCP 连接总数默认上限
+	UserMCPIdleTimeout       = 10 * time.Minute // 用户 MCP 连接默认空闲回收时间
+	UserMCPDialRetryInterval = time.Minute      // 用户 MCP 服务连接失败后的重试间隔
+	UserMCPToolSeparator     = "__"             // 用户工具与全局工具重名时，以 服务名+分隔符+工具名 暴露
+
+	MCPMetaConversationID = "conversation_id" // host 在 tools/call 的 _meta 中携带对话 ID 的字段名
+	MCPMetaUserID         = "user_id"         // host 在 tools/call 的 _meta 中携带用户 ID 的字段名，用于限流与审计
+	MCPMetaTokenUsage     = "token_usage"     // server 在 tools/call 结果的 _meta 中回报工具内部模型调用用量的字段名，由 host 计入调用方配额
+
+	MCPToolRateLimitKeyPrefix = "mcp:ratelimit:" // 工具限流令牌桶的 Redis key 前缀，后接 工具名:调用方
+	MCPToolAuditMaxArgBytes   = 2 << 10          // 审计日志中参数 JSON 的最大字节数
+	MCPMetricsPath            = "/metrics"       // Prometheus 指标路径
+
+	MCPProgressMethod   = "notifications/progress" // MCP 进度通知方法名
+	MCPProgressInterval = 2 * time.Second          // 长耗时工具心跳式进度通知的间隔
+	MCPProgressThrottle = 500 * time.Millisecond   // 同一调用两次进度通知的最小间隔
+
+	MCPResourceUpdatedChannel = "mcp:resource:updated" // host 写入用户数据后发布变更资源 URI 的 Redis 频道
+	MCPHeaderUserID           = "X-Mcp-User-Id"        // resources/list 按该请求头列出指定用户的资源
+	MCPResourceSchemeTodo     = "todo"                 // todo://{user_id}/{id}
+	MCPResourceSchemeCourse   = "course"               // course://{user_id}/{term}
+	MCPResourceSchemeSummary  = "summary"              // summary://{conversation_id}
+	MCPResourceMaxAttach      = 5                      // 单轮对话最多附带的资源数
+	MCPResourceMaxBytes       = 32 << 10               // 单个资源注入上下文的最大字节数
+
+	MCPPromptChatSystem    = "chat_system"    // 对话系统提示词
+	MCPPromptDailySchedule = "daily_schedule" // 每日日程生成提示词
+	MCPPromptSummarize     = "summarize"      // 对话总结提示词
+	MCPPromptDateLayout    = "2006-01-02"     // 提示词 date 参数的格式
+	MCPTermPattern         = `^\d{4}0[12]$`   // 学期代码 YYYYSS，01 秋季、02 春季
+
+	MCPToolResultMaxImages = 4 // 单轮工具结果附给模型的最大图片数
+
+	MCPAuthDefaultScope          = "mcp:tools"                               // 访问 MCP 工具的基础 scope
+	MCPAuthDefaultAudience       = "go-mcp-demo"                             // 令牌默认受众
+	MCPAuthTokenTTL              = time.Hour                                 // MCP 访问令牌默认有效期
+	MCPAuthTokenRefreshSkew      = time.Minute                               // 令牌到期前多久主动刷新
+	MCPAuthTokenPath             = "/oauth/token"                            // host 签发令牌的端点
+	MCPAuthServerMetadataPath    = "/.well-known/oauth-authorization-server" // 授权服务器元数据（RFC 8414）
+	MCPAuthProtectedResourcePath = "/.well-known/oauth-protected-resource"   // 受保护资源元数据（RFC 9728）
+
+	AiProviderModeLocal  = "local"  // 本地模型
+	AiProviderModeRemote = "remote" // 远程模型
+	AiProviderTypeOpenAI = "openai" // OpenAI 兼容服务
+	AiProviderTypeOllama = "ollama" // Ollama 实例（原生接口 + /v1 兼容层）
+
+	AiProviderDefaultTimeout     = 60 * time.Second       // 模型服务默认的首字节超时
+	AiProviderRetryMaxAttempts   = 3                      // 每个模型服务默认最多尝试次数（含首次）
+	AiProviderRetryBaseDelay     = 500 * time.Millisecond // 重试指数退避的初始等待
+	AiProviderRetryMaxDelay      = 8 * time.Second        // 重试单次等待上限
+	AiProviderBreakerThreshold   = 5                      // 连续失败多少次后熔断
+	AiProviderBreakerOpenTimeout = 30 * time.Second       // 熔断持续时间
+	AiProviderErrorMessageMax    = 200                    // 返回给用户的模型服务错误信息最大长度
 
-	AiProviderModeLocal   = "local"  // 本地模型
-	AiProviderModeRemote  = "remote" // 远程模型
 	FzuHelperServerMCPUrl = "https://fzuhelper.west2.online/mcp"
+
+	UserSettingToolsMaxEntries = 100 // 用户设置 tools.disabled / disabled_servers 的最大条目数
 )
+
+// ChatInternalTools 仅供专用接口（每日日程）使用的工具，任何用户设置都不会在对话中暴露
+var ChatInternalTools = []string{"get_todos", "get_course"}
+
+// MCPToolResultImageMIMETypes 视觉模型普遍接受的图片格式，其他格式（如 math_plot 的 SVG）只以文本占位告知模型
+var MCPToolResultImageMIMETypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
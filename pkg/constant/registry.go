@@ -12,6 +12,13 @@ const (
 	RegistryMCPDefaultPath = "/mcp"
 
 	RegistryCheckInterval                  = 5 * time.Second
+	RegistryCheckTimeout                   = 3 * time.Second
 	RegistryDeregisterAfter                = 15 * time.Second
 	RegistryResolverDefaultRefreshInterval = 10 * time.Second
+
+	RegistryWatchDebounce     = 300 * time.Millisecond // 推送式服务发现的合并窗口，窗口内的多次变更只应用一次
+	RegistryWatchMaxRetries   = 3                      // watch 连续失败次数上限，超过后退化为轮询
+	RegistryConsulWaitTime    = 5 * time.Minute        // consul 阻塞查询的最长等待时间
+	RegistryEtcdDefaultPrefix = "/go-mcp-demo/services"
+	RegistryEtcdDialTimeout   = 5 * time.Second
 )
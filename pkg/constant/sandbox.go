@@ -0,0 +1,48 @@
+package constant
+
+import "time"
+
+const (
+	SandboxIsolationNamespace = "namespace" // Linux user/pid/ipc/uts(/net) 命名空间隔离 + Landlock 文件系统限制
+	SandboxIsolationNone      = "none"      // 仅资源限制与进程组超时
+
+	SandboxDefaultTimeout        = 120 * time.Second // code_run 默认墙钟超时
+	SandboxDefaultMemoryMB       = 1024              // 默认虚拟内存上限
+	SandboxDefaultMaxPids        = 64                // 默认进程数上限（需配置 cgroup）
+	SandboxDefaultMaxFileSizeMB  = 64                // 默认单文件写入上限
+	SandboxDefaultMaxOutputBytes = 64 * 1024         // stdout/stderr 默认各保留 64KiB
+	SandboxKillWaitDelay         = 2 * time.Second   // 杀死进程组后等待输出管道关闭的时间
+	SandboxTimeoutExitCode       = 124               // 超时退出码，与 coreutils timeout 一致
+
+	WorkspaceBinarySniffBytes     = 8000           // 判断二进制文件时读取的头部字节数
+	WorkspaceMaxWriteBytes        = 1 << 20        // 开发工具单次写入文件的大小上限
+	WorkspaceUndoMaxEdits         = 50             // 每个对话保留的可撤销修改数
+	WorkspaceUndoMaxConversations = 256            // 撤销日志最多保留的对话数
+	WorkspaceUndoTTL              = 24 * time.Hour // 对话无修改超过该时间后丢弃其撤销日志
+	WorkspaceSearchMaxMatches     = 100            // fs_search 默认最多返回的匹配数
+	WorkspaceSearchMaxFileBytes   = 2 << 20        // fs_search 跳过超过该大小的文件
+	WorkspaceDefaultConversation  = "default"      // 无法确定对话时撤销日志使用的 key
+)
+
+// SandboxDefaultCommands 未配置 allowed_commands 时允许执行的命令
+var SandboxDefaultCommands = []string{"python3", "go", "node"}
+
+// SandboxReadOnlyPaths 隔离模式下沙箱进程可读、可执行的系统路径，不存在的路径忽略。
+// /etc 只放行动态链接、用户/域名解析、证书与时区所需的文件；
+// /proc 只读放行，Landlock 禁止 ptrace 域外进程，因此读不到宿主进程的 environ/mem/cwd
+var SandboxReadOnlyPaths = []string{
+	"/usr", "/bin", "/sbin", "/lib", "/lib32", "/lib64", "/libx32", "/opt",
+	"/etc/ld.so.cache", "/etc/ld.so.conf", "/etc/ld.so.conf.d", "/etc/alternatives",
+	"/etc/passwd", "/etc/group", "/etc/nsswitch.conf", "/etc/hosts", "/etc/resolv.conf",
+	"/etc/ssl", "/etc/ca-certificates", "/etc/pki", "/etc/localtime", "/usr/share/zoneinfo",
+	"/proc",
+}
+
+// SandboxDevicePaths 隔离模式下沙箱进程可读写的设备文件
+var SandboxDevicePaths = []string{"/dev/null", "/dev/zero", "/dev/random", "/dev/urandom", "/dev/full"}
+
+// WorkspaceDefaultDenyPatterns 开发工具始终不可访问的敏感文件，按路径中每一级名称匹配
+var WorkspaceDefaultDenyPatterns = []string{
+	".env", ".env.*", "*.pem", "*.key", "*.p12", "*.pfx", "id_rsa*", "id_ed25519*",
+	"config*.yaml", "config*.yml", ".git-credentials", ".netrc", ".ssh", ".aws", ".kube",
+}
@@ -3,7 +3,8 @@ package constant
 import "time"
 
 const (
-	AccessTokenTTL = time.Hour * 24 * 7
+	AccessTokenTTL    = time.Hour * 24 * 7
+	AccessTokenIssuer = "hachimi" // 用户登录令牌的签发者，与 MCP 访问令牌区分
 )
 
 // DefaultUserSettingJSON 默认用户设置
@@ -17,5 +18,10 @@ const DefaultUserSettingJSON = `{
 	"preferences": {
 		"auto_save": true,
 		"show_week_number": true
+	},
+	"tools": {
+		"web_search": true,
+		"disabled": [],
+		"disabled_servers": []
 	}
 }`
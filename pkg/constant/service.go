@@ -4,4 +4,5 @@ const (
 	ServiceNameAPI       = "host"
 	ServiceNameMCPLocal  = "mcp_local"  // 仅本地工具的MCP服务
 	ServiceNameMCPRemote = "mcp_remote" // 涉及到外部请求的MCP服务
+	// 两者均由 cmd/mcp_server 以 -service 区分，启用的工具组与传输层见 mcp.servers.<服务名>
 )
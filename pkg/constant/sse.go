@@ -6,4 +6,10 @@ const (
 	SSEEventStartToolCall = "start_tool_call" // 开始工具调用
 	SSEEventToolCall      = "tool_call"       // 工具调用
 	SSEEventToolResult    = "tool_result"     // 工具调用结果
+	SSEEventToolProgress  = "tool_progress"   // 工具执行进度
+
+	SSEEventToolApprovalRequired = "tool_approval_required" // 高风险工具调用等待用户确认
+	SSEEventToolApprovalResolved = "tool_approval_resolved" // 用户已确认/拒绝或等待超时
+
+	SSEEventProviderFallback = "provider_fallback" // 模型服务失败，已降级到备用服务
 )
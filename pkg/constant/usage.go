@@ -0,0 +1,9 @@
+package constant
+
+const (
+	UserRoleDefault = "user" // 新用户的默认角色，对应 users.role 的默认值
+
+	UsageDefaultDays        = 7  // GET /api/v1/user/usage 未指定 days 时统计的天数（含今天）
+	UsageMaxDays            = 90 // days 的上限
+	UsageTopConversationNum = 20 // 按对话聚合时返回用量最高的对话数
+)
@@ -0,0 +1,27 @@
+package constant
+
+import "time"
+
+// WebSearchTools 用户设置 tools.web_search 为 false 时禁用的工具
+var WebSearchTools = []string{"web_search", "web_fetch"}
+
+const (
+	WebSearchProviderDuckDuckGo = "duckduckgo" // DuckDuckGo HTML 版（无需密钥）
+	WebSearchProviderSearXNG    = "searxng"    // 自建 SearXNG 实例的 JSON 接口
+	WebSearchProviderBing       = "bing"       // Bing Web Search v7 及兼容接口
+
+	WebSearchDuckDuckGoEndpoint = "https://html.duckduckgo.com/html/"
+	WebSearchBingEndpoint       = "https://api.bing.microsoft.com/v7.0/search"
+	WebSearchDefaultMaxResults  = 5                // web_search 默认返回条数
+	WebSearchMaxResultsLimit    = 20               // web_search 单次最多返回条数
+	WebSearchDefaultTimeout     = 10 * time.Second // 搜索请求超时
+	WebSearchUserAgent          = "Mozilla/5.0 (compatible; go-mcp-demo/1.0; +https://github.com/FantasyRL/go-mcp-demo)"
+
+	WebFetchDefaultTimeout   = 15 * time.Second // 抓取网页超时
+	WebFetchDefaultMaxBytes  = 2 << 20          // 抓取网页时最多读取的响应体大小
+	WebFetchDefaultMaxChars  = 20000            // 返回给模型的正文最大字符数
+	WebFetchDefaultCacheTTL  = time.Hour        // 抓取结果在 Redis 中的缓存时间
+	WebFetchMaxRedirects     = 5                // 最多跟随的重定向次数
+	WebFetchCacheKeyPrefix   = "webfetch:"      // Redis 缓存 key 前缀，后接 URL 的 sha256
+	WebFetchMinParagraphRune = 25               // 正文提取时计入得分的最短段落
+)
@@ -79,4 +79,9 @@ const (
 	InternalTraceErrorCode     = 50020 // Trace错误
 	InternalKafkaErrorCode     = 50021
 	InternalSFErrorCode        = 50022 // snowflake错误
+
+	InternalAiRateLimitCode   = 50023 // 模型服务限流
+	InternalAiUnavailableCode = 50024 // 模型服务不可用（5xx、连接失败或已熔断）
+	InternalAiAuthCode        = 50025 // 模型服务鉴权失败
+	InternalAiRequestCode     = 50026 // 模型服务拒绝请求（模型不存在、参数错误等）
 )
@@ -33,6 +33,7 @@ func newConversations(db *gorm.DB, opts ...gen.DOOption) conversations {
 	_conversations.Messages = field.NewString(tableName, "messages")
 	_conversations.IsSummarized = field.NewInt16(tableName, "is_summarized")
 	_conversations.Title = field.NewString(tableName, "title")
+	_conversations.ChatOptions = field.NewString(tableName, "chat_options")
 	_conversations.CreatedAt = field.NewTime(tableName, "created_at")
 	_conversations.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_conversations.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -51,6 +52,7 @@ type conversations struct {
 	Messages     field.String // 对话消息，JSON格式存储
 	IsSummarized field.Int16  // 是否已生成摘要，0-否，1-是
 	Title        field.String // 对话标题
+	ChatOptions  field.String // 对话生成参数（模型、温度等），JSON格式存储
 	CreatedAt    field.Time   // 创建时间
 	UpdatedAt    field.Time   // 更新时间
 	DeletedAt    field.Field  // 删除时间
@@ -75,6 +77,7 @@ func (c *conversations) updateTableName(table string) *conversations {
 	c.Messages = field.NewString(table, "messages")
 	c.IsSummarized = field.NewInt16(table, "is_summarized")
 	c.Title = field.NewString(table, "title")
+	c.ChatOptions = field.NewString(table, "chat_options")
 	c.CreatedAt = field.NewTime(table, "created_at")
 	c.UpdatedAt = field.NewTime(table, "updated_at")
 	c.DeletedAt = field.NewField(table, "deleted_at")
@@ -106,12 +109,13 @@ func (c *conversations) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *conversations) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap = make(map[string]field.Expr, 9)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["user_id"] = c.UserID
 	c.fieldMap["messages"] = c.Messages
 	c.fieldMap["is_summarized"] = c.IsSummarized
 	c.fieldMap["title"] = c.Title
+	c.fieldMap["chat_options"] = c.ChatOptions
 	c.fieldMap["created_at"] = c.CreatedAt
 	c.fieldMap["updated_at"] = c.UpdatedAt
 	c.fieldMap["deleted_at"] = c.DeletedAt
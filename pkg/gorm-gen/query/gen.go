@@ -16,11 +16,13 @@ import (
 )
 
 var (
-	Q             = new(Query)
-	Conversations *conversations
-	Summaries     *summaries
-	Todolists     *todolists
-	Users         *users
+	Q              = new(Query)
+	Conversations  *conversations
+	Summaries      *summaries
+	Todolists      *todolists
+	TokenUsages    *tokenUsages
+	UserMcpServers *userMcpServers
+	Users          *users
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
@@ -28,37 +30,45 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	Conversations = &Q.Conversations
 	Summaries = &Q.Summaries
 	Todolists = &Q.Todolists
+	TokenUsages = &Q.TokenUsages
+	UserMcpServers = &Q.UserMcpServers
 	Users = &Q.Users
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:            db,
-		Conversations: newConversations(db, opts...),
-		Summaries:     newSummaries(db, opts...),
-		Todolists:     newTodolists(db, opts...),
-		Users:         newUsers(db, opts...),
+		db:             db,
+		Conversations:  newConversations(db, opts...),
+		Summaries:      newSummaries(db, opts...),
+		Todolists:      newTodolists(db, opts...),
+		TokenUsages:    newTokenUsages(db, opts...),
+		UserMcpServers: newUserMcpServers(db, opts...),
+		Users:          newUsers(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Conversations conversations
-	Summaries     summaries
-	Todolists     todolists
-	Users         users
+	Conversations  conversations
+	Summaries      summaries
+	Todolists      todolists
+	TokenUsages    tokenUsages
+	UserMcpServers userMcpServers
+	Users          users
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:            db,
-		Conversations: q.Conversations.clone(db),
-		Summaries:     q.Summaries.clone(db),
-		Todolists:     q.Todolists.clone(db),
-		Users:         q.Users.clone(db),
+		db:             db,
+		Conversations:  q.Conversations.clone(db),
+		Summaries:      q.Summaries.clone(db),
+		Todolists:      q.Todolists.clone(db),
+		TokenUsages:    q.TokenUsages.clone(db),
+		UserMcpServers: q.UserMcpServers.clone(db),
+		Users:          q.Users.clone(db),
 	}
 }
 
@@ -72,27 +82,33 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:            db,
-		Conversations: q.Conversations.replaceDB(db),
-		Summaries:     q.Summaries.replaceDB(db),
-		Todolists:     q.Todolists.replaceDB(db),
-		Users:         q.Users.replaceDB(db),
+		db:             db,
+		Conversations:  q.Conversations.replaceDB(db),
+		Summaries:      q.Summaries.replaceDB(db),
+		Todolists:      q.Todolists.replaceDB(db),
+		TokenUsages:    q.TokenUsages.replaceDB(db),
+		UserMcpServers: q.UserMcpServers.replaceDB(db),
+		Users:          q.Users.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Conversations IConversationsDo
-	Summaries     ISummariesDo
-	Todolists     ITodolistsDo
-	Users         IUsersDo
+	Conversations  IConversationsDo
+	Summaries      ISummariesDo
+	Todolists      ITodolistsDo
+	TokenUsages    ITokenUsagesDo
+	UserMcpServers IUserMcpServersDo
+	Users          IUsersDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Conversations: q.Conversations.WithContext(ctx),
-		Summaries:     q.Summaries.WithContext(ctx),
-		Todolists:     q.Todolists.WithContext(ctx),
-		Users:         q.Users.WithContext(ctx),
+		Conversations:  q.Conversations.WithContext(ctx),
+		Summaries:      q.Summaries.WithContext(ctx),
+		Todolists:      q.Todolists.WithContext(ctx),
+		TokenUsages:    q.TokenUsages.WithContext(ctx),
+		UserMcpServers: q.UserMcpServers.WithContext(ctx),
+		Users:          q.Users.WithContext(ctx),
 	}
 }
 
@@ -0,0 +1,141 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MCPClaims MCP 访问令牌，scope 以空格分隔（RFC 8693 / RFC 9068）
+type MCPClaims struct {
+	Scope    string `json:"scope"`
+	ClientID string `json:"client_id"`
+	jwt.RegisteredClaims
+}
+
+// Scopes 令牌携带的 scope 列表
+func (c *MCPClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasScope 令牌是否包含指定 scope，空 scope 视为无需授权
+func (c *MCPClaims) HasScope(scope string) bool {
+	if scope == "" {
+		return true
+	}
+	return slices.Contains(c.Scopes(), scope)
+}
+
+// GenerateMCPAccessToken 为 client_credentials 授权签发 MCP 访问令牌，返回令牌与过期时间
+func GenerateMCPAccessToken(clientID string, scopes []string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(mcpTokenTTL())
+	claims := &MCPClaims{
+		Scope:    strings.Join(scopes, " "),
+		ClientID: clientID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   clientID,
+			Audience:  jwt.ClaimStrings{MCPAudience()},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    config.MCP.Auth.Issuer,
+		},
+	}
+
+	key, err := mcpSigningKey()
+	if err != nil {
+		logger.Error("generate mcp token failed", zap.Error(err))
+		return "", time.Time{}, ErrGenerateToken
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		logger.Error("generate mcp token failed", zap.Error(err), zap.String("client_id", clientID))
+		return "", time.Time{}, ErrGenerateToken
+	}
+	return tokenString, expiresAt, nil
+}
+
+// VerifyMCPAccessToken 校验 MCP 访问令牌的签名、有效期、签发者与受众
+func VerifyMCPAccessToken(token string) (*MCPClaims, error) {
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+	key, err := mcpSigningKey()
+	if err != nil {
+		zap.L().Warn("parse mcp token error", zap.Error(err))
+		return nil, ErrInvalidToken
+	}
+	claims := &MCPClaims{}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(MCPAudience()),
+	}
+	if config.MCP.Auth.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.MCP.Auth.Issuer))
+	}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		return key, nil
+	}, opts...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		zap.L().Warn("parse mcp token error", zap.Error(err))
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// MCPAudience 令牌受众，未配置时使用默认值
+func MCPAudience() string {
+	if config.MCP.Auth.Audience != "" {
+		return config.MCP.Auth.Audience
+	}
+	return constant.MCPAuthDefaultAudience
+}
+
+// mcpSigningKey MCP 令牌使用独立的签名密钥，不回退到 server.private-key，
+// 否则同一密钥签出的令牌可能被另一类校验接受
+func mcpSigningKey() ([]byte, error) {
+	key := config.MCP.Auth.SigningKey
+	if key == "" {
+		return nil, errors.New("mcp.auth.signing_key is not configured")
+	}
+	if config.Server != nil && key == config.Server.Secret {
+		return nil, errors.New("mcp.auth.signing_key must differ from server.private-key")
+	}
+	return []byte(key), nil
+}
+
+func mcpTokenTTL() time.Duration {
+	if config.MCP.Auth.TokenTTL > 0 {
+		return config.MCP.Auth.TokenTTL
+	}
+	return constant.MCPAuthTokenTTL
+}
+
+type mcpClaimsKey struct{}
+
+// WithMCPClaims 将已校验的令牌放入 context，供工具层按 scope 鉴权
+func WithMCPClaims(ctx context.Context, claims *MCPClaims) context.Context {
+	return context.WithValue(ctx, mcpClaimsKey{}, claims)
+}
+
+// MCPClaimsFrom 取出 context 中的令牌，stdio 等未经授权的传输返回 false
+func MCPClaimsFrom(ctx context.Context) (*MCPClaims, bool) {
+	claims, ok := ctx.Value(mcpClaimsKey{}).(*MCPClaims)
+	return claims, ok && claims != nil
+}
@@ -25,7 +25,7 @@ func GenerateAccessToken(stuID string) (string, error) {
 			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(constant.AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    "hachimi",
+			Issuer:    constant.AccessTokenIssuer,
 		},
 	}
 
@@ -38,6 +38,7 @@ func GenerateAccessToken(stuID string) (string, error) {
 	return tokenString, nil
 }
 
+// VerifyAccessToken 校验用户登录令牌；签发者不符或带有受众的令牌（如 MCP 访问令牌）一律拒绝
 func VerifyAccessToken(token string) (*Claims, error) {
 	claims := &Claims{}
 
@@ -49,6 +50,7 @@ func VerifyAccessToken(token string) (*Claims, error) {
 	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
 		jwt.WithIssuedAt(),
 		jwt.WithExpirationRequired(),
+		jwt.WithIssuer(constant.AccessTokenIssuer),
 	)
 	if err != nil {
 		if !errors.Is(err, jwt.ErrTokenExpired) {
@@ -56,6 +58,9 @@ func VerifyAccessToken(token string) (*Claims, error) {
 		}
 		return nil, ErrInvalidToken
 	}
+	if len(claims.Audience) > 0 {
+		return nil, ErrInvalidToken
+	}
 
 	return claims, nil
 }
@@ -0,0 +1,29 @@
+package utils
+
+import "context"
+
+type conversationIDKey struct{}
+
+// WithConversationID 记录当前对话 ID，调用 MCP 工具时随 _meta 传给 server
+func WithConversationID(ctx context.Context, conversationID string) context.Context {
+	return context.WithValue(ctx, conversationIDKey{}, conversationID)
+}
+
+// ExtractConversationID 取出当前对话 ID
+func ExtractConversationID(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(conversationIDKey{}).(string)
+	return v, ok && v != ""
+}
+
+type userIDKey struct{}
+
+// WithUserID 记录当前用户 ID，调用 MCP 工具时随 _meta 传给 server
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// ExtractUserID 取出当前用户 ID
+func ExtractUserID(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(userIDKey{}).(string)
+	return v, ok && v != ""
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+)
+
+// EncryptSecret 使用 AES-256-GCM 加密敏感数据（如用户提供的 MCP 凭据），密钥由 server.encryption-key 派生，
+// 返回 base64(nonce|ciphertext)
+func EncryptSecret(plaintext []byte) (string, error) {
+	gcm, err := secretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("utils.EncryptSecret: read nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret 解密 EncryptSecret 的输出
+func DecryptSecret(encoded string) ([]byte, error) {
+	gcm, err := secretCipher()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("utils.DecryptSecret: decode: %w", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("utils.DecryptSecret: ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("utils.DecryptSecret: open: %w", err)
+	}
+	return plaintext, nil
+}
+
+func secretCipher() (cipher.AEAD, error) {
+	if config.Server == nil || config.Server.EncryptionKey == "" {
+		return nil, errors.New("utils: server encryption-key is empty")
+	}
+	if config.Server.EncryptionKey == config.Server.Secret {
+		return nil, errors.New("utils: server encryption-key must differ from private-key")
+	}
+	key := sha256.Sum256([]byte(config.Server.EncryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
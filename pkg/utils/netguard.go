@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// cgnat 运营商级 NAT 地址段 100.64.0.0/10，net.IP.IsPrivate 不包含
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPrivateIP 是否为内网、回环、链路本地等不应由用户输入触发访问的地址
+func IsPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		cgnat.Contains(ip)
+}
+
+// PublicDialControl 返回用于 net.Dialer.Control 的校验函数，在建立连接时检查解析后的 IP，
+// 域名解析到内网（含 DNS rebinding）同样会被拒绝，拒绝时返回包装了 errPrivate 的错误
+func PublicDialControl(errPrivate error) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		if ip := net.ParseIP(host); ip == nil || IsPrivateIP(ip) {
+			return fmt.Errorf("%w: %s", errPrivate, host)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// TodoResourceURI 单条待办的资源 URI：todo://{user_id}/{id}
+func TodoResourceURI(userID, id string) string {
+	return resourceURI(constant.MCPResourceSchemeTodo, userID, id)
+}
+
+// TodoListResourceURI 用户全部待办的资源 URI：todo://{user_id}
+func TodoListResourceURI(userID string) string {
+	return resourceURI(constant.MCPResourceSchemeTodo, userID)
+}
+
+// CourseResourceURI 用户某学期课表的资源 URI：course://{user_id}/{term}
+func CourseResourceURI(userID, term string) string {
+	return resourceURI(constant.MCPResourceSchemeCourse, userID, term)
+}
+
+// SummaryResourceURI 对话总结的资源 URI：summary://{conversation_id}
+func SummaryResourceURI(conversationID string) string {
+	return resourceURI(constant.MCPResourceSchemeSummary, conversationID)
+}
+
+func resourceURI(scheme string, segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+	return scheme + "://" + strings.Join(escaped, "/")
+}
+
+// ParseResourceURI 把 scheme://a/b 拆成 scheme 与各段（已反转义），任一段为空时报错
+func ParseResourceURI(uri string) (scheme string, segments []string, err error) {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok || scheme == "" || rest == "" {
+		return "", nil, fmt.Errorf("invalid resource uri %q", uri)
+	}
+	for _, s := range strings.Split(rest, "/") {
+		v, err := url.PathUnescape(s)
+		if err != nil || v == "" {
+			return "", nil, fmt.Errorf("invalid resource uri %q", uri)
+		}
+		segments = append(segments, v)
+	}
+	return scheme, segments, nil
+}
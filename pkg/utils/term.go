@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// TermOf 返回日期所在的学期代码 YYYYSS：8 月至次年 1 月为该学年第一学期（01），2 至 7 月为第二学期（02）
+func TermOf(t time.Time) string {
+	year := t.Year()
+	switch {
+	case t.Month() >= time.August:
+		return fmt.Sprintf("%d01", year)
+	case t.Month() == time.January:
+		return fmt.Sprintf("%d01", year-1)
+	default:
+		return fmt.Sprintf("%d02", year-1)
+	}
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTermOf(t *testing.T) {
+	cases := []struct {
+		date string
+		want string
+	}{
+		{"2025-01-01", "202401"},
+		{"2025-01-31", "202401"},
+		{"2025-02-01", "202402"},
+		{"2025-07-31", "202402"},
+		{"2025-08-01", "202501"},
+		{"2025-12-31", "202501"},
+	}
+
+	Convey("TermOf", t, func() {
+		for _, c := range cases {
+			Convey(c.date, func() {
+				d, err := time.ParseInLocation(time.DateOnly, c.date, time.Local)
+				So(err, ShouldBeNil)
+				So(TermOf(d), ShouldEqual, c.want)
+			})
+		}
+	})
+}